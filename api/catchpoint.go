@@ -32,22 +32,21 @@ func (r LatestCatchpointResponse) Status() string {
 	return r.ResponseStatus
 }
 
-func GetLatestCatchpointWithResponse(http HttpPkgInterface, network string) (LatestCatchpointResponse, error) {
+// GetLatestCatchpointWithResponse fetches the latest catchpoint of a network in the Registry.
+// The genesis hash tells apart networks sharing a genesis ID, an empty hash uses the first network with the ID.
+func GetLatestCatchpointWithResponse(http HttpPkgInterface, network string, genesisHash string) (LatestCatchpointResponse, error) {
 	var response LatestCatchpointResponse
 
-	var url CatchPointUrl
-	switch network {
-	case "fnet-v1", "fnet":
-		url = FNet
-	case "betanet-v1.0", "betanet":
-		url = BetaNet
-	case "testnet-v1.0", "testnet":
-		url = TestNet
-	case "mainnet-v1.0", "mainnet":
-		url = MainNet
-	default:
+	n, ok := Registry.Find(network, genesisHash)
+	if !ok || n.CatchpointURL == "" {
 		return response, ErrInvalidNetwork
 	}
+	return GetCatchpointWithResponse(http, n.CatchpointURL)
+}
+
+// GetCatchpointWithResponse fetches the latest catchpoint label from a catchpoint URL.
+func GetCatchpointWithResponse(http HttpPkgInterface, url CatchPointUrl) (LatestCatchpointResponse, error) {
+	var response LatestCatchpointResponse
 	res, err := http.Get(string(url))
 	response.HTTPResponse = res
 	if err != nil {
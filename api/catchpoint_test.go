@@ -5,7 +5,7 @@ import (
 )
 
 func Test_GetLatestCatchpoint(t *testing.T) {
-	catchpoint, err := GetLatestCatchpointWithResponse(new(HttpPkg), "mainnet")
+	catchpoint, err := GetLatestCatchpointWithResponse(new(HttpPkg), "mainnet", "")
 	if err != nil {
 		t.Error(err)
 	}
@@ -68,6 +68,40 @@ func GetGoAlgorandReleaseWithResponse(http HttpPkgInterface, channel string) (*G
 	return &versions, nil
 }
 
+// GetGoAlgorandReleaseByTagWithResponse fetches a single go-algorand release by its tag, e.g. v3.26.0-stable.
+// It is not limited to the first page of the release list, the JSON200 is the tag of the release.
+// A release which does not exist returns a 404 status.
+func GetGoAlgorandReleaseByTagWithResponse(http HttpPkgInterface, tag string) (*GithubVersionResponse, error) {
+	var versions GithubVersionResponse
+	resp, err := http.Get("https://api.github.com/repos/algorand/go-algorand/releases/tags/" + tag)
+	versions.HTTPResponse = resp
+	if resp == nil || err != nil {
+		return nil, err
+	}
+	// Update Model
+	versions.ResponseCode = resp.StatusCode
+	versions.ResponseStatus = resp.Status
+
+	// Exit if not 200
+	if resp.StatusCode != 200 {
+		return &versions, nil
+	}
+
+	defer resp.Body.Close()
+
+	var release struct {
+		TagName string `json:"tag_name"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&release); err != nil {
+		return &versions, err
+	}
+	if release.TagName == "" {
+		return &versions, errors.New(ChannelNotFoundMsg)
+	}
+	versions.JSON200 = release.TagName
+	return &versions, nil
+}
+
 func GetNodeKitReleaseWithResponse(http HttpPkgInterface) (*GithubVersionResponse, error) {
 	var versions GithubVersionResponse
 	resp, err := http.Get("https://api.github.com/repos/algorandfoundation/nodekit/releases/latest")
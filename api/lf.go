@@ -707,6 +707,12 @@ type ClientInterface interface {
 	// WaitForBlock request
 	WaitForBlock(ctx context.Context, round int, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// RawTransactionWithBody request with any body
+	RawTransactionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TransactionParams request
+	TransactionParams(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetVersion request
 	GetVersion(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
@@ -879,6 +885,30 @@ func (c *Algod) WaitForBlock(ctx context.Context, round int, reqEditors ...Reque
 	return c.Client.Do(req)
 }
 
+func (c *Algod) RawTransactionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRawTransactionRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Algod) TransactionParams(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTransactionParamsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Algod) GetVersion(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetVersionRequest(c.Server)
 	if err != nil {
@@ -1464,6 +1494,62 @@ func NewWaitForBlockRequest(server string, round int) (*http.Request, error) {
 	return req, nil
 }
 
+// NewRawTransactionRequestWithBody generates requests for RawTransaction with any type of body
+func NewRawTransactionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/transactions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTransactionParamsRequest generates requests for TransactionParams
+func NewTransactionParamsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/transactions/params")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewGetVersionRequest generates requests for GetVersion
 func NewGetVersionRequest(server string) (*http.Request, error) {
 	var err error
@@ -1576,6 +1662,12 @@ type ClientWithResponsesInterface interface {
 	// WaitForBlockWithResponse request
 	WaitForBlockWithResponse(ctx context.Context, round int, reqEditors ...RequestEditorFn) (*WaitForBlockResponse, error)
 
+	// RawTransactionWithBodyWithResponse request with any body
+	RawTransactionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RawTransactionResponse, error)
+
+	// TransactionParamsWithResponse request
+	TransactionParamsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TransactionParamsResponse, error)
+
 	// GetVersionWithResponse request
 	GetVersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetVersionResponse, error)
 }
@@ -2106,6 +2198,83 @@ func (r WaitForBlockResponse) StatusCode() int {
 	return 0
 }
 
+type RawTransactionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// TxId encoding of the transaction hash.
+		TxId string `json:"txId"`
+	}
+	JSON400 *ErrorResponse
+	JSON401 *ErrorResponse
+	JSON500 *ErrorResponse
+	JSON503 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RawTransactionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RawTransactionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TransactionParamsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// ConsensusVersion ConsensusVersion indicates the consensus protocol version
+		// as of LastRound.
+		ConsensusVersion string `json:"consensus-version"`
+
+		// Fee Fee is the suggested transaction fee
+		// Fee is in units of micro-Algos per byte.
+		// Fee may fall to zero but transactions must still have a fee of
+		// at least MinTxnFee for the current network protocol.
+		Fee int `json:"fee"`
+
+		// GenesisHash GenesisHash is the hash of the genesis block.
+		GenesisHash []byte `json:"genesis-hash"`
+
+		// GenesisId GenesisID is an ID listed in the genesis block.
+		GenesisId string `json:"genesis-id"`
+
+		// LastRound LastRound indicates the last round seen
+		LastRound int `json:"last-round"`
+
+		// MinFee The minimum transaction fee (not per byte) required for the
+		// txn to validate for the current network protocol.
+		MinFee int `json:"min-fee"`
+	}
+	JSON401 *ErrorResponse
+	JSON500 *ErrorResponse
+	JSON503 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r TransactionParamsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TransactionParamsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type GetVersionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -2254,6 +2423,24 @@ func (c *ClientWithResponses) WaitForBlockWithResponse(ctx context.Context, roun
 	return ParseWaitForBlockResponse(rsp)
 }
 
+// RawTransactionWithBodyWithResponse request with arbitrary body returning *RawTransactionResponse
+func (c *ClientWithResponses) RawTransactionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RawTransactionResponse, error) {
+	rsp, err := c.RawTransactionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRawTransactionResponse(rsp)
+}
+
+// TransactionParamsWithResponse request returning *TransactionParamsResponse
+func (c *ClientWithResponses) TransactionParamsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TransactionParamsResponse, error) {
+	rsp, err := c.TransactionParams(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTransactionParamsResponse(rsp)
+}
+
 // GetVersionWithResponse request returning *GetVersionResponse
 func (c *ClientWithResponses) GetVersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetVersionResponse, error) {
 	rsp, err := c.GetVersion(ctx, reqEditors...)
@@ -3133,6 +3320,133 @@ func ParseWaitForBlockResponse(rsp *http.Response) (*WaitForBlockResponse, error
 	return response, nil
 }
 
+// ParseRawTransactionResponse parses an HTTP response from a RawTransactionWithResponse call
+func ParseRawTransactionResponse(rsp *http.Response) (*RawTransactionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RawTransactionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// TxId encoding of the transaction hash.
+			TxId string `json:"txId"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTransactionParamsResponse parses an HTTP response from a TransactionParamsWithResponse call
+func ParseTransactionParamsResponse(rsp *http.Response) (*TransactionParamsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TransactionParamsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// ConsensusVersion ConsensusVersion indicates the consensus protocol version
+			// as of LastRound.
+			ConsensusVersion string `json:"consensus-version"`
+
+			// Fee Fee is the suggested transaction fee
+			// Fee is in units of micro-Algos per byte.
+			// Fee may fall to zero but transactions must still have a fee of
+			// at least MinTxnFee for the current network protocol.
+			Fee int `json:"fee"`
+
+			// GenesisHash GenesisHash is the hash of the genesis block.
+			GenesisHash []byte `json:"genesis-hash"`
+
+			// GenesisId GenesisID is an ID listed in the genesis block.
+			GenesisId string `json:"genesis-id"`
+
+			// LastRound LastRound indicates the last round seen
+			LastRound int `json:"last-round"`
+
+			// MinFee The minimum transaction fee (not per byte) required for the
+			// txn to validate for the current network protocol.
+			MinFee int `json:"min-fee"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseGetVersionResponse parses an HTTP response from a GetVersionWithResponse call
 func ParseGetVersionResponse(rsp *http.Response) (*GetVersionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
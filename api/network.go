@@ -0,0 +1,64 @@
+package api
+
+import "strings"
+
+// Network describes the services NodeKit uses for an algod network.
+type Network struct {
+	// GenesisID is the genesis ID reported by algod, e.g. mainnet-v1.0.
+	GenesisID string
+
+	// GenesisHash is the base64 genesis hash, an empty hash matches any network with the GenesisID.
+	GenesisHash string
+
+	// CatchpointURL returns the latest catchpoint label, empty when the network has no catchpoints.
+	CatchpointURL CatchPointUrl
+
+	// LoraNetwork is the network name used by lora and the short link service.
+	LoraNetwork string
+
+	// LaggingThreshold is the number of rounds behind the latest catchpoint before a node is lagging,
+	// zero uses the default threshold.
+	LaggingThreshold int
+}
+
+// Networks is a registry of networks, the first match wins.
+type Networks []Network
+
+// DefaultNetworks are the networks known to NodeKit.
+var DefaultNetworks = Networks{
+	{GenesisID: "fnet-v1", CatchpointURL: FNet, LoraNetwork: "fnet"},
+	{GenesisID: "betanet-v1.0", CatchpointURL: BetaNet, LoraNetwork: "betanet"},
+	{GenesisID: "testnet-v1.0", GenesisHash: "SGO1GKSzyE7IEPItTxCByw9x8FmnrCDexi9/cOUJOiI=", CatchpointURL: TestNet, LoraNetwork: "testnet"},
+	{GenesisID: "mainnet-v1.0", GenesisHash: "wGHE2Pwdvd7S12BL5FaOP20EGYesN73ktiC1qzkkit8=", CatchpointURL: MainNet, LoraNetwork: "mainnet"},
+	{GenesisID: "dockernet-v1", LoraNetwork: "localnet"},
+	{GenesisID: "tuinet-v1", LoraNetwork: "localnet"},
+}
+
+// Registry is the registry used to resolve networks, it holds the DefaultNetworks until RegisterNetworks is called.
+var Registry = DefaultNetworks
+
+// RegisterNetworks sets the Registry to the custom networks followed by the DefaultNetworks,
+// a custom network overrides a default network with the same genesis ID and hash.
+func RegisterNetworks(networks ...Network) {
+	Registry = append(append(Networks{}, networks...), DefaultNetworks...)
+}
+
+// Find returns the network for a genesis ID and hash, an empty hash matches any hash.
+// The genesis ID may omit its version suffix, e.g. mainnet.
+func (n Networks) Find(genesisID string, genesisHash string) (Network, bool) {
+	for _, network := range n {
+		if network.GenesisID != genesisID && ShortNetworkName(network.GenesisID) != genesisID {
+			continue
+		}
+		if network.GenesisHash != "" && genesisHash != "" && network.GenesisHash != genesisHash {
+			continue
+		}
+		return network, true
+	}
+	return Network{}, false
+}
+
+// ShortNetworkName removes the version suffix of a genesis ID, e.g. mainnet-v1.0 is mainnet.
+func ShortNetworkName(genesisID string) string {
+	return strings.Replace(strings.Replace(genesisID, "-v1.0", "", 1), "-v1", "", 1)
+}
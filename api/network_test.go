@@ -0,0 +1,84 @@
+package api
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func Test_NetworksFind(t *testing.T) {
+	network, ok := DefaultNetworks.Find("mainnet-v1.0", "wGHE2Pwdvd7S12BL5FaOP20EGYesN73ktiC1qzkkit8=")
+	if !ok || network.CatchpointURL != MainNet {
+		t.Errorf("expected mainnet, got %+v", network)
+	}
+	network, ok = DefaultNetworks.Find("testnet", "")
+	if !ok || network.LoraNetwork != "testnet" {
+		t.Errorf("expected the short name to match testnet, got %+v", network)
+	}
+	_, ok = DefaultNetworks.Find("mainnet-v1.0", "AAAA")
+	if ok {
+		t.Error("expected a different genesis hash not to match")
+	}
+	_, ok = DefaultNetworks.Find("privnet-v1", "")
+	if ok {
+		t.Error("expected an unknown network not to match")
+	}
+}
+
+func Test_RegisterNetworks(t *testing.T) {
+	defer func() { Registry = DefaultNetworks }()
+
+	_, err := GetLatestCatchpointWithResponse(new(HttpPkg), "privnet-v1", "")
+	if !errors.Is(err, ErrInvalidNetwork) {
+		t.Errorf("expected an invalid network, got %v", err)
+	}
+
+	RegisterNetworks(
+		Network{GenesisID: "privnet-v1", CatchpointURL: "http://localhost/latest", LoraNetwork: "localnet"},
+		Network{GenesisID: "mainnet-v1.0", GenesisHash: "AAAA", LoraNetwork: "mainnet-fork"},
+	)
+	network, ok := Registry.Find("privnet", "")
+	if !ok || network.CatchpointURL != "http://localhost/latest" {
+		t.Errorf("expected the custom network, got %+v", network)
+	}
+	network, ok = Registry.Find("mainnet-v1.0", "AAAA")
+	if !ok || network.LoraNetwork != "mainnet-fork" {
+		t.Errorf("expected the custom network to override mainnet, got %+v", network)
+	}
+	network, ok = Registry.Find("mainnet-v1.0", "wGHE2Pwdvd7S12BL5FaOP20EGYesN73ktiC1qzkkit8=")
+	if !ok || network.LoraNetwork != "mainnet" {
+		t.Errorf("expected the default mainnet, got %+v", network)
+	}
+	_, ok = Registry.Find("tuinet-v1", "")
+	if !ok {
+		t.Error("expected the default networks to stay registered")
+	}
+}
+
+// catchpointHttp answers every catchpoint URL with its own URL as the label
+type catchpointHttp struct {
+	HttpPkgInterface
+}
+
+func (catchpointHttp) Get(url string) (*http.Response, error) {
+	return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(url + "\n"))}, nil
+}
+
+func Test_RegisterNetworksShadowing(t *testing.T) {
+	defer func() { Registry = DefaultNetworks }()
+	mainnetHash := "wGHE2Pwdvd7S12BL5FaOP20EGYesN73ktiC1qzkkit8="
+
+	// A fork registered with the genesis ID of mainnet and without catchpoints
+	RegisterNetworks(Network{GenesisID: "mainnet-v1.0", GenesisHash: "AAAA", LoraNetwork: "mainnet-fork"})
+
+	response, err := GetLatestCatchpointWithResponse(new(catchpointHttp), "mainnet-v1.0", mainnetHash)
+	if err != nil || response.JSON200 != string(MainNet) {
+		t.Errorf("expected the catchpoint of mainnet, got %q (%v)", response.JSON200, err)
+	}
+	_, err = GetLatestCatchpointWithResponse(new(catchpointHttp), "mainnet-v1.0", "AAAA")
+	if !errors.Is(err, ErrInvalidNetwork) {
+		t.Errorf("expected the fork to have no catchpoints, got %v", err)
+	}
+}
@@ -0,0 +1,65 @@
+package alerts
+
+import (
+	cmdutils "github.com/algorandfoundation/nodekit/cmd/utils"
+	"github.com/algorandfoundation/nodekit/internal/alerts"
+	"github.com/algorandfoundation/nodekit/internal/algod/utils"
+	"github.com/algorandfoundation/nodekit/internal/system"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// dataDir path to the algorand data folder
+	dataDir string = ""
+
+	// Short provides a brief description of the alerts command.
+	Short = "Participation key expiry alerts"
+
+	// Long provides a detailed description of the alerts command and its settings.
+	Long = lipgloss.JoinVertical(
+		lipgloss.Left,
+		style.Purple(style.BANNER),
+		"",
+		style.Bold(Short),
+		"",
+		style.BoldUnderline("Overview:"),
+		"Checks when the participation key of each account expires and sends notifications",
+		"when a key crosses a threshold. The default thresholds are 14 days, 3 days and expired.",
+		"",
+		"Thresholds, webhooks, SMTP email and command hooks are configured in the",
+		"\"Alerts\" section of the NodeKit settings file (~/.nodekit.json).",
+		"The alerts already sent are stored per node in the "+alerts.DirName+" directory of your home directory.",
+	)
+
+	// Cmd is the parent command of the alert subcommands.
+	Cmd = &cobra.Command{
+		Use:   "alerts",
+		Short: Short,
+		Long:  Long,
+	}
+)
+
+// newNotifier creates a Notifier from the NodeKit settings, with the alerts already sent for the node.
+func newNotifier(resolvedDir string) (*alerts.Notifier, error) {
+	settings, err := utils.GetNodekitSettings()
+	if err != nil {
+		return nil, err
+	}
+	notifier, err := alerts.NewNotifier(settings.Alerts, new(system.Clock))
+	if err != nil {
+		return nil, err
+	}
+	path, err := alerts.Path(resolvedDir, cmdutils.Profile)
+	if err != nil {
+		return nil, err
+	}
+	notifier.Sent, err = alerts.LoadSent(path)
+	return notifier, err
+}
+
+func init() {
+	Cmd.AddCommand(checkCmd)
+	Cmd.AddCommand(watchCmd)
+}
@@ -0,0 +1,84 @@
+package alerts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/algorandfoundation/nodekit/cmd/utils"
+	"github.com/algorandfoundation/nodekit/internal/alerts"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
+	"github.com/spf13/cobra"
+)
+
+// dryRun prints the alerts without notifying the sinks
+var dryRun bool
+
+var checkShort = "Check participation keys once and send alerts"
+
+var checkLong = lipgloss.JoinVertical(
+	lipgloss.Left,
+	style.Purple(style.BANNER),
+	"",
+	style.Bold(checkShort),
+	"",
+	style.BoldUnderline("Overview:"),
+	"Evaluates every account with a participation key against the alert thresholds",
+	"and sends the matching alerts to the configured sinks. Suitable for cron jobs.",
+	"Exits with an error when any alert is raised.",
+	"",
+	style.Yellow.Render("This requires the daemon to be running."),
+)
+
+// checkCmd evaluates the node once and reports every crossed threshold.
+var checkCmd = utils.WithAlgodFlags(&cobra.Command{
+	Use:          "check",
+	Short:        checkShort,
+	Long:         checkLong,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		state, err := utils.GetStateModel(ctx, cmd, dataDir)
+		if err != nil {
+			return err
+		}
+		notifier, err := newNotifier(state.DataDir)
+		if err != nil {
+			return err
+		}
+
+		raised := notifier.Evaluate(state.Snapshot())
+		for _, alert := range raised {
+			if alert.Level == alerts.CriticalLevel {
+				log.Error(alert.Message)
+			} else {
+				log.Warn(alert.Message)
+			}
+		}
+
+		// Without sinks nothing is notified, the alerts are not recorded as sent
+		if !dryRun && len(notifier.Sinks) == 0 && len(raised) > 0 {
+			log.Warn(style.Yellow.Render("No alert sinks are configured"))
+		}
+		if !dryRun && len(notifier.Sinks) > 0 {
+			// Also forgets the keys that no longer raise an alert
+			_, err = notifier.Notify(ctx, raised)
+			if saveErr := notifier.Sent.Save(); saveErr != nil {
+				log.Error(saveErr)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		if len(raised) == 0 {
+			log.Info(style.Green.Render("All participation keys are within the alert thresholds"))
+			return nil
+		}
+		return fmt.Errorf("%d participation key alert(s) raised", len(raised))
+	},
+}, &dataDir)
+
+func init() {
+	checkCmd.Flags().BoolVar(&dryRun, "dry-run", false, style.LightBlue("Print the alerts without sending notifications"))
+}
@@ -0,0 +1,89 @@
+package alerts
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/algorandfoundation/nodekit/cmd/utils"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/system"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
+	"github.com/spf13/cobra"
+)
+
+var watchShort = "Watch participation keys and send alerts"
+
+var watchLong = lipgloss.JoinVertical(
+	lipgloss.Left,
+	style.Purple(style.BANNER),
+	"",
+	style.Bold(watchShort),
+	"",
+	style.BoldUnderline("Overview:"),
+	"Runs in the foreground and checks the participation keys every time an account changes",
+	"or the metrics are refreshed. Each account is notified once per threshold for the same key.",
+	"",
+	style.Yellow.Render("This requires the daemon to be running."),
+)
+
+// watchCmd keeps a watcher on the node and notifies the sinks as thresholds are crossed.
+var watchCmd = utils.WithAlgodFlags(&cobra.Command{
+	Use:          "watch",
+	Short:        watchShort,
+	Long:         watchLong,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		state, err := utils.GetStateModel(ctx, cmd, dataDir)
+		if err != nil {
+			return err
+		}
+		notifier, err := newNotifier(state.DataDir)
+		if err != nil {
+			return err
+		}
+		if len(notifier.Sinks) == 0 {
+			log.Warn(style.Yellow.Render("No alert sinks are configured, alerts will only be logged"))
+		}
+
+		check := func(snapshot algod.Snapshot) {
+			sent, err := notifier.Check(ctx, snapshot)
+			for _, alert := range sent {
+				log.Warn(alert.Message)
+			}
+			if err != nil {
+				log.Error(err)
+			}
+			// Only the alerts delivered to a sink are remembered across runs
+			if len(notifier.Sinks) > 0 {
+				err = notifier.Sent.Save()
+				if err != nil {
+					log.Error(err)
+				}
+			}
+		}
+
+		// The state is only read before the watcher starts and from the event snapshots afterward
+		log.Info(style.Green.Render("Watching participation keys for expiry"))
+		check(state.Snapshot())
+
+		watcher := algod.NewWatcher(state, new(system.Clock))
+		events := watcher.Subscribe(ctx, 1)
+		go watcher.Run(ctx)
+		for event := range events {
+			switch event.Type {
+			case algod.AccountChangedEvent, algod.MetricsRefreshedEvent:
+				check(event.Snapshot)
+			case algod.NodeDownEvent:
+				log.Error(event.Err)
+			}
+		}
+		return nil
+	},
+}, &dataDir)
@@ -0,0 +1,150 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/algorandfoundation/nodekit/api"
+	cmdutils "github.com/algorandfoundation/nodekit/cmd/utils"
+	"github.com/algorandfoundation/nodekit/cmd/utils/explanations"
+	"github.com/algorandfoundation/nodekit/internal/spec"
+	"github.com/algorandfoundation/nodekit/internal/system"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"github.com/charmbracelet/log"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// applyFile is the path of the node spec
+	applyFile string
+
+	// applyPlan prints the changes without making them
+	applyPlan bool
+
+	// applyYes makes the changes without asking
+	applyYes bool
+)
+
+// applyShort provides a brief description of the apply command.
+var applyShort = "Converge the node to a spec file"
+
+// applyLong provides a detailed description of the apply command.
+var applyLong = lipgloss.JoinVertical(
+	lipgloss.Left,
+	style.Purple(style.BANNER),
+	"",
+	style.Bold(applyShort),
+	"",
+	style.BoldUnderline("Overview:"),
+	"Reads a YAML or JSON node spec and makes the changes the node needs to match it:",
+	"installs or upgrades algod, writes the genesis, points the service at the data directory,",
+	"writes the systemd [Service] settings, updates config.json and logging.config,",
+	"restarts the node and generates the missing participation keys.",
+	"Everything left out of the spec is kept as it is, applying the same spec again changes nothing.",
+	"The keys of a node which is not synced yet are reported as pending, apply the spec again once it is synced.",
+	"",
+	"The changes and the diff of the files are printed first, and confirmed unless --yes is passed.",
+	"",
+	style.BoldUnderline("Spec:"),
+	"  network: mainnet",
+	"  dataDir: /var/lib/algorand",
+	"  algod:",
+	"    channel: stable",
+	"    version: 3.26.0",
+	"  service:",
+	"    LimitNOFILE: 65536",
+	"  config:",
+	"    EnableP2PHybridMode: true",
+	"  telemetry:",
+	"    enable: true",
+	"    name: my-node",
+	"    uri: "+string(cmdutils.NodelyTelemetryProvider),
+	"  accounts:",
+	"    - address: <account address>",
+	"      rounds: 3000000",
+	"",
+	style.BoldUnderline("Examples:"),
+	"  nodekit apply -f node.yaml --plan",
+	"  nodekit apply -f node.yaml",
+	"  nodekit apply -f node.yaml --yes",
+	"",
+	style.Yellow.Render("Applying a spec requires sudo, generating keys can take several minutes."),
+)
+
+// newApplyCmd returns a command that converges the machine to a node spec.
+func newApplyCmd(service *cmdutils.Service) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "apply",
+		Short:        applyShort,
+		Long:         applyLong,
+		Args:         cobra.NoArgs,
+		PreRunE:      cmdutils.NeedsLocalNode,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			nodeSpec, err := spec.Load(applyFile)
+			if err != nil {
+				return err
+			}
+			env := &spec.Env{
+				Service:  service.Interface,
+				HttpPkg:  new(api.HttpPkg),
+				Interval: CheckAlgodInterval,
+				Timeout:  CheckAlgodTimeout,
+			}
+			changes, err := spec.Plan(ctx, nodeSpec, env)
+			if err != nil {
+				return err
+			}
+			if len(changes) == 0 {
+				fmt.Fprintln(cmd.OutOrStdout(), "The node matches the spec, nothing to change")
+				return nil
+			}
+			printApplyPlan(cmd, changes)
+			if applyPlan {
+				return nil
+			}
+
+			if !system.IsSudo() {
+				return errors.New(explanations.NotSuperUserErrorMsg)
+			}
+			if !applyYes && !cmdutils.Prompt("Apply these changes?") {
+				return errors.New("the changes were not applied")
+			}
+			log.Warn(style.Yellow.Render(explanations.SudoWarningMsg))
+			err = spec.Apply(ctx, changes, env)
+			if err != nil {
+				return err
+			}
+			log.Info(style.Green.Render("The node matches the spec"))
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&applyFile, "file", "f", "", style.LightBlue("Path of the node spec, YAML or JSON"))
+	cmd.Flags().BoolVar(&applyPlan, "plan", false, style.LightBlue("Print the changes without making them"))
+	cmd.Flags().BoolVarP(&applyYes, "yes", "y", false, style.Yellow.Render("Make the changes without asking"))
+	_ = cmd.MarkFlagRequired("file")
+	cmd.MarkFlagsMutuallyExclusive("plan", "yes")
+	return cmd
+}
+
+// printApplyPlan prints the changes followed by the diff of the files.
+func printApplyPlan(cmd *cobra.Command, changes []spec.Change) {
+	rows := make([][]string, 0, len(changes))
+	for _, change := range changes {
+		rows = append(rows, []string{change.Resource, style.Green.Render(change.Action), change.Detail})
+	}
+	fmt.Fprintln(cmd.OutOrStdout(), table.New().
+		Border(lipgloss.HiddenBorder()).
+		Headers("Resource", "Action", "Detail").
+		Rows(rows...).
+		String())
+	for _, change := range changes {
+		if change.IsFile() {
+			cmdutils.PrintDiff(cmd.OutOrStdout(), change.Resource, change.Before, change.After)
+		}
+	}
+}
@@ -2,19 +2,22 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/algorandfoundation/nodekit/api"
+	cmdutils "github.com/algorandfoundation/nodekit/cmd/utils"
 	"github.com/algorandfoundation/nodekit/cmd/utils/explanations"
 	"github.com/algorandfoundation/nodekit/internal/algod"
-	"github.com/algorandfoundation/nodekit/internal/algod/utils"
-	"github.com/algorandfoundation/nodekit/ui/app"
-	"github.com/algorandfoundation/nodekit/ui/bootstrap"
+	"github.com/algorandfoundation/nodekit/internal/bootstrap"
+	"github.com/algorandfoundation/nodekit/internal/system"
+	bootstrapui "github.com/algorandfoundation/nodekit/ui/bootstrap"
 	"github.com/algorandfoundation/nodekit/ui/style"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/glamour"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
 	"github.com/charmbracelet/log"
 	"github.com/spf13/cobra"
 )
@@ -22,8 +25,6 @@ import (
 const CheckAlgodInterval = 10 * time.Second
 const CheckAlgodTimeout = 2 * time.Minute
 
-var CatchpointLagThreshold int = 30_000
-
 // bootstrapCmdShort provides a brief description of the "bootstrap" command to initialize a fresh Algorand node.
 var bootstrapCmdShort = "Initialize a fresh node"
 
@@ -38,7 +39,17 @@ var bootstrapCmdLong = lipgloss.JoinVertical(
 	"Get up and running with a fresh Algorand node.",
 	"Uses the local package manager to install Algorand, and then starts the node and preforms a Fast-Catchup.",
 	"",
+	"Each step is skipped when it is already done. The progress is recorded in ~/"+bootstrap.FileName+",",
+	"a failed bootstrap continues from the failed step with --resume.",
+	"Use --yes or --answers for unattended installs, the answers file is YAML or JSON with install and catchup keys.",
+	"",
 	style.Yellow.Render("Note: This command only supports the default data directory, /var/lib/algorand"),
+	"",
+	style.BoldUnderline("Examples:"),
+	"  nodekit bootstrap --plan",
+	"  nodekit bootstrap --yes",
+	"  nodekit bootstrap --answers answers.yaml",
+	"  nodekit bootstrap --resume",
 )
 
 var tutorial = `# Welcome!
@@ -47,180 +58,188 @@ This is the beginning of your adventure into running an Algorand node!
 
 `
 
-var FailedToAutoStartMessage = "Failed to start Algorand automatically."
-
-// bootstrapCmd defines the "debug" command used to display diagnostic information for developers, including debug data.
-var bootstrapCmd = &cobra.Command{
-	Use:          "bootstrap",
-	Short:        bootstrapCmdShort,
-	Long:         bootstrapCmdLong,
-	SilenceUsage: true,
-	RunE: func(cmd *cobra.Command, args []string) error {
-		var client *api.ClientWithResponses
-		// Create the Bootstrap TUI
-		model := bootstrap.NewModel()
-		log.Warn(style.Yellow.Render(explanations.SudoWarningMsg))
-		// Try to launch the TUI if it's already running and configured
-		if algod.IsInitialized(algodData) {
-			// Parse the data directory
-			dir, err := algod.GetDataDir(algodData)
-			if err != nil {
-				log.Fatal(err)
-			}
+var (
+	// bootstrapResume continues the last bootstrap from its failed step
+	bootstrapResume bool
+
+	// bootstrapPlan prints the steps without running them
+	bootstrapPlan bool
+
+	// bootstrapYes answers yes to every question
+	bootstrapYes bool
 
-			// Wait for the client to respond
-			client, err = algod.WaitForClient(context.Background(), dir, CheckAlgodInterval, CheckAlgodTimeout)
+	// bootstrapAnswers is the path of an answers file replacing the questions
+	bootstrapAnswers string
+)
+
+// newBootstrapCmd returns a command that runs the bootstrap steps, asking the questions with a TUI unless they are answered by the flags.
+func newBootstrapCmd(service *cmdutils.Service) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "bootstrap",
+		Short:        bootstrapCmdShort,
+		Long:         bootstrapCmdLong,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			steps := bootstrap.DefaultSteps()
+			env := &bootstrap.Env{
+				Service:  service.Interface,
+				DataDir:  algodData,
+				HttpPkg:  new(api.HttpPkg),
+				Time:     new(system.Clock),
+				Interval: CheckAlgodInterval,
+				Timeout:  CheckAlgodTimeout,
+			}
+			statePath, err := bootstrap.Path()
 			if err != nil {
-				log.Fatal(err)
+				return err
 			}
-
-			// Fetch the latest status
-			var resp *api.GetStatusResponse
-			resp, err = client.GetStatusWithResponse(context.Background())
-			// This should not happen, we waited for a status already
+			state, err := bootstrap.Load(statePath)
 			if err != nil {
-				log.Fatal(err)
+				return err
 			}
-			if resp.StatusCode() != 200 {
-				log.Fatal(fmt.Sprintf("Failed to connect to the node at %s", dir))
+			if bootstrapResume && state.Answers == nil {
+				return errors.New("there is no bootstrap to resume")
 			}
 
-			// Execute the TUI if we are caught up.
-			// TODO: check the delta to see if it is necessary,
-			if resp.JSON200.CatchupTime == 0 {
-				err = runTUI(RootCmd, dir, false, RootCmd.Version)
+			// The questions are answered by the resumed bootstrap, the flags or the TUI
+			unattended := bootstrapYes || bootstrapAnswers != ""
+			switch {
+			case bootstrapResume:
+				env.Answers = *state.Answers
+			case bootstrapAnswers != "":
+				env.Answers, err = bootstrap.LoadAnswers(bootstrapAnswers)
 				if err != nil {
-					log.Fatal(err)
+					return err
 				}
-				return nil
+			case bootstrapYes:
+				env.Answers = bootstrap.DefaultAnswers
+			default:
+				env.Answers = bootstrap.DefaultAnswers
 			}
-		}
 
-		// Exit the application in an invalid state
-		if algod.IsInstalled() && !algod.IsService() {
-			dataDir, _ := algod.GetDataDir(algodData)
-			if dataDir == "" {
-				dataDir = "<Path to data directory>"
+			// A new bootstrap runs every step which is not done, a resumed one skips the completed steps
+			if !bootstrapResume {
+				state.Reset(env.Answers)
 			}
-			log.Warn("algorand is installed, but not running as a service. Continue at your own risk!")
-			log.Warn(fmt.Sprintf("try connecting to the node with: ./nodekit -d %s", dataDir))
-			log.Fatal("invalid state, exiting")
-		}
-
-		// Render the welcome text
-		r, _ := glamour.NewTermRenderer(
-			glamour.WithAutoStyle(),
-		)
-		fmt.Print(style.Purple(style.BANNER))
-		out, err := r.Render(tutorial)
-		if err != nil {
-			return err
-		}
-		fmt.Println(out)
-
-		// Ensure it the service is started,
-		// in this case we won't be able to query state without the node running
-		if algod.IsInstalled() && algod.IsService() && !algod.IsRunning(algodData) {
-			log.Debug("Algorand is installed, but not running. Attempting to start it automatically.")
-			log.Warn(style.Yellow.Render(explanations.SudoWarningMsg))
-			err := algod.Start()
-			if err != nil {
-				log.Error(FailedToAutoStartMessage)
-				log.Fatal(err)
+			if bootstrapPlan {
+				return printBootstrapPlan(cmd, bootstrap.Plan(ctx, steps, env, state))
 			}
 
-		}
-
-		// Prefill questions
-		if algod.IsInstalled() {
-			model.BootstrapMsg.Install = false
-			model.Question = bootstrap.CatchupQuestion
-		}
-		// Run the Bootstrap TUI
-		p := tea.NewProgram(model)
-		var msg *app.BootstrapMsg
-		go func() {
-			for {
-				val := <-model.Outside
-				switch val.(type) {
-				case app.BootstrapMsg:
-					msgVal := val.(app.BootstrapMsg)
-					msg = &msgVal
+			if !unattended && !bootstrapResume {
+				// Launch the TUI when there is nothing left to do
+				if isBootstrapped(bootstrap.Plan(ctx, steps, env, state)) {
+					dataDir, err := algod.GetDataDir(algodData)
+					if err != nil {
+						return err
+					}
+					return runTUI(RootCmd, dataDir, false, RootCmd.Version)
+				}
+				answers, err := askBootstrapQuestions(service)
+				// The TUI was closed without answering
+				if err != nil || answers == nil {
+					return err
 				}
+				env.Answers = *answers
+				state.Reset(env.Answers)
 			}
-		}()
-		if _, err := p.Run(); err != nil {
-			log.Fatal(err)
-		}
-
-		// If the pointer is empty, return (should not happen)
-		if msg == nil {
-			return nil
-		}
 
-		// User Answer for Install Question
-		if msg.Install {
 			log.Warn(style.Yellow.Render(explanations.SudoWarningMsg))
-
-			// Run the installer
-			err := algod.Install()
+			err = bootstrap.Run(ctx, steps, env, state)
 			if err != nil {
+				log.Error("Continue from the failed step with: nodekit bootstrap --resume")
 				return err
 			}
-		} else {
-			// This should not happen but just in case, ensure it is running
-			if !algod.IsRunning(algodData) {
-				log.Info(style.Green.Render("Starting Algod 🚀"))
-				err := algod.Start()
-				if err != nil {
-					log.Fatal(err)
-				}
+			log.Info(style.Green.Render("Algorand node bootstrapped successfully 🎉"))
+			if unattended {
+				return nil
 			}
-		}
-
-		// Parse the data directory
-		dataDir, err := algod.GetDataDir(algodData)
-		if err != nil {
-			log.Fatal(err)
-		}
-
-		// Wait for the client to respond
-		client, err = algod.WaitForClient(context.Background(), dataDir, CheckAlgodInterval, CheckAlgodTimeout)
-		if err != nil {
-			log.Fatal(err)
-		}
-
-		if !algod.IsRunning(algodData) {
-			log.Fatal("algod is not running. Something went wrong with installation")
-		}
-
-		log.Info(style.Green.Render("Algorand node started successfully 🎉"))
-
-		// User answer for catchup question
-		if msg.Catchup {
-			ctx := context.Background()
-			httpPkg := new(api.HttpPkg)
-			network, err := utils.GetNetworkFromDataDir(dataDir)
+			dataDir, err := algod.GetDataDir(algodData)
 			if err != nil {
 				return err
 			}
-			// Get the latest catchpoint
-			catchpoint, _, err := algod.GetLatestCatchpoint(httpPkg, network)
-			if err != nil && err == api.ErrInvalidNetwork {
-				log.Fatal("This network does not support fast-catchup.")
-			} else {
-				log.Info(style.Green.Render("Latest Catchpoint: " + catchpoint))
-			}
+			return runTUI(RootCmd, dataDir, false, RootCmd.Version)
+		},
+	}
+	cmd.Flags().BoolVar(&bootstrapResume, "resume", false, style.LightBlue("Continue the last bootstrap from its failed step with its saved answers"))
+	cmd.Flags().BoolVar(&bootstrapPlan, "plan", false, style.LightBlue("Print the steps of the bootstrap without running them, the questions are assumed answered yes"))
+	cmd.Flags().BoolVarP(&bootstrapYes, "yes", "y", false, style.LightBlue("Answer yes to every question and do not launch the TUI"))
+	cmd.Flags().StringVar(&bootstrapAnswers, "answers", "", style.LightBlue("Read the answers from a YAML or JSON file and do not launch the TUI"))
+	cmd.MarkFlagsMutuallyExclusive("yes", "answers")
+	return cmd
+}
 
-			// Start catchup with round threshold
-			res, _, err := algod.StartCatchup(ctx, client, catchpoint, &api.StartCatchupParams{Min: &CatchpointLagThreshold})
-			if err != nil {
-				log.Fatal(err)
-			}
-			log.Info(style.Green.Render(res))
+// isBootstrapped is true when every step of the plan is skipped.
+func isBootstrapped(plan []bootstrap.PlanItem) bool {
+	for _, item := range plan {
+		if item.Action == bootstrap.RunAction {
+			return false
+		}
+	}
+	return true
+}
 
+// askBootstrapQuestions renders the welcome text and asks the bootstrap questions with a TUI.
+// It returns nil when the TUI is closed without answering.
+func askBootstrapQuestions(service *cmdutils.Service) (*bootstrap.Answers, error) {
+	r, _ := glamour.NewTermRenderer(
+		glamour.WithAutoStyle(),
+	)
+	fmt.Print(style.Purple(style.BANNER))
+	out, err := r.Render(tutorial)
+	if err != nil {
+		return nil, err
+	}
+	fmt.Println(out)
+
+	// Prefill questions
+	model := bootstrapui.NewModel()
+	if service.IsInstalled() {
+		model.BootstrapMsg.Install = false
+		model.Question = bootstrapui.CatchupQuestion
+	}
+	// Run the Bootstrap TUI, the answers are read from its final model
+	p := tea.NewProgram(model)
+	// The TUI emits the answers before it quits, drain them until it exits
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			select {
+			case <-model.Outside:
+			case <-done:
+				return
+			}
 		}
+	}()
+	final, err := p.Run()
+	if err != nil {
+		return nil, err
+	}
+	answered, ok := final.(bootstrapui.Model)
+	if !ok || answered.Question != bootstrapui.WaitingQuestion {
+		return nil, nil
+	}
+	return &bootstrap.Answers{Install: answered.BootstrapMsg.Install, Catchup: answered.BootstrapMsg.Catchup}, nil
+}
 
-		return runTUI(RootCmd, dataDir, false, RootCmd.Version)
-	},
+// printBootstrapPlan prints what the bootstrap would do with every step.
+func printBootstrapPlan(cmd *cobra.Command, plan []bootstrap.PlanItem) error {
+	rows := make([][]string, 0, len(plan))
+	for _, item := range plan {
+		action := string(item.Action)
+		switch item.Action {
+		case bootstrap.RunAction:
+			action = style.Green.Render(action)
+		case bootstrap.SkipAction:
+			action = style.Yellow.Render(action)
+		}
+		rows = append(rows, []string{item.Step, action, item.Description, item.Reason})
+	}
+	fmt.Fprintln(cmd.OutOrStdout(), table.New().
+		Border(lipgloss.HiddenBorder()).
+		Headers("Step", "Action", "Description", "Reason").
+		Rows(rows...).
+		String())
+	return nil
 }
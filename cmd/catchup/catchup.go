@@ -2,6 +2,11 @@ package catchup
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
 	"github.com/algorandfoundation/nodekit/api"
 	"github.com/algorandfoundation/nodekit/cmd/utils"
 	"github.com/algorandfoundation/nodekit/internal/algod"
@@ -15,8 +20,14 @@ var (
 	// dataDir path to the algorand data folder
 	dataDir string = ""
 
-	// defaultLag represents the default minimum catchup delay in milliseconds for the Fast Catchup process.
-	defaultLag int = 30_000
+	// force starts a fast-catchup even when it is not recommended.
+	force bool = false
+
+	// verify requires a quorum of catchpoint sources to agree on the catchpoint.
+	verify bool = false
+
+	// SyncRateSample is how long the sync rate of the node is observed for the catchup estimate.
+	SyncRateSample = 5 * time.Second
 
 	// cmdLong provides a detailed description of the Fast-Catchup feature, explaining its purpose and expected sync durations.
 	cmdLong = lipgloss.JoinVertical(
@@ -28,6 +39,7 @@ var (
 		style.BoldUnderline("Overview:"),
 		"The entire process should sync a node in minutes rather than hours or days.",
 		"Actual sync times may vary depending on the number of accounts, number of blocks and the network.",
+		"Private networks are supported by adding their catchpoint URL to the Networks of ~/.nodekit.json.",
 		"",
 		style.Yellow.Render("Note: Not all networks support Fast-Catchup."),
 	)
@@ -41,7 +53,7 @@ var (
 			// Create Clients
 			ctx := context.Background()
 			httpPkg := new(api.HttpPkg)
-			client, err := algod.GetClient(dataDir)
+			client, resolvedDir, err := utils.GetClient(dataDir)
 			cobra.CheckErr(err)
 
 			// Fetch Status from Node
@@ -52,14 +64,19 @@ var (
 			}
 
 			// Get the Latest Catchpoint
-			catchpoint, _, err := algod.GetLatestCatchpoint(httpPkg, status.Network)
+			catchpoint, err := latestCatchpoint(ctx, httpPkg, status.Network, status.GenesisHash)
 			if err != nil {
 				log.Fatal(err)
 			}
 			log.Info(style.Green.Render("Latest Catchpoint: " + catchpoint))
 
-			// Submit the Catchpoint to the Algod Node, using the StartCatchupParams to skip
-			res, _, err := algod.StartCatchup(ctx, client, catchpoint, &api.StartCatchupParams{Min: &defaultLag})
+			// Only catchup when it is faster than syncing
+			if !shouldCatchup(ctx, status, resolvedDir) {
+				return
+			}
+
+			// Submit the Catchpoint to the Algod Node
+			res, _, err := algod.StartCatchup(ctx, client, catchpoint, nil)
 			if err != nil {
 				log.Fatal(err)
 			}
@@ -69,8 +86,59 @@ var (
 	}, &dataDir)
 )
 
+// shouldCatchup estimates a fast-catchup against a normal sync and logs the recommendation.
+// A catchup is started when it is recommended, when it could not be estimated or when forced.
+func shouldCatchup(ctx context.Context, status algod.Status, dataDir string) bool {
+	log.Info(style.Green.Render(fmt.Sprintf("Measuring the sync rate for %s...", SyncRateSample)))
+	estimate, err := algod.GetCatchupEstimate(ctx, status, dataDir, SyncRateSample)
+	if err != nil {
+		log.Warn(style.Yellow.Render("Unable to estimate the catchup: " + err.Error()))
+		return true
+	}
+	if estimate.Recommended {
+		log.Info(style.Green.Render("Fast-catchup recommended, " + estimate.Reason))
+		return true
+	}
+	if force {
+		log.Warn(style.Yellow.Render("Fast-catchup not recommended, " + estimate.Reason))
+		return true
+	}
+	log.Info(style.Yellow.Render("Skipping fast-catchup, " + estimate.Reason))
+	log.Info("Use --force to start it anyway.")
+	return false
+}
+
+// latestCatchpoint returns the latest catchpoint of the network,
+// verified against the catchpoint sources of the settings when verification is enabled.
+func latestCatchpoint(ctx context.Context, httpPkg api.HttpPkgInterface, network string, genesisHash string) (string, error) {
+	if !verify && !algod.IsCatchpointVerificationEnabled() {
+		catchpoint, _, err := algod.GetLatestCatchpoint(httpPkg, network, genesisHash)
+		return catchpoint, err
+	}
+	verification, err := algod.GetVerifiedCatchpoint(ctx, httpPkg, network, genesisHash)
+	others := make([]string, 0, len(verification.Others))
+	for name := range verification.Others {
+		others = append(others, name)
+	}
+	sort.Strings(others)
+	for _, name := range others {
+		log.Warn(style.Yellow.Render(fmt.Sprintf("Source %s reported %s", name, verification.Others[name])))
+	}
+	if err != nil {
+		return "", err
+	}
+	log.Info(style.Green.Render(fmt.Sprintf("Catchpoint confirmed by %d of %d required sources: %s",
+		len(verification.Agreed), verification.Quorum, strings.Join(verification.Agreed, ", "))))
+	return verification.Catchpoint, nil
+}
+
 func init() {
+	Cmd.Flags().BoolVarP(&force, "force", "f", false, style.LightBlue("start a fast-catchup even when it is not recommended"))
+	startCmd.Flags().BoolVarP(&force, "force", "f", false, style.LightBlue("start a fast-catchup even when it is not recommended"))
+	Cmd.Flags().BoolVar(&verify, "verify", false, style.LightBlue("require a quorum of catchpoint sources to agree on the catchpoint"))
+	startCmd.Flags().BoolVar(&verify, "verify", false, style.LightBlue("require a quorum of catchpoint sources to agree on the catchpoint"))
 	Cmd.AddCommand(startCmd)
 	Cmd.AddCommand(stopCmd)
 	Cmd.AddCommand(debugCmd)
+	Cmd.AddCommand(statusCmd)
 }
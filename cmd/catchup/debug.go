@@ -25,6 +25,9 @@ type Catchpoint struct {
 
 	// CatchpointScore scores the node based on how well it can preform a catchup
 	CatchpointScore int `json:"score"`
+
+	// Estimate compares a fast-catchup with a normal sync, nil when it could not be estimated
+	Estimate *algod.CatchupEstimate `json:"estimate"`
 }
 
 // DebugInfo represents the debugging information of the catchpoint service.
@@ -58,14 +61,14 @@ var debugCmd = utils.WithAlgodFlags(&cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		ctx := context.Background()
 		httpPkg := new(api.HttpPkg)
-		client, err := algod.GetClient(dataDir)
+		client, resolvedDir, err := utils.GetClient(dataDir)
 		cobra.CheckErr(err)
 
 		status, response, err := algod.NewStatus(ctx, client, httpPkg)
 		utils.WithInvalidResponsesExplanations(err, response, cmd.UsageString())
 
 		var isSupported bool
-		catchpoint, _, err := algod.GetLatestCatchpoint(httpPkg, status.Network)
+		catchpoint, _, err := algod.GetLatestCatchpoint(httpPkg, status.Network, status.GenesisHash)
 		if err != nil && err == api.ErrInvalidNetwork {
 			isSupported = false
 		} else {
@@ -78,9 +81,17 @@ var debugCmd = utils.WithAlgodFlags(&cobra.Command{
 				IsRunning:        status.State == algod.FastCatchupState,
 				IsSupported:      isSupported,
 				LatestCatchpoint: &catchpoint,
-				CatchpointScore:  0,
 			},
 		}
+		if isSupported && catchpoint != "" {
+			estimate, err := algod.GetCatchupEstimate(ctx, status, resolvedDir, SyncRateSample)
+			if err != nil {
+				log.Warn(style.Yellow.Render("Unable to estimate the catchup: " + err.Error()))
+			} else {
+				info.CatchpointScore = estimate.Score
+				info.Estimate = &estimate
+			}
+		}
 
 		data, err := json.MarshalIndent(info, "", " ")
 		if err != nil {
@@ -20,6 +20,9 @@ var startCmdLong = lipgloss.JoinVertical(
 	"",
 	style.BoldUnderline("Overview:"),
 	"Starting a catchup will sync the node to the latest catchpoint.",
+	"The catchup is skipped when syncing the remaining rounds is estimated to be faster, use --force to start it anyway.",
+	"Use --verify to require a quorum of the catchpoint sources in ~/.nodekit.json to agree on the catchpoint.",
+	"Trusted nodes used as sources must track catchpoints, with Archival or CatchpointTracking set to 1 or 2.",
 	"Actual sync times may vary depending on the number of accounts, number of blocks and the network.",
 	"",
 	style.Yellow.Render("Note: Not all networks support Fast-Catchup."),
@@ -34,7 +37,7 @@ var startCmd = utils.WithAlgodFlags(&cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		ctx := context.Background()
 		httpPkg := new(api.HttpPkg)
-		client, err := algod.GetClient(dataDir)
+		client, resolvedDir, err := utils.GetClient(dataDir)
 		cobra.CheckErr(err)
 
 		status, response, err := algod.NewStatus(ctx, client, httpPkg)
@@ -45,11 +48,17 @@ var startCmd = utils.WithAlgodFlags(&cobra.Command{
 		}
 
 		// Get the latest catchpoint
-		catchpoint, _, err := algod.GetLatestCatchpoint(httpPkg, status.Network)
+		catchpoint, err := latestCatchpoint(ctx, httpPkg, status.Network, status.GenesisHash)
 		if err != nil && err == api.ErrInvalidNetwork {
 			log.Fatal("This network does not support fast-catchup.")
-		} else {
-			log.Info(style.Green.Render("Latest Catchpoint: " + catchpoint))
+		} else if err != nil {
+			log.Fatal(err)
+		}
+		log.Info(style.Green.Render("Latest Catchpoint: " + catchpoint))
+
+		// Only catchup when it is faster than syncing
+		if !shouldCatchup(ctx, status, resolvedDir) {
+			return
 		}
 
 		// Start catchup
@@ -0,0 +1,195 @@
+package catchup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/cmd/utils"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/algod/progress"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"github.com/spf13/cobra"
+)
+
+// FollowInterval is the delay between two status checks of the status command with --follow.
+const FollowInterval = 5 * time.Second
+
+// statusOutput is the output format of the status command
+var statusOutput string
+
+// statusFollow keeps printing the progress until the catchup finishes
+var statusFollow bool
+
+// statusCmdShort provides a brief description of the status command.
+var statusCmdShort = "Display the progress of a fast catchup"
+
+// statusCmdLong provides a detailed description of the status command.
+var statusCmdLong = lipgloss.JoinVertical(
+	lipgloss.Left,
+	style.Purple(style.BANNER),
+	"",
+	style.Bold(statusCmdShort),
+	"",
+	style.BoldUnderline("Overview:"),
+	"Prints the rate and estimated time remaining of every catchup phase.",
+	"The progress is stored in the "+progress.DirName+" directory of your home directory,",
+	"so the rates survive restarts of NodeKit. The TUI records the progress as well.",
+	fmt.Sprintf("A catchup without progress for %s is reported as stalled.", progress.StallTimeout),
+	"",
+	style.BoldUnderline("Examples:"),
+	"  nodekit catchup status --follow",
+	"  nodekit catchup status -o json",
+)
+
+// statusCmd prints the progress of the fast catchup as a table, JSON or YAML.
+var statusCmd = utils.WithOutputFlag(utils.WithAlgodFlags(&cobra.Command{
+	Use:          "status",
+	Short:        statusCmdShort,
+	Long:         statusCmdLong,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if statusFollow && statusOutput == utils.YAMLOutput {
+			return fmt.Errorf("--follow does not support the yaml output format")
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		client, resolvedDir, err := utils.GetClient(dataDir)
+		if err != nil {
+			return err
+		}
+		status, response, err := algod.NewStatus(ctx, client, new(api.HttpPkg))
+		utils.WithInvalidResponsesExplanations(err, response, cmd.UsageString())
+		if err != nil {
+			return err
+		}
+
+		path, err := progress.Path(resolvedDir, utils.Profile)
+		if err != nil {
+			return err
+		}
+		tracker, err := progress.Load(path)
+		if err != nil {
+			return err
+		}
+
+		for {
+			now := time.Now()
+			if !tracker.Record(now, status) && tracker.Finished == nil {
+				fmt.Fprintln(cmd.OutOrStdout(), "The node is not performing a fast catchup")
+				return nil
+			}
+			err = tracker.Save()
+			if err != nil {
+				return err
+			}
+
+			report := tracker.Report(now)
+			err = printProgress(cmd, report)
+			if err != nil {
+				return err
+			}
+			if !statusFollow || report.Finished != nil {
+				return nil
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(FollowInterval):
+			}
+			status, response, err = status.Get(ctx)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				utils.WithInvalidResponsesExplanations(err, response, cmd.UsageString())
+				return err
+			}
+		}
+	},
+}, &dataDir), &statusOutput)
+
+// printProgress writes the report to the command output using the selected output format.
+func printProgress(cmd *cobra.Command, report progress.Report) error {
+	if statusOutput == utils.JSONOutput && statusFollow {
+		return json.NewEncoder(cmd.OutOrStdout()).Encode(report)
+	}
+	if statusOutput != utils.TableOutput {
+		data, err := utils.Marshal(statusOutput, report)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(cmd.OutOrStdout(), string(data))
+		return err
+	}
+
+	state := style.Green.Render("running")
+	switch {
+	case report.Finished != nil:
+		state = style.Green.Render("finished")
+	case report.Stalled:
+		state = style.Red.Render(fmt.Sprintf("stalled, no progress since %s", report.LastChange.Format(time.TimeOnly)))
+	}
+	eta := "unknown"
+	if report.Finished == nil && report.ETA > 0 {
+		eta = formatDuration(report.ETA)
+	}
+
+	rows := make([][]string, 0, len(report.Phases))
+	for _, phase := range report.Phases {
+		rate, phaseEta := "", ""
+		if phase.Rate > 0 {
+			rate = strconv.FormatFloat(phase.Rate, 'f', 1, 64) + "/s"
+			phaseEta = formatDuration(phase.ETA)
+		}
+		started, finished := "", ""
+		if phase.Started != nil {
+			started = phase.Started.Format(time.TimeOnly)
+		}
+		if phase.Finished != nil {
+			finished = phase.Finished.Format(time.TimeOnly)
+		}
+		rows = append(rows, []string{
+			string(phase.Phase),
+			fmt.Sprintf("%d / %d", phase.Done, phase.Total),
+			rate,
+			phaseEta,
+			started,
+			finished,
+		})
+	}
+
+	_, err := fmt.Fprintln(cmd.OutOrStdout(), lipgloss.JoinVertical(
+		lipgloss.Left,
+		fmt.Sprintf("Catchpoint: %s", report.Catchpoint),
+		fmt.Sprintf("State:      %s", state),
+		fmt.Sprintf("Elapsed:    %s", formatDuration(report.Elapsed)),
+		fmt.Sprintf("ETA:        %s", eta),
+		table.New().
+			Border(lipgloss.HiddenBorder()).
+			Headers("Phase", "Progress", "Rate", "ETA", "Started", "Finished").
+			Rows(rows...).
+			String(),
+	))
+	return err
+}
+
+// formatDuration rounds a duration to the second for display.
+func formatDuration(d time.Duration) string {
+	return d.Round(time.Second).String()
+}
+
+func init() {
+	statusCmd.Flags().BoolVarP(&statusFollow, "follow", "f", false, style.LightBlue("Keep printing the progress until the catchup finishes"))
+}
@@ -1,8 +1,12 @@
 package configure
 
 import (
+	"errors"
 	"fmt"
-	"time"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
 
 	cmdutils "github.com/algorandfoundation/nodekit/cmd/utils"
 	"github.com/algorandfoundation/nodekit/cmd/utils/explanations"
@@ -18,6 +22,18 @@ import (
 
 var enableHybrid bool
 
+// algodSet are Key=Value pairs to write to config.json
+var algodSet []string
+
+// algodUnset are keys to remove from config.json
+var algodUnset []string
+
+// algodAll lists every option of the schema instead of only the configured ones
+var algodAll bool
+
+// algodYes writes the changes without asking
+var algodYes bool
+
 // algodShort provides a brief description of the algod command, emphasizing its role in installing algod files.
 var algodShort = "Configure options for the Algorand daemon."
 
@@ -30,109 +46,153 @@ var algodLong = lipgloss.JoinVertical(
 	"",
 	style.BoldUnderline("Overview:"),
 	"Modify various configuration options available for the Algorand daemon.",
+	"Options are validated against the algod configuration schema and the installed algod version,",
+	"keys NodeKit does not know are kept as they are. The node is restarted when the file changed.",
+	"The diff of config.json is printed and confirmed before writing, unless --yes is passed.",
+	"",
+	style.BoldUnderline("Examples:"),
+	"  nodekit configure algod --set Archival=true --set CatchupParallelBlocks=32",
+	"  nodekit configure algod --unset NetAddress --yes",
+	"  nodekit configure algod --all",
 )
 
 // TODO: Check if we should enforce sudo for this.
-// algodCmd is a Cobra command for managing Algorand configuration
-var algodCmd = cmdutils.WithAlgodFlags(&cobra.Command{
-	Use:   "algod",
-	Short: algodShort,
-	Long:  algodLong,
-	RunE: func(cmd *cobra.Command, args []string) error {
-		dataDir, err := algod.GetDataDir(algodData)
-		if err != nil {
-			log.Fatal(err)
-		}
-
-		// Current Node Configuration
-		currentConfig, _ := utils.GetConfigFromDataDir(dataDir)
-
-		// OR (`||`) additional flags for `hasFlags` when adding something new.
-		hasHybrid := cmd.Flags().Lookup("hybrid").Changed
-		hasFlags := hasHybrid
-
-		restartRequired := false
-
-		// Are we doing something? If not, just display the current configuration.
-		if hasFlags {
-			newConfig := &config.Config{
-				// EnableP2PHybridMode: currentConfig.EnableP2PHybridMode,
+// newAlgodCmd returns a Cobra command for managing Algorand configuration
+func newAlgodCmd(service *cmdutils.Service) *cobra.Command {
+	cmd := cmdutils.WithAlgodFlags(&cobra.Command{
+		Use:          "algod",
+		PreRunE:      cmdutils.NeedsLocalNode,
+		Short:        algodShort,
+		Long:         algodLong,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dataDir, err := algod.GetDataDir(algodData)
+			if err != nil {
+				log.Fatal(err)
 			}
 
-			if hasHybrid {
-				newConfig.EnableP2PHybridMode = &enableHybrid
-			}
+			// OR (`||`) additional flags for `hasFlags` when adding something new.
+			hasHybrid := cmd.Flags().Lookup("hybrid").Changed
+			hasFlags := hasHybrid || len(algodSet) > 0 || len(algodUnset) > 0
 
-			mergedConfig := config.MergeAlgodConfigs(*currentConfig, *newConfig)
-			if currentConfig.IsEqual(mergedConfig) {
-				log.Debug("Configuration up to date, nothing to do")
-			} else {
-				err := utils.WriteConfigToDataDir(dataDir, &mergedConfig)
+			restartRequired := false
+
+			// Are we doing something? If not, just display the current configuration.
+			if hasFlags {
+				set, err := parseSetFlags(algodSet)
 				if err != nil {
-					log.Warnf("%s", err)
-					log.Fatalf("%s", explanations.AlgorandPermissionErrorMsg)
+					return err
+				}
+				if hasHybrid {
+					set["EnableP2PHybridMode"] = strconv.FormatBool(enableHybrid)
 				}
-				restartRequired = true
-			}
-
-		} else {
 
-			hybridModeStatus := "Disabled"
-			if currentConfig.EnableP2PHybridMode != nil && *currentConfig.EnableP2PHybridMode {
-				hybridModeStatus = "Enabled"
-			}
+				// Validate against the installed algod, an unknown version allows every option
+				version, err := algod.GetInstalledVersion()
+				if err != nil {
+					log.Debugf("Unable to get the algod version: %s", err)
+				}
 
-			rows := [][]string{
-				{"EnableP2PHybridMode:", hybridModeStatus},
-			}
+				before, after, err := algod.PreviewConfig(dataDir, version, set, algodUnset)
+				if err != nil {
+					return err
+				}
+				if !cmdutils.PrintDiff(cmd.OutOrStdout(), "config.json", before, after) {
+					fmt.Fprintln(cmd.OutOrStdout(), "Configuration up to date, nothing to change")
+					return nil
+				}
+				if !algodYes && !cmdutils.Prompt("Write these changes to config.json?") {
+					return errors.New("the configuration was not changed")
+				}
 
-			var (
-				cellStyle      = lipgloss.NewStyle().Padding(0)
-				optionRowStyle = cellStyle.Align(lipgloss.Right)
-				valueRowStyle  = cellStyle.Align(lipgloss.Left)
-			)
-
-			configurationTable := table.New().
-				Border(lipgloss.HiddenBorder()).
-				StyleFunc(func(row, col int) lipgloss.Style {
-					if col == 0 {
-						return optionRowStyle
+				changed, err := algod.UpdateConfig(dataDir, version, set, algodUnset)
+				if err != nil {
+					if os.IsPermission(err) {
+						log.Warnf("%s", err)
+						log.Fatalf("%s", explanations.AlgorandPermissionErrorMsg)
 					}
-					return valueRowStyle
-				}).
-				Rows(rows...)
+					return err
+				}
+				restartRequired = changed
 
-			currentConfiguration := lipgloss.JoinVertical(
-				lipgloss.Left,
-				style.BoldUnderline("Current Configuration:"),
-				configurationTable.String(),
-			)
+			} else {
+				raw, err := utils.GetConfigValuesFromDataDir(dataDir)
+				if err != nil {
+					return err
+				}
 
-			fmt.Println(currentConfiguration)
-		}
+				rows := [][]string{}
+				for _, option := range config.Options {
+					value, ok := raw[option.Name]
+					if !ok && !algodAll {
+						continue
+					}
+					rows = append(rows, []string{option.Name + ":", string(value), option.Format(option.Default)})
+				}
+				// Keys that are not part of the schema
+				unknown := make([]string, 0)
+				for key := range raw {
+					if _, ok := config.Lookup(key); !ok {
+						unknown = append(unknown, key)
+					}
+				}
+				sort.Strings(unknown)
+				for _, key := range unknown {
+					rows = append(rows, []string{key + ":", string(raw[key]), ""})
+				}
 
-		if restartRequired {
-			log.Debug("Restarting node...")
-			err = algod.Stop()
-			if err != nil {
-				log.Fatal(err)
+				var (
+					cellStyle      = lipgloss.NewStyle().Padding(0, 1, 0, 0)
+					optionRowStyle = cellStyle.Align(lipgloss.Right)
+					valueRowStyle  = cellStyle.Align(lipgloss.Left)
+				)
+
+				configurationTable := table.New().
+					Border(lipgloss.HiddenBorder()).
+					Headers("Option", "Value", "Default").
+					StyleFunc(func(row, col int) lipgloss.Style {
+						if col == 0 {
+							return optionRowStyle
+						}
+						return valueRowStyle
+					}).
+					Rows(rows...)
+
+				currentConfiguration := lipgloss.JoinVertical(
+					lipgloss.Left,
+					style.BoldUnderline("Current Configuration:"),
+					configurationTable.String(),
+				)
+
+				fmt.Println(currentConfiguration)
 			}
 
-			// Wait 1 second.
-			// Calling stop & start too quickly on Mac (launchctl) appears to
-			// result in a false successfully start. Haven't investigated why.
-			time.Sleep(1 * time.Second)
-
-			err = algod.Start()
-			if err != nil {
-				log.Fatal(err)
+			if restartRequired {
+				err = restartNode(service, dataDir)
+				if err != nil {
+					log.Fatal(err)
+				}
 			}
-			log.Debug("Node restarted successfully.")
-		}
-		return nil
-	},
-}, &algodData)
+			return nil
+		},
+	}, &algodData)
+	cmd.Flags().BoolVar(&enableHybrid, "hybrid", true, "Enable or Disable P2P Hybrid Mode")
+	cmd.Flags().StringArrayVar(&algodSet, "set", nil, style.LightBlue("Set an option, as Key=Value"))
+	cmd.Flags().StringSliceVar(&algodUnset, "unset", nil, style.LightBlue("Remove an option so algod uses its default"))
+	cmd.Flags().BoolVar(&algodAll, "all", false, style.LightBlue("Show every option, including the defaults"))
+	cmd.Flags().BoolVarP(&algodYes, "yes", "y", false, style.Yellow.Render("Write the changes without asking"))
+	return cmd
+}
 
-func init() {
-	algodCmd.Flags().BoolVar(&enableHybrid, "hybrid", true, "Enable or Disable P2P Hybrid Mode")
+// parseSetFlags splits the Key=Value pairs of the --set flag.
+func parseSetFlags(pairs []string) (map[string]string, error) {
+	set := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid option %s, expected Key=Value", pair)
+		}
+		set[strings.TrimSpace(key)] = value
+	}
+	return set, nil
 }
@@ -0,0 +1,52 @@
+package configure
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	cmdutils "github.com/algorandfoundation/nodekit/cmd/utils"
+	"github.com/algorandfoundation/nodekit/internal/test/mock"
+)
+
+func Test_AlgodCommand(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	service := &mock.Service{Installed: true, Service: true, Running: true}
+	algodCmd := newAlgodCmd(&cmdutils.Service{Interface: service})
+	defer func(previous time.Duration) { nodeCheckInterval = previous }(nodeCheckInterval)
+	nodeCheckInterval = 0
+
+	dataDir := t.TempDir()
+	err := os.WriteFile(filepath.Join(dataDir, "config.json"), []byte(`{"GossipFanout": 4}`), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { algodData, algodSet, algodYes = "", nil, false }()
+	algodData, algodSet, algodYes = dataDir, []string{"GossipFanout=8"}, true
+
+	err = algodCmd.RunE(algodCmd, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(service.Calls, ",") != "Restart" {
+		t.Errorf("expected the node to be restarted, got %v", service.Calls)
+	}
+	content, err := os.ReadFile(filepath.Join(dataDir, "config.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var config map[string]any
+	if err = json.Unmarshal(content, &config); err != nil || config["GossipFanout"] != float64(8) {
+		t.Errorf("unexpected config.json %s (%v)", content, err)
+	}
+
+	// Nothing changed, the node keeps running
+	service.Calls = nil
+	err = algodCmd.RunE(algodCmd, nil)
+	if err != nil || len(service.Calls) != 0 {
+		t.Errorf("expected no restart, got %v (%v)", service.Calls, err)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"text/template"
 
+	cmdutils "github.com/algorandfoundation/nodekit/cmd/utils"
 	"github.com/algorandfoundation/nodekit/cmd/utils/explanations"
 	"github.com/algorandfoundation/nodekit/internal/algod"
 	"github.com/algorandfoundation/nodekit/internal/algod/utils"
@@ -35,30 +36,34 @@ var long = lipgloss.JoinVertical(
 
 var algodData = ""
 
-var Cmd = &cobra.Command{
-	Use:   "configure",
-	Short: short,
-	Long:  long,
-}
-
-func init() {
-	Cmd.AddCommand(serviceCmd)
-	Cmd.AddCommand(telemetryCmd)
-	Cmd.AddCommand(algodCmd)
+// NewCmd returns the configure command, its subcommands manage the given service.
+func NewCmd(service *cmdutils.Service) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "configure",
+		Short: short,
+		Long:  long,
+	}
+	cmd.AddCommand(newServiceCmd(service))
+	cmd.AddCommand(newTelemetryCmd(service))
+	cmd.AddCommand(newAlgodCmd(service))
+	cmd.AddCommand(diffCmd)
+	cmd.AddCommand(historyCmd)
+	cmd.AddCommand(newRollbackCmd(service))
+	return cmd
 }
 
 const RunningErrorMsg = "algorand is currently running. Please stop the node with *node stop* before configuring"
 
 // TODO: configure not just data directory but algod path
-func configureNode() error {
+func configureNode(service *cmdutils.Service) error {
 	var systemServiceConfigure bool
 
-	if algod.IsRunning(algodData) {
+	if algod.IsRunning(service.Interface, algodData) {
 		return fmt.Errorf(RunningErrorMsg)
 	}
 
 	// Check systemctl first
-	if algod.IsService() {
+	if service.IsService() {
 		if promptWrapperYes("Algorand is installed as a service. Do you wish to edit the service file to change the data directory? (y/N)") {
 			// Edit the service file with the user's new data directory
 			systemServiceConfigure = true
@@ -0,0 +1,116 @@
+package configure
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	cmdutils "github.com/algorandfoundation/nodekit/cmd/utils"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/algod/backup"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
+	"github.com/spf13/cobra"
+)
+
+// diffSet are Key=Value pairs to preview in config.json
+var diffSet []string
+
+// diffUnset are keys to preview removing from config.json
+var diffUnset []string
+
+// diffShort provides a brief description of the diff command.
+var diffShort = "Preview configuration changes or compare a backup"
+
+// diffLong provides a detailed description of the diff command.
+var diffLong = lipgloss.JoinVertical(
+	lipgloss.Left,
+	style.Purple(style.BANNER),
+	"",
+	style.Bold(diffShort),
+	"",
+	style.BoldUnderline("Overview:"),
+	"Shows the changes *configure algod* would make to config.json, without writing anything.",
+	"With a backup id, shows the changes a rollback to that backup would make instead.",
+	"",
+	style.BoldUnderline("Examples:"),
+	"  nodekit configure diff --set Archival=true --unset NetAddress",
+	"  nodekit configure diff 3",
+)
+
+// diffCmd prints a line diff of the configuration files.
+var diffCmd = cmdutils.WithAlgodFlags(&cobra.Command{
+	Use:          "diff [backup id]",
+	Short:        diffShort,
+	Long:         diffLong,
+	Args:         cobra.MaximumNArgs(1),
+	PreRunE:      cmdutils.NeedsLocalNode,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataDir, err := algod.GetDataDir(algodData)
+		if err != nil {
+			return err
+		}
+
+		if len(args) == 1 {
+			if len(diffSet) > 0 || len(diffUnset) > 0 {
+				return errors.New("a backup id cannot be combined with --set or --unset")
+			}
+			id, err := strconv.Atoi(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid backup id %s", args[0])
+			}
+			b, err := backup.Get(dataDir, id)
+			if err != nil {
+				return err
+			}
+			same := true
+			for _, name := range backup.Files {
+				old, err := backup.Read(dataDir, b.ID, name)
+				if err != nil {
+					return err
+				}
+				current, err := os.ReadFile(filepath.Join(dataDir, name))
+				if err != nil && !os.IsNotExist(err) {
+					return err
+				}
+				// Rolling back turns the current file into the backup
+				if cmdutils.PrintDiff(cmd.OutOrStdout(), name, current, old) {
+					same = false
+				}
+			}
+			if same {
+				fmt.Fprintf(cmd.OutOrStdout(), "Backup %d matches the current configuration\n", b.ID)
+			}
+			return nil
+		}
+
+		if len(diffSet) == 0 && len(diffUnset) == 0 {
+			return errors.New("nothing to compare, use --set, --unset or a backup id")
+		}
+		set, err := parseSetFlags(diffSet)
+		if err != nil {
+			return err
+		}
+		version, err := algod.GetInstalledVersion()
+		if err != nil {
+			log.Debugf("Unable to get the algod version: %s", err)
+		}
+		before, after, err := algod.PreviewConfig(dataDir, version, set, diffUnset)
+		if err != nil {
+			return err
+		}
+		if !cmdutils.PrintDiff(cmd.OutOrStdout(), "config.json", before, after) {
+			fmt.Fprintln(cmd.OutOrStdout(), "Configuration up to date, nothing to change")
+		}
+		return nil
+	},
+}, &algodData)
+
+func init() {
+	diffCmd.Flags().StringArrayVar(&diffSet, "set", nil, style.LightBlue("Preview setting an option, as Key=Value"))
+	diffCmd.Flags().StringSliceVar(&diffUnset, "unset", nil, style.LightBlue("Preview removing an option"))
+}
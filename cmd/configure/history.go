@@ -0,0 +1,99 @@
+package configure
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	cmdutils "github.com/algorandfoundation/nodekit/cmd/utils"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/algod/backup"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"github.com/spf13/cobra"
+)
+
+// historyOutput is the output format of the history command
+var historyOutput string
+
+// historyShort provides a brief description of the history command.
+var historyShort = "List the backups of the configuration files"
+
+// historyLong provides a detailed description of the history command.
+var historyLong = lipgloss.JoinVertical(
+	lipgloss.Left,
+	style.Purple(style.BANNER),
+	"",
+	style.Bold(historyShort),
+	"",
+	style.BoldUnderline("Overview:"),
+	"NodeKit backs up config.json and logging.config to the "+backup.DirName+" directory",
+	"of the data directory before every change, keeping the last "+strconv.Itoa(backup.MaxBackups)+" backups.",
+	"Backups taken while the node was running are known good configurations.",
+	"",
+	style.BoldUnderline("Examples:"),
+	"  nodekit configure history",
+	"  nodekit configure diff 3",
+	"  nodekit configure rollback 3",
+)
+
+// historyCmd prints the backups as a table, JSON or YAML.
+var historyCmd = cmdutils.WithOutputFlag(cmdutils.WithAlgodFlags(&cobra.Command{
+	Use:          "history",
+	Short:        historyShort,
+	Long:         historyLong,
+	PreRunE:      cmdutils.NeedsLocalNode,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataDir, err := algod.GetDataDir(algodData)
+		if err != nil {
+			return err
+		}
+		backups, err := backup.List(dataDir)
+		if err != nil {
+			return err
+		}
+
+		if historyOutput != cmdutils.TableOutput {
+			data, err := cmdutils.Marshal(historyOutput, backups)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), string(data))
+			return nil
+		}
+
+		if len(backups) == 0 {
+			fmt.Fprintln(cmd.OutOrStdout(), "No backups yet, they are taken before the configuration changes")
+			return nil
+		}
+		rows := make([][]string, 0, len(backups))
+		for _, b := range backups {
+			files := make([]string, 0, len(b.Files))
+			for name := range b.Files {
+				files = append(files, name)
+			}
+			sort.Strings(files)
+			node := "stopped"
+			if b.Running {
+				node = "running"
+			}
+			rows = append(rows, []string{
+				strconv.Itoa(b.ID),
+				b.Time.Format(time.DateTime),
+				b.Reason,
+				strings.Join(files, ", "),
+				node,
+			})
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), table.New().
+			Border(lipgloss.HiddenBorder()).
+			Headers("ID", "Time", "Reason", "Files", "Node").
+			Rows(rows...).
+			String())
+		return nil
+	},
+}, &algodData), &historyOutput)
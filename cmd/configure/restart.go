@@ -0,0 +1,81 @@
+package configure
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	cmdutils "github.com/algorandfoundation/nodekit/cmd/utils"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/algod/backup"
+	"github.com/charmbracelet/log"
+)
+
+// NodeStartTimeout is how long to wait for the node to run again after a restart.
+const NodeStartTimeout = 30 * time.Second
+
+// nodeSettleChecks is the number of checks in a row, nodeCheckInterval apart, the node must be running for.
+// algod can start and exit again shortly after when it rejects its configuration.
+const nodeSettleChecks = 3
+
+// nodeCheckInterval is the time between two checks of the node after a restart.
+var nodeCheckInterval = time.Second
+
+// NodeNotRunningErrorMsg is reported when the node did not come back after a change.
+const NodeNotRunningErrorMsg = "the node did not come back after the change, see *nodekit configure history* to restore a previous configuration"
+
+// restartNode restarts the node after a configuration change.
+// When the node does not come back, the user is offered to restore the last configuration the node was running with.
+func restartNode(service *cmdutils.Service, dataDir string) error {
+	log.Debug("Restarting node...")
+	err := service.Restart()
+	if err != nil {
+		return err
+	}
+	if waitForNode(service, dataDir) {
+		log.Debug("Node restarted successfully.")
+		return nil
+	}
+
+	good, err := backup.LastGood(dataDir)
+	if err != nil {
+		if errors.Is(err, backup.ErrNotFound) {
+			return errors.New(NodeNotRunningErrorMsg)
+		}
+		return err
+	}
+	if !cmdutils.Prompt(fmt.Sprintf("The node did not come back after the change. Restore backup %d from %s, the last configuration it was running with?",
+		good.ID, good.Time.Format(time.DateTime))) {
+		return errors.New(NodeNotRunningErrorMsg)
+	}
+	_, err = backup.Restore(dataDir, good.ID, false)
+	if err != nil {
+		return err
+	}
+	log.Infof("Restored backup %d, restarting node...", good.ID)
+	err = service.Restart()
+	if err != nil {
+		return err
+	}
+	if !waitForNode(service, dataDir) {
+		return fmt.Errorf("the node did not come back with backup %d either, check the algod logs in %s", good.ID, dataDir)
+	}
+	log.Debug("Node restarted successfully.")
+	return nil
+}
+
+// waitForNode polls the node until it keeps running or NodeStartTimeout passed.
+func waitForNode(service *cmdutils.Service, dataDir string) bool {
+	running := 0
+	for deadline := time.Now().Add(NodeStartTimeout); time.Now().Before(deadline); time.Sleep(nodeCheckInterval) {
+		if !algod.IsRunning(service.Interface, dataDir) {
+			running = 0
+			continue
+		}
+		running++
+		if running == nodeSettleChecks {
+			return true
+		}
+	}
+	return false
+}
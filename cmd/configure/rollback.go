@@ -0,0 +1,75 @@
+package configure
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	cmdutils "github.com/algorandfoundation/nodekit/cmd/utils"
+	"github.com/algorandfoundation/nodekit/cmd/utils/explanations"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/algod/backup"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
+	"github.com/spf13/cobra"
+)
+
+// rollbackShort provides a brief description of the rollback command.
+var rollbackShort = "Restore the configuration files from a backup"
+
+// rollbackLong provides a detailed description of the rollback command.
+var rollbackLong = lipgloss.JoinVertical(
+	lipgloss.Left,
+	style.Purple(style.BANNER),
+	"",
+	style.Bold(rollbackShort),
+	"",
+	style.BoldUnderline("Overview:"),
+	"Replaces config.json and logging.config with a backup listed by *configure history*.",
+	"The current files are backed up first, so a rollback can be undone the same way.",
+	"A running node is restarted with the restored configuration.",
+	"",
+	style.BoldUnderline("Example:"),
+	"  nodekit configure rollback 3",
+)
+
+// newRollbackCmd returns a command that restores a backup and restarts the node.
+func newRollbackCmd(service *cmdutils.Service) *cobra.Command {
+	cmd := cmdutils.WithAlgodFlags(&cobra.Command{
+		Use:          "rollback <backup id>",
+		Short:        rollbackShort,
+		Long:         rollbackLong,
+		Args:         cobra.ExactArgs(1),
+		PreRunE:      cmdutils.NeedsLocalNode,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.Atoi(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid backup id %s", args[0])
+			}
+			dataDir, err := algod.GetDataDir(algodData)
+			if err != nil {
+				return err
+			}
+
+			running := algod.IsRunning(service.Interface, dataDir)
+			b, err := backup.Restore(dataDir, id, running)
+			if err != nil {
+				if os.IsPermission(err) {
+					log.Warnf("%s", err)
+					log.Fatalf("%s", explanations.AlgorandPermissionErrorMsg)
+				}
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Restored backup %d from %s\n", b.ID, b.Time.Format(time.DateTime))
+
+			if running {
+				return restartNode(service, dataDir)
+			}
+			return nil
+		},
+	}, &algodData)
+	return cmd
+}
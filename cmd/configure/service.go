@@ -3,7 +3,6 @@ package configure
 import (
 	"github.com/algorandfoundation/nodekit/cmd/utils"
 	"github.com/algorandfoundation/nodekit/cmd/utils/explanations"
-	"github.com/algorandfoundation/nodekit/internal/algod"
 	"github.com/algorandfoundation/nodekit/ui/style"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/spf13/cobra"
@@ -25,14 +24,16 @@ var serviceLong = lipgloss.JoinVertical(
 	style.Yellow.Render(explanations.ExperimentalWarning),
 )
 
-// serviceCmd is a Cobra command for managing Algorand service files, requiring root privileges to ensure proper execution.
-var serviceCmd = &cobra.Command{
-	Use:               "service",
-	Short:             serviceShort,
-	Long:              serviceLong,
-	PersistentPreRunE: utils.IsSudoCmd,
-	RunE: func(cmd *cobra.Command, args []string) error {
-		// TODO: Combine this with algod.UpdateService and algod.SetNetwork
-		return algod.EnsureService()
-	},
+// newServiceCmd returns a Cobra command for managing Algorand service files, requiring root privileges to ensure proper execution.
+func newServiceCmd(service *utils.Service) *cobra.Command {
+	return &cobra.Command{
+		Use:               "service",
+		Short:             serviceShort,
+		Long:              serviceLong,
+		PersistentPreRunE: utils.IsSudoCmd,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// TODO: Combine this with UpdateService and SetNetwork
+			return service.EnsureService()
+		},
+	}
 }
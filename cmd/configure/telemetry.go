@@ -1,8 +1,6 @@
 package configure
 
 import (
-	"time"
-
 	cmdutils "github.com/algorandfoundation/nodekit/cmd/utils"
 	"github.com/algorandfoundation/nodekit/cmd/utils/explanations"
 	"github.com/algorandfoundation/nodekit/internal/algod"
@@ -36,100 +34,90 @@ var telemetryLong = lipgloss.JoinVertical(
 	style.Yellow.Render(NodelyTelemetryWarning),
 )
 
-var telemetryCmd = cmdutils.WithAlgodFlags(&cobra.Command{
-	Use:               "telemetry",
-	Short:             telemetryShort,
-	Long:              telemetryLong,
-	PersistentPreRunE: cmdutils.IsSudoCmd,
-	Run: func(cmd *cobra.Command, args []string) {
-		log.Warn(style.Yellow.Render(explanations.SudoWarningMsg))
-		resolvedDir, err := algod.GetDataDir(algodData)
-		if err != nil {
-			log.Fatal(err)
-		}
+// newTelemetryCmd returns a Cobra command that configures the telemetry of the node and restarts it.
+func newTelemetryCmd(service *cmdutils.Service) *cobra.Command {
+	cmd := cmdutils.WithAlgodFlags(&cobra.Command{
+		Use:               "telemetry",
+		PreRunE:           cmdutils.NeedsLocalNode,
+		Short:             telemetryShort,
+		Long:              telemetryLong,
+		PersistentPreRunE: cmdutils.IsSudoCmd,
+		Run: func(cmd *cobra.Command, args []string) {
+			log.Warn(style.Yellow.Render(explanations.SudoWarningMsg))
+			resolvedDir, err := algod.GetDataDir(algodData)
+			if err != nil {
+				log.Fatal(err)
+			}
 
-		// Current Log Configuration
-		logConfig, _ := utils.GetLogConfigFromDataDir(resolvedDir)
+			// Current Log Configuration
+			logConfig, _ := utils.GetLogConfigFromDataDir(resolvedDir)
 
-		hasDisable := cmd.Flags().Lookup("disable").Changed
-		hasEnabled := cmd.Flags().Lookup("enable").Changed
-		hasEndpoint := cmd.Flags().Lookup("endpoint").Changed
-		hasName := cmd.Flags().Lookup("name").Changed
+			hasDisable := cmd.Flags().Lookup("disable").Changed
+			hasEnabled := cmd.Flags().Lookup("enable").Changed
+			hasEndpoint := cmd.Flags().Lookup("endpoint").Changed
+			hasName := cmd.Flags().Lookup("name").Changed
 
-		hasFlags := hasDisable || hasEndpoint || hasName || hasEnabled
+			hasFlags := hasDisable || hasEndpoint || hasName || hasEnabled
 
-		if hasFlags {
-			if !hasEndpoint && hasEnabled {
-				err := cmd.Usage()
-				if err != nil {
-					log.Fatal(err)
+			if hasFlags {
+				if !hasEndpoint && hasEnabled {
+					err := cmd.Usage()
+					if err != nil {
+						log.Fatal(err)
+					}
+					log.Fatal(
+						"The --endpoint flag is required when using the --enable flag")
+				}
+				newConfig := telemetry.Config{
+					SendToLog:          logConfig.SendToLog,
+					GUID:               logConfig.GUID,
+					FilePath:           logConfig.FilePath,
+					UserName:           logConfig.UserName,
+					Password:           logConfig.Password,
+					MinLogLevel:        logConfig.MinLogLevel,
+					ReportHistoryLevel: logConfig.ReportHistoryLevel,
+				}
+				if hasEndpoint {
+					newConfig.URI = telemetryEndpoint
+				} else {
+					newConfig.URI = logConfig.URI
+				}
+				if hasName {
+					newConfig.Name = telemetryName
+				} else {
+					newConfig.Name = logConfig.Name
 				}
-				log.Fatal(
-					"The --endpoint flag is required when using the --enable flag")
-			}
-			newConfig := telemetry.Config{
-				SendToLog:          logConfig.SendToLog,
-				GUID:               logConfig.GUID,
-				FilePath:           logConfig.FilePath,
-				UserName:           logConfig.UserName,
-				Password:           logConfig.Password,
-				MinLogLevel:        logConfig.MinLogLevel,
-				ReportHistoryLevel: logConfig.ReportHistoryLevel,
-			}
-			if hasEndpoint {
-				newConfig.URI = telemetryEndpoint
-			} else {
-				newConfig.URI = logConfig.URI
-			}
-			if hasName {
-				newConfig.Name = telemetryName
-			} else {
-				newConfig.Name = logConfig.Name
-			}
 
-			if hasDisable {
-				newConfig.Enable = false
-			} else if hasEnabled {
-				newConfig.Enable = true
-			} else {
-				newConfig.Enable = logConfig.Enable
-			}
-			mergeConfig := telemetry.MergeLogConfigs(*logConfig, newConfig)
-			if logConfig.IsEqual(mergeConfig) {
-				log.Debug("Configuration up to date, nothing to do")
-			} else {
-				logConfig = &mergeConfig
-				err := utils.WriteLogConfigToDataDir(resolvedDir, logConfig)
-				if err != nil {
-					log.Fatal(err)
+				if hasDisable {
+					newConfig.Enable = false
+				} else if hasEnabled {
+					newConfig.Enable = true
+				} else {
+					newConfig.Enable = logConfig.Enable
+				}
+				mergeConfig := telemetry.MergeLogConfigs(*logConfig, newConfig)
+				if logConfig.IsEqual(mergeConfig) {
+					log.Debug("Configuration up to date, nothing to do")
+				} else {
+					logConfig = &mergeConfig
+					err := utils.WriteLogConfigToDataDir(resolvedDir, logConfig)
+					if err != nil {
+						log.Fatal(err)
+					}
 				}
 			}
-		}
-
-		log.Debug("Restarting node...")
-		err = algod.Stop()
-		if err != nil {
-			log.Fatal(err)
-		}
 
-		// Wait 1 second.
-		// Calling stop & start too quickly on Mac (launchctl) appears to
-		// result in a false successfully start. Haven't investigated why.
-		time.Sleep(1 * time.Second)
-
-		err = algod.Start()
-		if err != nil {
-			log.Fatal(err)
-		}
-		log.Debug("Node restarted successfully.")
-	},
-}, &algodData)
-
-func init() {
-	telemetryCmd.Flags().BoolVarP(&telemetryDisable, "disable", "", false, "Disables telemetry")
-	telemetryCmd.Flags().BoolVarP(&telemetryEnable, "enable", "", false, "Enables telemetry")
-	telemetryCmd.MarkFlagsOneRequired("disable", "enable")
-	telemetryCmd.MarkFlagsMutuallyExclusive("disable", "enable")
-	telemetryCmd.Flags().StringVarP(&telemetryEndpoint, "endpoint", "e", string(cmdutils.NodelyTelemetryProvider), "Sets the \"URI\" property")
-	telemetryCmd.Flags().StringVarP(&telemetryName, "name", "n", "anon", "Enable Algorand remote logging with specified node name")
+			err = restartNode(service, resolvedDir)
+			if err != nil {
+				log.Fatal(err)
+			}
+		},
+	}, &algodData)
+	cmd.Flags().BoolVarP(&telemetryDisable, "disable", "", false, "Disables telemetry")
+	cmd.Flags().BoolVarP(&telemetryEnable, "enable", "", false, "Enables telemetry")
+	cmd.MarkFlagsOneRequired("disable", "enable")
+	cmd.MarkFlagsMutuallyExclusive("disable", "enable")
+	cmd.Flags().StringVarP(&telemetryEndpoint, "endpoint", "e", string(cmdutils.NodelyTelemetryProvider), "Sets the \"URI\" property")
+	cmd.Flags().StringVarP(&telemetryName, "name", "n", "anon", "Enable Algorand remote logging with specified node name")
+	return cmd
 }
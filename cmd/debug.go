@@ -16,7 +16,6 @@ import (
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/log"
 	"github.com/spf13/cobra"
-	"golang.org/x/sys/unix"
 )
 
 // DebugInfo represents diagnostic information about
@@ -36,6 +35,9 @@ type DebugInfo struct {
 	// IsInstalled indicates whether the Algorand software is installed on the system by checking its presence and configuration.
 	IsInstalled bool `json:"isInstalled"`
 
+	// Service is the health of the algod service reported by systemd, nil when it is unavailable.
+	Service *system.ServiceStatus `json:"service,omitempty"`
+
 	// Algod holds the path to the `algod` executable if found on the system, or an empty string if not found.
 	Algod string `json:"algod"`
 
@@ -61,61 +63,66 @@ var debugCmdLong = lipgloss.JoinVertical(
 	"",
 )
 
-// debugCmd defines the "debug" command used to display diagnostic information for developers, including debug data.
-var debugCmd = cmdutils.WithAlgodFlags(&cobra.Command{
-	Use:          "debug",
-	Short:        debugCmdShort,
-	Long:         debugCmdLong,
-	SilenceUsage: true,
-	RunE: func(cmd *cobra.Command, args []string) error {
-		log.Info("Collecting debug information...")
-
-		// Warn user for prompt
-		log.Warn(style.Yellow.Render(explanations.SudoWarningMsg))
-
-		path, _ := exec.LookPath("algod")
-
-		dataDir, err := algod.GetDataDir(algodData)
-		if err != nil {
-			return err
-		}
-
-		// Get the log configuration
-		logConfig, _ := utils.GetLogConfigFromDataDir(dataDir)
-		lenPassword := len(logConfig.Password)
-		if lenPassword > 0 {
-			logConfig.Password = strings.Repeat("*", lenPassword)
-		}
-
-		folderDebug, err := utils.ToDataFolderConfig(dataDir)
-		if err != nil {
-			folderDebug.Token = fmt.Sprint(err)
-		} else if len(folderDebug.Token) > 3 {
-			folderDebug.Token = folderDebug.Token[:3] + "..."
-		}
-
-		var stat unix.Statfs_t
-		unix.Statfs(dataDir, &stat)
-		bytesFree := stat.Bavail * uint64(stat.Bsize)
-		folderDebug.BytesFree = fmt.Sprintf("%d bytes (%d MB)", bytesFree, bytesFree/1024/1024)
-
-		info := DebugInfo{
-			Version:     cmd.Root().Version,
-			InPath:      system.CmdExists("algod"),
-			IsRunning:   algod.IsRunning(dataDir),
-			IsService:   algod.IsService(),
-			IsInstalled: algod.IsInstalled(),
-			Algod:       path,
-			DataFolder:  folderDebug,
-			Telemetry:   *logConfig,
-		}
-		data, err := json.MarshalIndent(info, "", " ")
-		if err != nil {
-			return err
-		}
-
-		log.Info(style.Blue.Render("Copy and paste the following to a bug report:"))
-		fmt.Println(style.Bold(string(data)))
-		return nil
-	},
-}, &algodData)
+// newDebugCmd returns the "debug" command used to display diagnostic information for developers, including debug data.
+func newDebugCmd(service *cmdutils.Service) *cobra.Command {
+	cmd := cmdutils.WithAlgodFlags(&cobra.Command{
+		Use:          "debug",
+		PreRunE:      cmdutils.NeedsLocalNode,
+		Short:        debugCmdShort,
+		Long:         debugCmdLong,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			log.Info("Collecting debug information...")
+
+			// Warn user for prompt
+			log.Warn(style.Yellow.Render(explanations.SudoWarningMsg))
+
+			path, _ := exec.LookPath("algod")
+
+			dataDir, err := algod.GetDataDir(algodData)
+			if err != nil {
+				return err
+			}
+
+			// Get the log configuration
+			logConfig, _ := utils.GetLogConfigFromDataDir(dataDir)
+			lenPassword := len(logConfig.Password)
+			if lenPassword > 0 {
+				logConfig.Password = strings.Repeat("*", lenPassword)
+			}
+
+			folderDebug, err := utils.ToDataFolderConfig(dataDir)
+			if err != nil {
+				folderDebug.Token = fmt.Sprint(err)
+			} else if len(folderDebug.Token) > 3 {
+				folderDebug.Token = folderDebug.Token[:3] + "..."
+			}
+
+			bytesFree, _ := system.DiskFree(dataDir)
+			folderDebug.BytesFree = fmt.Sprintf("%d bytes (%d MB)", bytesFree, bytesFree/1024/1024)
+
+			info := DebugInfo{
+				Version:     cmd.Root().Version,
+				InPath:      system.CmdExists("algod"),
+				IsRunning:   algod.IsRunning(service.Interface, dataDir),
+				IsService:   service.IsService(),
+				IsInstalled: service.IsInstalled(),
+				Algod:       path,
+				DataFolder:  folderDebug,
+				Telemetry:   *logConfig,
+			}
+			if status, err := service.ServiceStatus(); err == nil {
+				info.Service = &status
+			}
+			data, err := json.MarshalIndent(info, "", " ")
+			if err != nil {
+				return err
+			}
+
+			log.Info(style.Blue.Render("Copy and paste the following to a bug report:"))
+			fmt.Println(style.Bold(string(data)))
+			return nil
+		},
+	}, &algodData)
+	return cmd
+}
@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/algorandfoundation/nodekit/api"
+	cmdutils "github.com/algorandfoundation/nodekit/cmd/utils"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/doctor"
+	"github.com/algorandfoundation/nodekit/internal/system"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"github.com/spf13/cobra"
+)
+
+// doctorOutput is the output format of the doctor command
+var doctorOutput string
+
+// doctorShort provides a brief description of the doctor command.
+var doctorShort = "Check the health of the node"
+
+// doctorLong provides a detailed description of the doctor command.
+var doctorLong = lipgloss.JoinVertical(
+	lipgloss.Left,
+	style.Purple(style.BANNER),
+	"",
+	style.Bold(doctorShort),
+	"",
+	style.BoldUnderline("Overview:"),
+	"Runs a series of checks against the node and reports each one as pass, warn or fail,",
+	"with a suggestion to fix it. The checks cover the service, the algod version, disk space,",
+	"token permissions, clock skew, peers, the P2P configuration, the catchpoint lag, pending protocol upgrades",
+	"and the participation keys.",
+	"",
+	"The command exits with an error when a check failed. Checks of local files and the service",
+	"are skipped for a remote --profile.",
+)
+
+// newDoctorCmd returns a command that runs the doctor checks and prints their reports as a table, JSON or YAML.
+func newDoctorCmd(service *cmdutils.Service) *cobra.Command {
+	cmd := cmdutils.WithOutputFlag(cmdutils.WithAlgodFlags(&cobra.Command{
+		Use:          "doctor",
+		Short:        doctorShort,
+		Long:         doctorLong,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			httpPkg := new(api.HttpPkg)
+			clock := new(system.Clock)
+
+			env := doctor.Env{
+				HttpPkg:        httpPkg,
+				Time:           clock,
+				DiskFree:       system.DiskFree,
+				ServiceEnabled: service.IsServiceEnabled,
+				ServiceStatus:  service.ServiceStatus,
+			}
+			client, dataDir, err := cmdutils.GetClient(algodData)
+			if err != nil && dataDir == "" {
+				return err
+			}
+			env.DataDir = dataDir
+			env.Err = err
+			if err == nil {
+				// An unreachable node is reported by the checks
+				state, _, err := algod.NewStateModel(ctx, client, httpPkg, false, cmd.Root().Version, dataDir)
+				if err == nil {
+					state.UpdateKeys(ctx, clock)
+				}
+				env.State, env.Err = state, err
+			}
+
+			reports := doctor.Run(ctx, doctor.DefaultChecks(), env)
+			if doctorOutput != cmdutils.TableOutput {
+				data, err := cmdutils.Marshal(doctorOutput, reports)
+				if err != nil {
+					return err
+				}
+				fmt.Fprintln(cmd.OutOrStdout(), string(data))
+			} else {
+				rows := make([][]string, 0, len(reports))
+				for _, report := range reports {
+					rows = append(rows, []string{report.Check, renderResult(report.Result), report.Message, report.Fix})
+				}
+				fmt.Fprintln(cmd.OutOrStdout(), table.New().
+					Border(lipgloss.HiddenBorder()).
+					Headers("Check", "Result", "Message", "Fix").
+					Rows(rows...).
+					String())
+			}
+
+			if doctor.Worst(reports) == doctor.FailResult {
+				return doctor.ErrFailed
+			}
+			return nil
+		},
+	}, &algodData), &doctorOutput)
+	return cmd
+}
+
+// renderResult colors a doctor result for the table.
+func renderResult(result doctor.Result) string {
+	label := strings.ToUpper(string(result))
+	switch result {
+	case doctor.PassResult:
+		return style.Green.Render(label)
+	case doctor.WarnResult:
+		return style.Yellow.Render(label)
+	case doctor.FailResult:
+		return style.Red.Render(label)
+	default:
+		return label
+	}
+}
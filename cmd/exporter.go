@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	cmdutils "github.com/algorandfoundation/nodekit/cmd/utils"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/exporter"
+	"github.com/algorandfoundation/nodekit/internal/system"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
+	"github.com/spf13/cobra"
+)
+
+// exporterListen is the address the exporter binds to
+var exporterListen = ":9101"
+
+// exporterPath is the HTTP path metrics are served on
+var exporterPath = "/metrics"
+
+// exporterShort provides a brief description of the exporter command.
+var exporterShort = "Serve NodeKit metrics for Prometheus"
+
+// exporterLong provides a detailed description of the exporter command.
+var exporterLong = lipgloss.JoinVertical(
+	lipgloss.Left,
+	style.Purple(style.BANNER),
+	"",
+	style.Bold(exporterShort),
+	"",
+	style.BoldUnderline("Overview:"),
+	"Serves the metrics derived by NodeKit in the Prometheus text format.",
+	"Includes TPS, average round time, RX/TX rates, peer counts and the participation",
+	"status of every account with keys on the node, including rounds until key expiry.",
+	"",
+	style.Yellow.Render("This requires the daemon to be running."),
+)
+
+// exporterCmd runs a single watcher against the node and serves the latest state on every scrape.
+var exporterCmd = cmdutils.WithAlgodFlags(&cobra.Command{
+	Use:          "exporter",
+	Short:        exporterShort,
+	Long:         exporterLong,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		state, err := cmdutils.GetStateModel(ctx, cmd, algodData)
+		if err != nil {
+			return err
+		}
+
+		e := exporter.New()
+		e.Update(state.Snapshot())
+
+		// Refresh the snapshot on every state change
+		watcher := algod.NewWatcher(state, new(system.Clock))
+		events := watcher.Subscribe(ctx, 1)
+		go watcher.Run(ctx)
+		go func() {
+			for event := range events {
+				e.Update(event.Snapshot)
+			}
+		}()
+
+		mux := http.NewServeMux()
+		mux.Handle(exporterPath, e)
+		server := &http.Server{
+			Addr:              exporterListen,
+			Handler:           mux,
+			ReadHeaderTimeout: 5 * time.Second,
+		}
+		go func() {
+			<-ctx.Done()
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			_ = server.Shutdown(shutdownCtx)
+		}()
+
+		log.Info(style.Green.Render("Serving metrics on " + exporterListen + exporterPath))
+		err = server.ListenAndServe()
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+		return err
+	},
+}, &algodData)
+
+// init initializes the flags for the exporter command.
+func init() {
+	exporterCmd.Flags().StringVarP(&exporterListen, "listen", "l", exporterListen, style.LightBlue("Address to serve metrics on"))
+	exporterCmd.Flags().StringVar(&exporterPath, "path", exporterPath, style.LightBlue("HTTP path to serve metrics on"))
+}
@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/internal/fleet"
+	"github.com/algorandfoundation/nodekit/internal/system"
+	"github.com/algorandfoundation/nodekit/ui"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+)
+
+// fleetFile is the path of the fleet configuration, defaults to ~/.nodekit.fleet.json
+var fleetFile string
+
+// fleetShort provides a brief description of the fleet command.
+var fleetShort = "Monitor several nodes in the TUI"
+
+// fleetLong provides a detailed description of the fleet command.
+var fleetLong = lipgloss.JoinVertical(
+	lipgloss.Left,
+	style.Purple(style.BANNER),
+	"",
+	style.Bold(fleetShort),
+	"",
+	style.BoldUnderline("Overview:"),
+	"Shows the status, round lag, peers, key count and soonest key expiry of every node in the fleet file.",
+	"Select a node with enter to open its accounts and keys pages, esc on the accounts page returns to the fleet.",
+	"",
+	"The fleet file lists local nodes by data directory and remote nodes by endpoint and admin token,",
+	"or by the name of a connection profile in the NodeKit settings:",
+	"",
+	`  {"nodes": [`,
+	`    {"name": "local", "dataDir": "/var/lib/algorand"},`,
+	`    {"name": "relay", "endpoint": "http://10.0.0.2:8080", "token": "<admin token>"},`,
+	`    {"name": "archive", "profile": "archive"}`,
+	`  ]}`,
+)
+
+// fleetCmd runs the TUI against every node in the fleet file.
+var fleetCmd = &cobra.Command{
+	Use:          "fleet",
+	Short:        fleetShort,
+	Long:         fleetLong,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		path := fleetFile
+		if path == "" {
+			var err error
+			path, err = fleet.DefaultPath()
+			if err != nil {
+				return err
+			}
+		}
+		config, err := fleet.Load(path)
+		if err != nil {
+			return fmt.Errorf("failed to load the fleet: %w", err)
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		p := tea.NewProgram(
+			ui.NewFleetViewModel(config.Nodes),
+			tea.WithAltScreen(),
+			tea.WithFPS(120),
+		)
+
+		// Connect and watch every node on separate threads
+		go fleet.Watch(ctx, config.Nodes, fleet.Options{
+			HttpPkg:            new(api.HttpPkg),
+			Time:               new(system.Clock),
+			IncentivesDisabled: IncentivesDisabled,
+			Version:            cmd.Root().Version,
+		}, func(update fleet.Update) {
+			p.Send(update)
+		})
+
+		_, err = p.Run()
+		return err
+	},
+}
+
+// init initializes the flags for the fleet command.
+func init() {
+	fleetCmd.Flags().StringVarP(&fleetFile, "file", "f", "", style.LightBlue("Path to the fleet file (default: ~/.nodekit.fleet.json)"))
+	fleetCmd.Flags().BoolVarP(&IncentivesDisabled, "no-incentives", "n", false, style.LightBlue("Disable setting incentive eligibility fees"))
+}
@@ -4,8 +4,11 @@ import (
 	"os"
 	"time"
 
+	"github.com/algorandfoundation/nodekit/api"
+	cmdutils "github.com/algorandfoundation/nodekit/cmd/utils"
 	"github.com/algorandfoundation/nodekit/cmd/utils/explanations"
 	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/system"
 	"github.com/algorandfoundation/nodekit/ui/style"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/log"
@@ -18,6 +21,20 @@ const InstallMsg = "Installing Algorand"
 // InstallExistsMsg is a constant string used to indicate that the Algod is already installed on the system.
 const InstallExistsMsg = "algod is already installed"
 
+// InstallTimeout is how long to wait after installing or upgrading for the package manager to start the daemon.
+var InstallTimeout = 5 * time.Second
+
+// releasesHttpPkg fetches the go-algorand releases.
+var releasesHttpPkg api.HttpPkgInterface = new(api.HttpPkg)
+
+var (
+	// releaseChannel is the release channel to install or upgrade from
+	releaseChannel string
+
+	// releaseVersion pins algod to a version
+	releaseVersion string
+)
+
 var installShort = "Install the node daemon"
 
 var installLong = lipgloss.JoinVertical(
@@ -29,50 +46,80 @@ var installLong = lipgloss.JoinVertical(
 	style.BoldUnderline("Overview:"),
 	"Configures the local package manager and installs the algorand daemon on your local machine",
 	"",
+	"The latest stable release is installed by default. Pick the beta channel with --channel,",
+	"or pin a version with --version so the package manager keeps it until the next nodekit upgrade.",
+	style.Yellow.Render("Pinning a version requires apt-get or dnf, Homebrew only provides the latest stable release."),
+	"",
+	style.BoldUnderline("Examples:"),
+	"  nodekit install",
+	"  nodekit install --version 3.26.0",
+	"  nodekit install --channel beta",
 )
 
-// installCmd is a Cobra command that installs the Algorand daemon on the local machine, ensuring the service is operational.
-var installCmd = &cobra.Command{
-	Use:          "install",
-	Short:        installShort,
-	Long:         installLong,
-	SilenceUsage: true,
-	Run: func(cmd *cobra.Command, args []string) {
-		// TODO: yes flag
-
-		// TODO: get expected version
-		log.Info(style.Green.Render(InstallMsg))
-		// Warn user for prompt
-		log.Warn(style.Yellow.Render(explanations.SudoWarningMsg))
-
-		// TODO: compare expected version to existing version
-		if algod.IsInstalled() && !force {
-			log.Error(InstallExistsMsg)
-			os.Exit(1)
-		}
+// newInstallCmd returns a Cobra command that installs the Algorand daemon on the local machine, ensuring the service is operational.
+func newInstallCmd(service *cmdutils.Service) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "install",
+		Short:        installShort,
+		Long:         installLong,
+		SilenceUsage: true,
+		Run: func(cmd *cobra.Command, args []string) {
+			// TODO: yes flag
 
-		// Run the installation
-		err := algod.Install()
-		if err != nil {
-			log.Error(err)
-			os.Exit(1)
-		}
+			release, tag, err := getRelease(force)
+			if err != nil {
+				log.Fatal(err)
+			}
+			log.Info(style.Green.Render(InstallMsg + " " + tag))
+			// Warn user for prompt
+			log.Warn(style.Yellow.Render(explanations.SudoWarningMsg))
 
-		time.Sleep(5 * time.Second)
+			if service.IsInstalled() && !force {
+				log.Error(InstallExistsMsg)
+				os.Exit(1)
+			}
 
-		// If it's not running, start the daemon (can happen)
-		if !algod.IsRunning(algodData) {
-			err = algod.Start()
+			// Run the installation
+			err = service.Install(release)
 			if err != nil {
 				log.Error(err)
 				os.Exit(1)
 			}
-		}
 
-		log.Info(style.Green.Render("Algorand installed successfully 🎉"))
-	},
+			time.Sleep(InstallTimeout)
+
+			// If it's not running, start the daemon (can happen)
+			if !algod.IsRunning(service.Interface, algodData) {
+				err = service.Start()
+				if err != nil {
+					log.Error(err)
+					os.Exit(1)
+				}
+			}
+
+			log.Info(style.Green.Render("Algorand installed successfully 🎉"))
+		},
+	}
+	cmd.Flags().BoolVarP(&force, "force", "f", false, style.Yellow.Render("forcefully install the node"))
+	cmd.Flags().StringVar(&releaseChannel, "channel", "stable", style.LightBlue("Release channel, stable or beta"))
+	cmd.Flags().StringVar(&releaseVersion, "version", "", style.LightBlue("Install and pin a version, e.g. 3.26.0"))
+	return cmd
 }
 
-func init() {
-	installCmd.Flags().BoolVarP(&force, "force", "f", false, style.Yellow.Render("forcefully install the node"))
+// getRelease validates the release flags and resolves the release tag through the go-algorand releases.
+// For an unpinned release the tag is empty when the releases are unavailable, the package manager then picks the latest.
+func getRelease(downgrade bool) (system.Release, string, error) {
+	release, err := algod.NewRelease(releaseChannel, releaseVersion, downgrade)
+	if err != nil {
+		return release, "", err
+	}
+	tag, err := algod.ResolveRelease(releasesHttpPkg, release)
+	if err != nil {
+		if release.Version != "" {
+			return release, "", err
+		}
+		log.Warn(style.Yellow.Render("Unable to resolve the latest release: " + err.Error()))
+		return release, "", nil
+	}
+	return release, tag, nil
 }
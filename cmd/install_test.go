@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/cmd/utils"
+	"github.com/algorandfoundation/nodekit/internal/test/mock"
+)
+
+// releasesHttp serves the go-algorand releases
+type releasesHttp struct {
+	api.HttpPkgInterface
+}
+
+func (releasesHttp) Get(url string) (*http.Response, error) {
+	body := `[{"tag_name": "v3.27.0-beta"}, {"tag_name": "v3.26.0-stable"}]`
+	if tag, ok := strings.CutPrefix(url, "https://api.github.com/repos/algorand/go-algorand/releases/tags/"); ok {
+		body = `{"tag_name": "` + tag + `"}`
+	}
+	return &http.Response{StatusCode: 200, Status: "200 OK", Body: io.NopCloser(bytes.NewReader([]byte(body)))}, nil
+}
+
+func Test_InstallCommand(t *testing.T) {
+	defer func(previous time.Duration, httpPkg api.HttpPkgInterface) {
+		InstallTimeout, releasesHttpPkg = previous, httpPkg
+	}(InstallTimeout, releasesHttpPkg)
+	InstallTimeout, releasesHttpPkg = 0, releasesHttp{}
+	service := &mock.Service{}
+	installCmd := newInstallCmd(&utils.Service{Interface: service})
+	defer func() { releaseChannel, releaseVersion = "stable", "" }()
+	releaseChannel, releaseVersion = "beta", "3.27.0"
+
+	installCmd.Run(installCmd, nil)
+	if strings.Join(service.Calls, ",") != "Install,Start" {
+		t.Errorf("expected the node to be installed and started, got %v", service.Calls)
+	}
+	if service.Release.Channel != "beta" || service.Release.Version != "3.27.0" {
+		t.Errorf("unexpected release %+v", service.Release)
+	}
+}
@@ -0,0 +1,83 @@
+package instance
+
+import (
+	"fmt"
+
+	"github.com/algorandfoundation/nodekit/api"
+	cmdutils "github.com/algorandfoundation/nodekit/cmd/utils"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/system"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// network is the network the new instance joins
+	network string
+
+	// dataDir is the data directory of the new instance, defaults to algod.DefaultInstanceDir
+	dataDir string
+
+	// port is the REST API port of the new instance, 0 allocates a free port
+	port int
+
+	// noStart registers the new instance without starting it
+	noStart bool
+)
+
+// addShort provides a brief description of the add command.
+var addShort = "Add a named instance and register its service"
+
+// addLong provides a detailed description of the add command.
+var addLong = lipgloss.JoinVertical(
+	lipgloss.Left,
+	style.Purple(style.BANNER),
+	"",
+	style.Bold(addShort),
+	"",
+	style.BoldUnderline("Overview:"),
+	"Creates the data directory of the instance with the genesis file of the network,",
+	fmt.Sprintf("sets its REST API to a free port from %d, registers its service and starts it.", algod.InstancePortBase),
+	"",
+	style.Yellow.Render("This requires algod to be installed with nodekit install."),
+)
+
+// addCmd creates an instance and registers it with the service manager.
+var addCmd = &cobra.Command{
+	Use:               "add <name>",
+	Short:             addShort,
+	Long:              addLong,
+	Args:              cobra.ExactArgs(1),
+	PersistentPreRunE: cmdutils.IsSudoCmd,
+	SilenceUsage:      true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		instance, err := algod.AddInstance(new(api.HttpPkg), args[0], network, dataDir, port)
+		if err != nil {
+			return err
+		}
+		log.Info(fmt.Sprintf("Created instance %s for %s in %s, REST API on port %d", instance.Name, instance.Network, instance.DataDir, instance.Port))
+
+		service := algod.NewInstanceService(instance)
+		err = service.Install(system.Release{})
+		if err != nil {
+			return err
+		}
+		if !noStart {
+			err = service.Start()
+			if err != nil {
+				return err
+			}
+		}
+		log.Info(style.Green.Render(fmt.Sprintf("Instance %s is ready, use it with --instance %s", instance.Name, instance.Name)))
+		return nil
+	},
+}
+
+func init() {
+	addCmd.Flags().StringVar(&network, "network", "mainnet", style.LightBlue("Network of the instance, e.g. mainnet, testnet or betanet"))
+	addCmd.Flags().StringVarP(&dataDir, "datadir", "d", "", style.LightBlue("Data directory of the instance"))
+	addCmd.Flags().IntVar(&port, "port", 0, style.LightBlue("REST API port of the instance, a free port is picked by default"))
+	addCmd.Flags().BoolVar(&noStart, "no-start", false, style.LightBlue("Register the instance without starting it"))
+}
@@ -0,0 +1,49 @@
+package instance
+
+import (
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Short provides a brief description of the instance command.
+	Short = "Manage several nodes on this machine"
+
+	// Long provides a detailed description of the instance command.
+	Long = lipgloss.JoinVertical(
+		lipgloss.Left,
+		style.Purple(style.BANNER),
+		"",
+		style.Bold(Short),
+		"",
+		style.BoldUnderline("Overview:"),
+		"Runs several named algod instances side by side, e.g. a TestNet and a MainNet node.",
+		"Every instance has its own data directory, REST API port and service:",
+		"a nodekit-algod@<name> systemd unit on Linux or a com.algorand.algod.<name> launchd service on macOS.",
+		"The algod binary installed with nodekit install is shared by all of them.",
+		"",
+		"Select an instance with --instance on any command working on a node, including the TUI.",
+		"The instances are saved in the \"Instances\" section of the NodeKit settings file (~/.nodekit.json).",
+		"",
+		style.BoldUnderline("Examples:"),
+		"  sudo nodekit instance add testnode --network testnet",
+		"  nodekit instance list",
+		"  nodekit start --instance testnode",
+		"  nodekit configure algod --instance testnode --set Archival=false",
+		"  nodekit --instance testnode",
+	)
+
+	// Cmd is the parent command of the instance subcommands.
+	Cmd = &cobra.Command{
+		Use:   "instance",
+		Short: Short,
+		Long:  Long,
+	}
+)
+
+func init() {
+	Cmd.AddCommand(addCmd)
+	Cmd.AddCommand(listCmd)
+	Cmd.AddCommand(removeCmd)
+}
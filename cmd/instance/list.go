@@ -0,0 +1,93 @@
+package instance
+
+import (
+	"fmt"
+	"strconv"
+
+	cmdutils "github.com/algorandfoundation/nodekit/cmd/utils"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"github.com/spf13/cobra"
+)
+
+// listOutput is the output format of the list command
+var listOutput string
+
+// instanceStatus is an instance with the state of its service.
+type instanceStatus struct {
+	algod.Instance
+	Service bool `json:"service"`
+	Running bool `json:"running"`
+}
+
+// listShort provides a brief description of the list command.
+var listShort = "List the instances and whether they are running"
+
+// listLong provides a detailed description of the list command.
+var listLong = lipgloss.JoinVertical(
+	lipgloss.Left,
+	style.Purple(style.BANNER),
+	"",
+	style.Bold(listShort),
+	"",
+	style.BoldUnderline("Overview:"),
+	"Shows the network, REST API port and data directory of every instance,",
+	"whether its service is registered and whether algod is running.",
+)
+
+// listCmd prints the instances as a table, JSON or YAML.
+var listCmd = cmdutils.WithOutputFlag(&cobra.Command{
+	Use:          "list",
+	Short:        listShort,
+	Long:         listLong,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		instances, err := algod.GetInstances()
+		if err != nil {
+			return err
+		}
+		statuses := make([]instanceStatus, 0, len(instances))
+		for _, instance := range instances {
+			service := algod.NewInstanceService(instance)
+			statuses = append(statuses, instanceStatus{
+				Instance: instance,
+				Service:  service.IsService(),
+				Running:  service.IsRunning(instance.DataDir),
+			})
+		}
+
+		if listOutput != cmdutils.TableOutput {
+			data, err := cmdutils.Marshal(listOutput, statuses)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), string(data))
+			return nil
+		}
+
+		if len(statuses) == 0 {
+			fmt.Fprintln(cmd.OutOrStdout(), "No instances yet, add one with nodekit instance add")
+			return nil
+		}
+		rows := make([][]string, 0, len(statuses))
+		for _, s := range statuses {
+			service := "missing"
+			if s.Service {
+				service = "registered"
+			}
+			node := "stopped"
+			if s.Running {
+				node = "running"
+			}
+			rows = append(rows, []string{s.Name, s.Network, strconv.Itoa(s.Port), s.DataDir, service, node})
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), table.New().
+			Border(lipgloss.HiddenBorder()).
+			Headers("Name", "Network", "Port", "Data Directory", "Service", "Node").
+			Rows(rows...).
+			String())
+		return nil
+	},
+}, &listOutput)
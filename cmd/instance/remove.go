@@ -0,0 +1,56 @@
+package instance
+
+import (
+	"fmt"
+
+	cmdutils "github.com/algorandfoundation/nodekit/cmd/utils"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
+	"github.com/spf13/cobra"
+)
+
+// removeShort provides a brief description of the remove command.
+var removeShort = "Stop an instance and remove its service"
+
+// removeLong provides a detailed description of the remove command.
+var removeLong = lipgloss.JoinVertical(
+	lipgloss.Left,
+	style.Purple(style.BANNER),
+	"",
+	style.Bold(removeShort),
+	"",
+	style.BoldUnderline("Overview:"),
+	"Stops the instance, removes its service and deletes it from the NodeKit settings.",
+	"The data directory is kept, delete it yourself once the participation keys are no longer needed.",
+)
+
+// removeCmd unregisters an instance.
+var removeCmd = &cobra.Command{
+	Use:               "remove <name>",
+	Short:             removeShort,
+	Long:              removeLong,
+	Args:              cobra.ExactArgs(1),
+	PersistentPreRunE: cmdutils.IsSudoCmd,
+	SilenceUsage:      true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		instance, err := algod.GetInstance(args[0])
+		if err != nil {
+			return err
+		}
+		service := algod.NewInstanceService(instance)
+		if service.IsService() {
+			err = service.Uninstall(false)
+			if err != nil {
+				return err
+			}
+		}
+		err = algod.RemoveInstance(instance.Name)
+		if err != nil {
+			return err
+		}
+		log.Info(style.Green.Render(fmt.Sprintf("Removed instance %s, its data directory %s was kept", instance.Name, instance.DataDir)))
+		return nil
+	},
+}
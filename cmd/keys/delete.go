@@ -0,0 +1,73 @@
+package keys
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/algorandfoundation/nodekit/cmd/utils"
+	"github.com/algorandfoundation/nodekit/internal/algod/participation"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
+	"github.com/spf13/cobra"
+)
+
+// deleteYes skips the confirmation prompt
+var deleteYes bool
+
+var deleteShort = "Delete a participation key"
+
+var deleteLong = lipgloss.JoinVertical(
+	lipgloss.Left,
+	style.Purple(style.BANNER),
+	"",
+	style.Bold(deleteShort),
+	"",
+	style.BoldUnderline("Overview:"),
+	"Removes a participation key from the node by its ID.",
+	"",
+	style.Yellow.Render("Deleting the key an online account is registered with stops it from participating."),
+)
+
+// deleteCmd removes a participation key after confirmation.
+var deleteCmd = utils.WithAlgodFlags(&cobra.Command{
+	Use:          "delete <id>",
+	Short:        deleteShort,
+	Long:         deleteLong,
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		state, err := utils.GetStateModel(ctx, cmd, dataDir)
+		if err != nil {
+			return err
+		}
+		key, _, err := participation.GetKey(ctx, state.Client, args[0])
+		if err != nil {
+			return err
+		}
+
+		status := keyStatus(state, *key)
+		if !deleteYes {
+			msg := fmt.Sprintf("Delete participation key %s for %s?", key.Id, key.Address)
+			if status == "ACTIVE" {
+				msg = fmt.Sprintf("Key %s is ACTIVE for %s, the account will stop participating. Delete it?", key.Id, key.Address)
+			}
+			if !utils.Prompt(msg) {
+				log.Info("Aborted")
+				return nil
+			}
+		}
+
+		err = participation.Delete(ctx, state.Client, key.Id)
+		if err != nil {
+			return err
+		}
+		log.Info(style.Green.Render("Deleted participation key " + key.Id))
+		return nil
+	},
+}, &dataDir)
+
+func init() {
+	deleteCmd.Flags().BoolVarP(&deleteYes, "yes", "y", false, style.LightBlue("Delete without confirmation"))
+}
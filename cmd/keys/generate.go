@@ -0,0 +1,145 @@
+package keys
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/cmd/utils"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/algod/participation"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// generateAddress is the account the key is generated for
+	generateAddress string
+
+	// generateFirst is the first valid round, zero uses the latest round
+	generateFirst int
+
+	// generateLast is the last valid round
+	generateLast int
+
+	// generateRounds is the number of rounds the key is valid for
+	generateRounds int
+
+	// generateDuration is the approximate time the key is valid for
+	generateDuration time.Duration
+
+	// generateDilution is the key dilution, zero uses the node default
+	generateDilution int
+)
+
+var generateShort = "Generate a participation key"
+
+var generateLong = lipgloss.JoinVertical(
+	lipgloss.Left,
+	style.Purple(style.BANNER),
+	"",
+	style.Bold(generateShort),
+	"",
+	style.BoldUnderline("Overview:"),
+	"Generates a participation key for an account on the node and waits until it is installed.",
+	"The end of the validity range is set with exactly one of --last, --rounds or --duration.",
+	"A --duration is converted to rounds with the current average round time.",
+	"",
+	style.Yellow.Render("Generating a key can take several minutes."),
+)
+
+// generateCmd creates a participation key for an account.
+var generateCmd = utils.WithOutputFlag(utils.WithAlgodFlags(&cobra.Command{
+	Use:          "generate",
+	Short:        generateShort,
+	Long:         generateLong,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		if !algod.ValidateAddress(generateAddress) {
+			return fmt.Errorf("invalid address %s", generateAddress)
+		}
+
+		state, err := utils.GetStateModel(ctx, cmd, dataDir)
+		if err != nil {
+			return err
+		}
+
+		params, err := getGenerateParams(cmd, state)
+		if err != nil {
+			return err
+		}
+
+		log.Info(style.Green.Render(fmt.Sprintf("Generating participation key for %s from round %d to %d", generateAddress, params.First, params.Last)))
+		key, err := participation.GenerateKeys(ctx, state.Client, generateAddress, params)
+		if err != nil {
+			return err
+		}
+
+		if output != utils.TableOutput {
+			data, err := utils.Marshal(output, key)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), string(data))
+			return nil
+		}
+		log.Info(style.Green.Render("Participation key generated: " + key.Id))
+		return nil
+	},
+}, &dataDir), &output)
+
+// getGenerateParams builds the generation parameters from the flags and the current round.
+func getGenerateParams(cmd *cobra.Command, state *algod.StateModel) (*api.GenerateParticipationKeysParams, error) {
+	ranges := 0
+	for _, name := range []string{"last", "rounds", "duration"} {
+		if cmd.Flags().Changed(name) {
+			ranges++
+		}
+	}
+	if ranges != 1 {
+		return nil, errors.New("exactly one of --last, --rounds or --duration is required")
+	}
+
+	params := api.GenerateParticipationKeysParams{
+		First: generateFirst,
+	}
+	if !cmd.Flags().Changed("first") {
+		params.First = int(state.Status.LastRound)
+	}
+	if generateDilution > 0 {
+		params.Dilution = &generateDilution
+	}
+
+	switch {
+	case cmd.Flags().Changed("last"):
+		params.Last = generateLast
+	case cmd.Flags().Changed("rounds"):
+		params.Last = params.First + generateRounds
+	default:
+		if state.Metrics.RoundTime <= 0 {
+			return nil, errors.New("the average round time is not known yet, use --rounds or --last instead")
+		}
+		params.Last = params.First + int(generateDuration/state.Metrics.RoundTime)
+	}
+
+	if params.Last <= params.First {
+		return nil, fmt.Errorf("last round %d must be after first round %d", params.Last, params.First)
+	}
+	return &params, nil
+}
+
+func init() {
+	generateCmd.Flags().StringVarP(&generateAddress, "address", "a", "", style.LightBlue("Account to generate the key for"))
+	generateCmd.Flags().IntVar(&generateFirst, "first", 0, style.LightBlue("First valid round, defaults to the latest round"))
+	generateCmd.Flags().IntVar(&generateLast, "last", 0, style.LightBlue("Last valid round"))
+	generateCmd.Flags().IntVar(&generateRounds, "rounds", 0, style.LightBlue("Number of rounds the key is valid for"))
+	generateCmd.Flags().DurationVar(&generateDuration, "duration", 0, style.LightBlue("Time the key is valid for, e.g. 720h"))
+	generateCmd.Flags().IntVar(&generateDilution, "dilution", 0, style.LightBlue("Key dilution, defaults to the node default"))
+	_ = generateCmd.MarkFlagRequired("address")
+	generateCmd.MarkFlagsMutuallyExclusive("last", "rounds", "duration")
+}
@@ -0,0 +1,85 @@
+package keys
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/algorandfoundation/nodekit/cmd/utils"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/algod/participation"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
+	"github.com/spf13/cobra"
+)
+
+// importAddress is the account the imported key must belong to
+var importAddress string
+
+var importShort = "Import a participation key file"
+
+var importLong = lipgloss.JoinVertical(
+	lipgloss.Left,
+	style.Purple(style.BANNER),
+	"",
+	style.Bold(importShort),
+	"",
+	style.BoldUnderline("Overview:"),
+	"Uploads a .partkey file to the node, for example one created on an air-gapped machine with:",
+	"",
+	"  algokey part generate --parent <address> --first <round> --last <round> --keyfile <file.partkey>",
+	"",
+	"The key material never has to be generated on the internet-facing node.",
+	"Use --address to reject a file that belongs to a different account before it is uploaded.",
+)
+
+// importCmd installs a participation key file on the node.
+var importCmd = utils.WithOutputFlag(utils.WithAlgodFlags(&cobra.Command{
+	Use:          "import <file.partkey>",
+	Short:        importShort,
+	Long:         importLong,
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		if importAddress != "" && !algod.ValidateAddress(importAddress) {
+			return fmt.Errorf("invalid address %s", importAddress)
+		}
+
+		file, err := os.Open(args[0])
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		state, err := utils.GetStateModel(ctx, cmd, dataDir)
+		if err != nil {
+			return err
+		}
+
+		key, err := participation.Import(ctx, state.Client, importAddress, file)
+		if err != nil {
+			return err
+		}
+
+		if key.Key.VoteLastValid < int(state.Status.LastRound) {
+			log.Warn(style.Yellow.Render(fmt.Sprintf("Key %s expired at round %d", key.Id, key.Key.VoteLastValid)))
+		}
+
+		if output != utils.TableOutput {
+			data, err := utils.Marshal(output, key)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), string(data))
+			return nil
+		}
+		log.Info(style.Green.Render(fmt.Sprintf("Imported participation key %s for %s (%s)", key.Id, key.Address, keyStatus(state, *key))))
+		return nil
+	},
+}, &dataDir), &output)
+
+func init() {
+	importCmd.Flags().StringVarP(&importAddress, "address", "a", "", style.LightBlue("Account the key must belong to"))
+}
@@ -0,0 +1,89 @@
+package keys
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+
+	"github.com/algorandfoundation/nodekit/cmd/utils"
+	"github.com/algorandfoundation/nodekit/internal/algod/participation"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"github.com/spf13/cobra"
+)
+
+var infoShort = "Display a participation key"
+
+var infoLong = lipgloss.JoinVertical(
+	lipgloss.Left,
+	style.Purple(style.BANNER),
+	"",
+	style.Bold(infoShort),
+	"",
+	style.BoldUnderline("Overview:"),
+	"Prints the public keys, validity and dilution of a participation key by its ID.",
+)
+
+// infoCmd prints a single participation key.
+var infoCmd = utils.WithOutputFlag(utils.WithAlgodFlags(&cobra.Command{
+	Use:          "info <id>",
+	Short:        infoShort,
+	Long:         infoLong,
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		state, err := utils.GetStateModel(ctx, cmd, dataDir)
+		if err != nil {
+			return err
+		}
+		key, _, err := participation.GetKey(ctx, state.Client, args[0])
+		if err != nil {
+			return err
+		}
+
+		if output != utils.TableOutput {
+			data, err := utils.Marshal(output, key)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), string(data))
+			return nil
+		}
+
+		stateProofKey := ""
+		if key.Key.StateProofKey != nil {
+			stateProofKey = base64.StdEncoding.EncodeToString(*key.Key.StateProofKey)
+		}
+		rows := [][]string{
+			{"Participation ID:", key.Id},
+			{"Account:", key.Address},
+			{"Status:", keyStatus(state, *key)},
+			{"Vote Key:", base64.StdEncoding.EncodeToString(key.Key.VoteParticipationKey)},
+			{"Selection Key:", base64.StdEncoding.EncodeToString(key.Key.SelectionParticipationKey)},
+			{"State Proof Key:", stateProofKey},
+			{"Vote First Valid:", strconv.Itoa(key.Key.VoteFirstValid)},
+			{"Vote Last Valid:", strconv.Itoa(key.Key.VoteLastValid)},
+			{"Vote Key Dilution:", strconv.Itoa(key.Key.VoteKeyDilution)},
+		}
+		if key.LastVote != nil {
+			rows = append(rows, []string{"Last Vote:", strconv.Itoa(*key.LastVote)})
+		}
+		if key.LastBlockProposal != nil {
+			rows = append(rows, []string{"Last Block Proposal:", strconv.Itoa(*key.LastBlockProposal)})
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), table.New().
+			Border(lipgloss.HiddenBorder()).
+			StyleFunc(func(row, col int) lipgloss.Style {
+				if col == 0 {
+					return lipgloss.NewStyle().Align(lipgloss.Right)
+				}
+				return lipgloss.NewStyle()
+			}).
+			Rows(rows...).
+			String())
+		return nil
+	},
+}, &dataDir), &output)
@@ -0,0 +1,163 @@
+package keys
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/cmd/utils"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/algod/participation"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// keyregAddress is the account of an offline or nonparticipating keyreg
+	keyregAddress string
+
+	// keyregOffline writes an offline keyreg
+	keyregOffline bool
+
+	// keyregNonParticipating writes a nonparticipating keyreg
+	keyregNonParticipating bool
+
+	// keyregNoIncentives skips the incentive eligibility fee
+	keyregNoIncentives bool
+
+	// keyregOut is the path of the unsigned transaction file
+	keyregOut string
+
+	// keyregMultisigThreshold is the number of signatures the multisig requires
+	keyregMultisigThreshold int
+
+	// keyregMultisigAddresses are the ordered members of the multisig
+	keyregMultisigAddresses []string
+)
+
+var keyregShort = "Write an unsigned keyreg transaction file"
+
+var keyregLong = lipgloss.JoinVertical(
+	lipgloss.Left,
+	style.Purple(style.BANNER),
+	"",
+	style.Bold(keyregShort),
+	"",
+	style.BoldUnderline("Overview:"),
+	"Writes an unsigned key registration transaction in the msgpack format used by goal,",
+	"using the suggested parameters of the node. Sign it with a hardware wallet, a multisig",
+	"or `goal clerk sign` and submit it with `nodekit keys submit`.",
+	"",
+	"  nodekit keys keyreg <id>                            register the key online",
+	"  nodekit keys keyreg --offline -a <address>          take the account offline",
+	"  nodekit keys keyreg --nonparticipating -a <address> mark the account as nonparticipating",
+	"",
+	"The 2 ALGO incentive eligibility fee is added to online keyregs of accounts that are not",
+	"eligible yet, unless --no-incentives is set. The transaction is valid for 1000 rounds.",
+	"",
+	"Rekeyed accounts are signed by their authorizing address. For a multisig, pass",
+	"--multisig-threshold and every --multisig-address in order to include the preimage",
+	"required by `goal clerk multisig sign`.",
+	"",
+	style.Red.Render("A nonparticipating keyreg is permanent, the account can never go online again."),
+)
+
+// keyregCmd writes an unsigned keyreg transaction without any third-party service.
+var keyregCmd = utils.WithAlgodFlags(&cobra.Command{
+	Use:          "keyreg [id]",
+	Short:        keyregShort,
+	Long:         keyregLong,
+	Args:         cobra.MaximumNArgs(1),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		kind := participation.OnlineKeyreg
+		switch {
+		case keyregOffline:
+			kind = participation.OfflineKeyreg
+		case keyregNonParticipating:
+			kind = participation.NonParticipatingKeyreg
+		}
+		if kind == participation.OnlineKeyreg && len(args) == 0 {
+			return errors.New("a participation key ID is required for an online keyreg")
+		}
+		if kind != participation.OnlineKeyreg && !algod.ValidateAddress(keyregAddress) {
+			return fmt.Errorf("invalid address %s", keyregAddress)
+		}
+
+		state, err := utils.GetStateModel(ctx, cmd, dataDir)
+		if err != nil {
+			return err
+		}
+
+		var key *api.ParticipationKey
+		address := keyregAddress
+		if kind == participation.OnlineKeyreg {
+			key, _, err = participation.GetKey(ctx, state.Client, args[0])
+			if err != nil {
+				return err
+			}
+			address = key.Address
+		}
+
+		params, err := participation.GetSuggestedParams(ctx, state.Client)
+		if err != nil {
+			return err
+		}
+
+		acct, ok := state.Accounts[address]
+		incentivesFee := kind == participation.OnlineKeyreg && !keyregNoIncentives && !(ok && acct.IncentiveEligible)
+		txn, err := participation.MakeKeyregTxn(kind, address, key, params, incentivesFee)
+		if err != nil {
+			return err
+		}
+
+		var msig *crypto.MultisigAccount
+		if len(keyregMultisigAddresses) > 0 {
+			msig, err = participation.NewMultisig(keyregMultisigThreshold, keyregMultisigAddresses)
+			if err != nil {
+				return err
+			}
+		} else if acct.IsMultisig() {
+			log.Warn(style.Yellow.Render("The account signs with a multisig, use --multisig-threshold and --multisig-address to include the preimage"))
+		}
+		data, err := participation.EncodeUnsignedTxn(txn, acct.AuthAddr, msig)
+		if err != nil {
+			return err
+		}
+
+		out := keyregOut
+		if out == "" {
+			out = fmt.Sprintf("%s.%s.txn", address, kind)
+		}
+		err = os.WriteFile(out, data, 0644)
+		if err != nil {
+			return err
+		}
+
+		log.Info(style.Green.Render(fmt.Sprintf("Wrote unsigned %s keyreg for %s to %s", kind, address, out)))
+		log.Info(fmt.Sprintf("Fee: %d microalgos, valid from round %d to %d", txn.Fee, txn.FirstValid, txn.LastValid))
+		if acct.IsRekeyed() {
+			log.Warn(style.Yellow.Render(fmt.Sprintf("The account is rekeyed, sign with the authorizing address %s", acct.AuthAddr)))
+		}
+		return nil
+	},
+}, &dataDir)
+
+func init() {
+	keyregCmd.Flags().StringVarP(&keyregAddress, "address", "a", "", style.LightBlue("Account of an offline or nonparticipating keyreg"))
+	keyregCmd.Flags().BoolVar(&keyregOffline, "offline", false, style.LightBlue("Write an offline keyreg"))
+	keyregCmd.Flags().BoolVar(&keyregNonParticipating, "nonparticipating", false, style.LightBlue("Write a nonparticipating keyreg"))
+	keyregCmd.Flags().BoolVarP(&keyregNoIncentives, "no-incentives", "n", false, style.LightBlue("Disable setting incentive eligibility fees"))
+	keyregCmd.Flags().StringVarP(&keyregOut, "out", "f", "", style.LightBlue("Path of the unsigned transaction (default: <address>.<type>.txn)"))
+	keyregCmd.Flags().IntVar(&keyregMultisigThreshold, "multisig-threshold", 0, style.LightBlue("Signatures required by the multisig"))
+	keyregCmd.Flags().StringSliceVar(&keyregMultisigAddresses, "multisig-address", nil, style.LightBlue("Member of the multisig, repeat in order"))
+	keyregCmd.MarkFlagsMutuallyExclusive("offline", "nonparticipating")
+	keyregCmd.MarkFlagsRequiredTogether("multisig-threshold", "multisig-address")
+}
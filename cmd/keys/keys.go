@@ -0,0 +1,49 @@
+package keys
+
+import (
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// dataDir path to the algorand data folder
+	dataDir string = ""
+
+	// output is the output format of the list, info and generate commands
+	output string
+
+	// Short provides a brief description of the keys command.
+	Short = "Manage participation keys"
+
+	// Long provides a detailed description of the keys command.
+	Long = lipgloss.JoinVertical(
+		lipgloss.Left,
+		style.Purple(style.BANNER),
+		"",
+		style.Bold(Short),
+		"",
+		style.BoldUnderline("Overview:"),
+		"Manage the participation keys of the node without the TUI.",
+		"",
+		style.Yellow.Render("This requires the daemon to be running."),
+	)
+
+	// Cmd is the parent command of the participation key subcommands.
+	Cmd = &cobra.Command{
+		Use:   "keys",
+		Short: Short,
+		Long:  Long,
+	}
+)
+
+func init() {
+	Cmd.AddCommand(listCmd)
+	Cmd.AddCommand(infoCmd)
+	Cmd.AddCommand(generateCmd)
+	Cmd.AddCommand(importCmd)
+	Cmd.AddCommand(deleteCmd)
+	Cmd.AddCommand(keyregCmd)
+	Cmd.AddCommand(submitCmd)
+	Cmd.AddCommand(rotateCmd)
+}
@@ -0,0 +1,100 @@
+package keys
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/cmd/utils"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/algod/participation"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"github.com/spf13/cobra"
+)
+
+// listAddress filters the keys by account
+var listAddress string
+
+var listShort = "List the participation keys on the node"
+
+var listLong = lipgloss.JoinVertical(
+	lipgloss.Left,
+	style.Purple(style.BANNER),
+	"",
+	style.Bold(listShort),
+	"",
+	style.BoldUnderline("Overview:"),
+	"Lists every participation key installed on the node and whether it is",
+	"the key the account is currently registered with.",
+)
+
+// listCmd prints the participation keys as a table, JSON or YAML.
+var listCmd = utils.WithOutputFlag(utils.WithAlgodFlags(&cobra.Command{
+	Use:          "list",
+	Short:        listShort,
+	Long:         listLong,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		if listAddress != "" && !algod.ValidateAddress(listAddress) {
+			return fmt.Errorf("invalid address %s", listAddress)
+		}
+		state, err := utils.GetStateModel(ctx, cmd, dataDir)
+		if err != nil {
+			return err
+		}
+
+		keys := make(participation.List, 0, len(state.ParticipationKeys))
+		for _, key := range state.ParticipationKeys {
+			if listAddress == "" || key.Address == listAddress {
+				keys = append(keys, key)
+			}
+		}
+
+		if output != utils.TableOutput {
+			data, err := utils.Marshal(output, keys)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), string(data))
+			return nil
+		}
+
+		rows := make([][]string, 0, len(keys))
+		for _, key := range keys {
+			rows = append(rows, []string{
+				key.Id,
+				key.Address,
+				strconv.Itoa(key.Key.VoteFirstValid),
+				strconv.Itoa(key.Key.VoteLastValid),
+				strconv.Itoa(key.Key.VoteKeyDilution),
+				keyStatus(state, key),
+			})
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), table.New().
+			Border(lipgloss.HiddenBorder()).
+			Headers("ID", "Address", "First Valid", "Last Valid", "Dilution", "Status").
+			Rows(rows...).
+			String())
+		return nil
+	},
+}, &dataDir), &output)
+
+// keyStatus describes whether the account is registered with the key.
+func keyStatus(state *algod.StateModel, key api.ParticipationKey) string {
+	acct, ok := state.Accounts[key.Address]
+	if ok && acct.Participation != nil && participation.IsActive(key, *acct.Participation) {
+		if key.Key.VoteLastValid < int(state.Status.LastRound) {
+			return "EXPIRED"
+		}
+		return "ACTIVE"
+	}
+	return "INACTIVE"
+}
+
+func init() {
+	listCmd.Flags().StringVarP(&listAddress, "address", "a", "", style.LightBlue("Only list keys for this account"))
+}
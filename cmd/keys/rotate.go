@@ -0,0 +1,172 @@
+package keys
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/cmd/utils"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/algod/participation"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"github.com/charmbracelet/log"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// rotateApply executes the plan instead of only printing it
+	rotateApply bool
+
+	// rotateNoIncentives skips the incentive eligibility fee on the keyreg
+	rotateNoIncentives bool
+
+	// rotateAddresses limits the rotation to these accounts
+	rotateAddresses []string
+
+	// rotateOptions configures the successor keys
+	rotateOptions = algod.RotationOptions{
+		Threshold: 200_000,
+		Rounds:    3_000_000,
+	}
+
+	// rotateDilution is the key dilution of successor keys, zero uses the node default
+	rotateDilution int
+)
+
+var rotateShort = "Plan and run participation key rotation"
+
+var rotateLong = lipgloss.JoinVertical(
+	lipgloss.Left,
+	style.Purple(style.BANNER),
+	"",
+	style.Bold(rotateShort),
+	"",
+	style.BoldUnderline("Overview:"),
+	"Checks every online account with a key on this node and plans the next rotation step:",
+	"",
+	"  generate  the active key is within --threshold rounds of expiry, a successor key is",
+	"            generated starting at the current round so both keys overlap",
+	"  register  the successor key exists, sign the online keyreg to switch to it",
+	"  pending   the account is registered with a newer key, the old key is kept",
+	"            for the 320 rounds consensus still uses it",
+	"  delete    the account is registered with a newer key, the old key is removed",
+	"",
+	"The plan is printed without changes unless --apply is set. Run it periodically",
+	"to move each account through the rotation.",
+)
+
+// rotateCmd plans the rotation of every account and optionally applies it.
+var rotateCmd = utils.WithAlgodFlags(&cobra.Command{
+	Use:          "rotate",
+	Short:        rotateShort,
+	Long:         rotateLong,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		for _, address := range rotateAddresses {
+			if !algod.ValidateAddress(address) {
+				return fmt.Errorf("invalid address %s", address)
+			}
+		}
+		if rotateDilution > 0 {
+			rotateOptions.Dilution = &rotateDilution
+		}
+
+		state, err := utils.GetStateModel(ctx, cmd, dataDir)
+		if err != nil {
+			return err
+		}
+
+		accounts := state.Accounts
+		if len(rotateAddresses) > 0 {
+			accounts = make(map[string]algod.Account)
+			for _, address := range rotateAddresses {
+				if acct, ok := state.Accounts[address]; ok {
+					accounts[address] = acct
+				}
+			}
+		}
+
+		steps := algod.PlanRotation(accounts, state.ParticipationKeys, int(state.Status.LastRound), rotateOptions)
+		printRotationPlan(cmd, steps)
+		if !rotateApply {
+			return nil
+		}
+
+		for _, step := range steps {
+			switch step.Action {
+			case algod.RotationGenerate:
+				log.Info(fmt.Sprintf("Generating successor key for %s, this may take a few minutes", step.Address))
+				key, err := algod.ApplyRotationStep(ctx, state.Client, step)
+				if err != nil {
+					return err
+				}
+				log.Info(style.Green.Render("Generated key " + key.Id))
+				err = printKeyreg(cmd, state, *key)
+				if err != nil {
+					return err
+				}
+			case algod.RotationRegister:
+				err = printKeyreg(cmd, state, *step.Successor)
+				if err != nil {
+					return err
+				}
+			case algod.RotationDelete:
+				_, err = algod.ApplyRotationStep(ctx, state.Client, step)
+				if err != nil {
+					return err
+				}
+				log.Info(style.Green.Render(fmt.Sprintf("Deleted key %s for %s", step.Key.Id, step.Address)))
+			}
+		}
+		return nil
+	},
+}, &dataDir)
+
+// printRotationPlan renders the steps as a table.
+func printRotationPlan(cmd *cobra.Command, steps []algod.RotationStep) {
+	rows := make([][]string, 0, len(steps))
+	for _, step := range steps {
+		key := ""
+		if step.Key != nil {
+			key = step.Key.Id
+		}
+		successor := ""
+		if step.Successor != nil {
+			successor = step.Successor.Id
+		}
+		if step.Params != nil {
+			successor = fmt.Sprintf("rounds %d-%d", step.Params.First, step.Params.Last)
+		}
+		rows = append(rows, []string{step.Address, string(step.Action), key, successor, step.Reason})
+	}
+	fmt.Fprintln(cmd.OutOrStdout(), table.New().
+		Border(lipgloss.HiddenBorder()).
+		Headers("Address", "Action", "Key", "Successor", "Reason").
+		Rows(rows...).
+		String())
+}
+
+// printKeyreg creates the online keyreg link for the successor key.
+func printKeyreg(cmd *cobra.Command, state *algod.StateModel, key api.ParticipationKey) error {
+	link, err := participation.GetOnlineShortLink(state.HttpPkg, participation.ToOnlineShortLinkBody(key, state.Status.Network, state.Status.GenesisHash))
+	if err != nil {
+		return err
+	}
+	acct, ok := state.Accounts[key.Address]
+	incentivesFee := !rotateNoIncentives && ok && !acct.IncentiveEligible
+	log.Info(fmt.Sprintf("Sign the online keyreg for %s (valid until round %d):", key.Address, key.Key.VoteLastValid))
+	fmt.Fprintln(cmd.OutOrStdout(), participation.ToShortLink(link, incentivesFee))
+	return nil
+}
+
+func init() {
+	rotateCmd.Flags().BoolVar(&rotateApply, "apply", false, style.LightBlue("Generate, register and delete keys according to the plan"))
+	rotateCmd.Flags().StringSliceVarP(&rotateAddresses, "address", "a", nil, style.LightBlue("Only rotate these accounts"))
+	rotateCmd.Flags().IntVar(&rotateOptions.Threshold, "threshold", rotateOptions.Threshold, style.LightBlue("Rounds before expiry to generate a successor key"))
+	rotateCmd.Flags().IntVar(&rotateOptions.Rounds, "rounds", rotateOptions.Rounds, style.LightBlue("Validity of the successor key in rounds"))
+	rotateCmd.Flags().IntVar(&rotateDilution, "dilution", 0, style.LightBlue("Key dilution of the successor key (default: node default)"))
+	rotateCmd.Flags().BoolVarP(&rotateNoIncentives, "no-incentives", "n", false, style.LightBlue("Disable setting incentive eligibility fees"))
+}
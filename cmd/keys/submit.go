@@ -0,0 +1,64 @@
+package keys
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/algorand/go-algorand-sdk/v2/types"
+	"github.com/algorandfoundation/nodekit/cmd/utils"
+	"github.com/algorandfoundation/nodekit/internal/algod/participation"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
+	"github.com/spf13/cobra"
+)
+
+var submitShort = "Submit a signed keyreg transaction file"
+
+var submitLong = lipgloss.JoinVertical(
+	lipgloss.Left,
+	style.Purple(style.BANNER),
+	"",
+	style.Bold(submitShort),
+	"",
+	style.BoldUnderline("Overview:"),
+	"Sends a signed transaction, for example a keyreg written by `nodekit keys keyreg`",
+	"and signed offline, to the network through the node.",
+	"",
+	style.Yellow.Render("This requires the daemon to be running."),
+)
+
+// submitCmd sends a signed transaction file through algod.
+var submitCmd = utils.WithAlgodFlags(&cobra.Command{
+	Use:          "submit <file.stxn>",
+	Short:        submitShort,
+	Long:         submitLong,
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		data, err := os.ReadFile(args[0])
+		if err != nil {
+			return err
+		}
+		stxn, err := participation.DecodeSignedTxn(data)
+		if err != nil {
+			return err
+		}
+		if stxn.Txn.Type != types.KeyRegistrationTx {
+			log.Warn(style.Yellow.Render(fmt.Sprintf("Submitting a %s transaction instead of a keyreg", stxn.Txn.Type)))
+		}
+
+		state, err := utils.GetStateModel(ctx, cmd, dataDir)
+		if err != nil {
+			return err
+		}
+		txId, err := participation.SendRawTxn(ctx, state.Client, data)
+		if err != nil {
+			return err
+		}
+		log.Info(style.Green.Render("Submitted transaction " + txId))
+		return nil
+	},
+}, &dataDir)
@@ -4,14 +4,20 @@ import (
 	"context"
 	"fmt"
 	"runtime"
+	"time"
 
 	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/cmd/alerts"
 	"github.com/algorandfoundation/nodekit/cmd/catchup"
 	"github.com/algorandfoundation/nodekit/cmd/configure"
+	"github.com/algorandfoundation/nodekit/cmd/instance"
+	"github.com/algorandfoundation/nodekit/cmd/keys"
 	"github.com/algorandfoundation/nodekit/cmd/telemetry"
 	"github.com/algorandfoundation/nodekit/cmd/utils"
 	"github.com/algorandfoundation/nodekit/cmd/utils/explanations"
 	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/algod/progress"
+	"github.com/algorandfoundation/nodekit/internal/algod/upgrade"
 	algodutils "github.com/algorandfoundation/nodekit/internal/algod/utils"
 	"github.com/algorandfoundation/nodekit/internal/system"
 	"github.com/algorandfoundation/nodekit/ui"
@@ -37,6 +43,9 @@ var (
 	// force indicates whether actions should be performed forcefully, bypassing checks or confirmations.
 	force bool = false
 
+	// CatchupEstimateSample is how long the sync rate is observed before recommending a fast catchup in the TUI.
+	CatchupEstimateSample = 5 * time.Second
+
 	short = "Manage Algorand nodes from the command line"
 	long  = lipgloss.JoinVertical(
 		lipgloss.Left,
@@ -69,47 +78,79 @@ var (
 )
 
 // NeedsToBeRunning ensures the Algod software is installed and running before executing the associated Cobra command.
-func NeedsToBeRunning(cmd *cobra.Command, args []string) {
-	if force {
-		return
-	}
-	if !algod.IsInstalled() {
-		log.Fatal(explanations.NotInstalledErrorMsg)
-	}
-	if !algod.IsRunning(algodData) {
-		log.Fatal(explanations.NotRunningErrorMsg)
+func NeedsToBeRunning(service *utils.Service) func(cmd *cobra.Command, args []string) {
+	return func(cmd *cobra.Command, args []string) {
+		if err := utils.NeedsLocalNode(cmd, args); err != nil {
+			log.Fatal(err)
+		}
+		if force {
+			return
+		}
+		if !service.IsInstalled() {
+			log.Fatal(explanations.NotInstalledErrorMsg)
+		}
+		if !algod.IsRunning(service.Interface, algodData) {
+			log.Fatal(explanations.NotRunningErrorMsg)
+		}
 	}
 }
 
 // NeedsToBeStopped ensures the operation halts if Algod is not installed or is currently running, unless forced.
-func NeedsToBeStopped(cmd *cobra.Command, args []string) {
-	if force {
-		return
-	}
-	if !algod.IsInstalled() {
-		log.Fatal(explanations.NotInstalledErrorMsg)
-	}
-	if algod.IsRunning(algodData) {
-		log.Fatal(explanations.RunningErrorMsg)
+func NeedsToBeStopped(service *utils.Service) func(cmd *cobra.Command, args []string) {
+	return func(cmd *cobra.Command, args []string) {
+		if err := utils.NeedsLocalNode(cmd, args); err != nil {
+			log.Fatal(err)
+		}
+		if force {
+			return
+		}
+		if !service.IsInstalled() {
+			log.Fatal(explanations.NotInstalledErrorMsg)
+		}
+		if algod.IsRunning(service.Interface, algodData) {
+			log.Fatal(explanations.RunningErrorMsg)
+		}
 	}
 }
 
 // init initializes the application, setting up logging, commands, and version information.
 func init() {
 	log.SetReportTimestamp(false)
+	// The service is selected once and handed to the commands managing the node
+	service := utils.NewService()
+	cobra.OnInitialize(func() {
+		// Register the custom networks of the settings before any command resolves a network
+		if err := algod.LoadNetworks(); err != nil {
+			log.Warn(style.Yellow.Render("Unable to load the custom networks: " + err.Error()))
+		}
+		// Point the data directory and the service to the selected instance
+		if utils.Instance != "" {
+			if err := service.Select(utils.Instance); err != nil {
+				log.Fatal(err)
+			}
+		}
+	})
 	RootCmd.Flags().BoolVarP(&IncentivesDisabled, "no-incentives", "n", false, style.LightBlue("Disable setting incentive eligibility fees"))
 	RootCmd.SetVersionTemplate(fmt.Sprintf("nodekit-%s-%s@{{.Version}}\n", runtime.GOARCH, runtime.GOOS))
 	// Add Commands
 	if runtime.GOOS != "windows" {
-		RootCmd.AddCommand(bootstrapCmd)
-		RootCmd.AddCommand(debugCmd)
-		RootCmd.AddCommand(installCmd)
-		RootCmd.AddCommand(startCmd)
-		RootCmd.AddCommand(stopCmd)
-		RootCmd.AddCommand(uninstallCmd)
-		RootCmd.AddCommand(upgradeCmd)
+		RootCmd.AddCommand(alerts.Cmd)
+		RootCmd.AddCommand(newApplyCmd(service))
+		RootCmd.AddCommand(newBootstrapCmd(service))
+		RootCmd.AddCommand(newDebugCmd(service))
+		RootCmd.AddCommand(newDoctorCmd(service))
+		RootCmd.AddCommand(exporterCmd)
+		RootCmd.AddCommand(fleetCmd)
+		RootCmd.AddCommand(newInstallCmd(service))
+		RootCmd.AddCommand(newStartCmd(service))
+		RootCmd.AddCommand(statusCmd)
+		RootCmd.AddCommand(newStopCmd(service))
+		RootCmd.AddCommand(newUninstallCmd(service))
+		RootCmd.AddCommand(newUpgradeCmd(service))
 		RootCmd.AddCommand(catchup.Cmd)
-		RootCmd.AddCommand(configure.Cmd)
+		RootCmd.AddCommand(configure.NewCmd(service))
+		RootCmd.AddCommand(instance.Cmd)
+		RootCmd.AddCommand(keys.Cmd)
 		RootCmd.AddCommand(telemetry.Cmd)
 	}
 }
@@ -130,11 +171,7 @@ func runTUI(cmd *cobra.Command, algodData string, incentivesFlag bool, version s
 	httpPkg := new(api.HttpPkg)
 	t := new(system.Clock)
 
-	dataDir, err := algod.GetDataDir(algodData)
-	if err != nil {
-		log.Fatal(err)
-	}
-	client, err := algod.GetClient(dataDir)
+	client, dataDir, err := utils.GetClient(algodData)
 	cobra.CheckErr(err)
 
 	// Fetch the state and handle any creation errors
@@ -153,15 +190,40 @@ func runTUI(cmd *cobra.Command, algodData string, incentivesFlag bool, version s
 	)
 
 	// Watch for State Updates on a separate thread
-	// TODO: refactor into context aware watcher without callbacks
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	watcher := algod.NewWatcher(state, t)
+	events := watcher.Subscribe(watchCtx, 1)
+	// Recommend a fast catchup when it is faster than syncing,
+	// estimated from a copy of the status since the watcher updates the state
+	status := state.Status
+	go watcher.Run(watchCtx)
 	go func() {
-		// Check if the instance is lagging
-		lagging, err := algod.IsLagging(httpPkg, state.Status.LastRound, state.Status.Network)
-		if err != nil {
-			if err != api.ErrInvalidNetwork {
-				cobra.CheckErr(err)
-			}
+		if status.State == algod.FastCatchupState {
+			return
 		}
+		estimate, err := algod.GetCatchupEstimate(ctx, status, dataDir, CatchupEstimateSample)
+		if err != nil || !estimate.Recommended {
+			return
+		}
+		p.Send(estimate)
+		p.Send(app.LaggingModal)
+	}()
+	// Record the progress of a fast catchup and the upgrade votes.
+	// The trackers are optional, they only refine the catchup ETA and the vote projection.
+	var tracker *progress.Tracker
+	trackerPath, err := progress.Path(dataDir, utils.Profile)
+	if err == nil {
+		tracker, _ = progress.Load(trackerPath)
+	}
+	var upgradeTracker *upgrade.Tracker
+	upgradePath, err := upgrade.Path(dataDir, utils.Profile)
+	if err == nil {
+		upgradeTracker, _ = upgrade.Load(upgradePath)
+	}
+	go func() {
+		// The switch round the upgrade alert was shown for, it is shown once per upgrade
+		alertedRound := 0
 
 		// Display Hybrid Notice on launch
 		// Only shown if EnableP2PHybridMode is unset/false and hasn't already been set to "do not show again"
@@ -170,24 +232,35 @@ func runTUI(cmd *cobra.Command, algodData string, incentivesFlag bool, version s
 			p.Send(app.HybridModal)
 		}
 
-		state.Watch(func(status *algod.StateModel, err error) {
+		for event := range events {
+			status := event.Snapshot.Status
 			// Handle Fast Catchup
-			if state.Status.State == algod.FastCatchupState {
+			if tracker != nil && tracker.Record(t.Now(), status) {
+				_ = tracker.Save()
+				p.Send(tracker.Report(t.Now()))
+			}
+			if status.State == algod.FastCatchupState {
 				p.Send(app.CatchupModal)
-				lagging = false
-			} else if lagging && status.Status.State != algod.FastCatchupState {
-				p.Send(app.LaggingModal)
-				lagging = false
 			}
-
-			if err == nil {
-				p.Send(state)
+			// Handle Protocol Upgrades
+			if upgradeTracker != nil {
+				if upgradeTracker.Record(t.Now(), status) {
+					_ = upgradeTracker.Save()
+				}
+				roundTime := time.Duration(event.Snapshot.Metrics.RoundTime * float64(time.Second))
+				report := upgradeTracker.Report(t.Now(), status, roundTime)
+				p.Send(report)
+				if report.Blocking && report.SwitchRound != alertedRound && status.State == algod.StableState {
+					alertedRound = report.SwitchRound
+					p.Send(app.UpgradeModal)
+				}
 			}
-			if err != nil {
-				p.Send(state)
-				p.Send(err)
+
+			p.Send(state)
+			if event.Type == algod.NodeDownEvent {
+				p.Send(event.Err)
 			}
-		}, ctx, t)
+		}
 	}()
 
 	// Execute the TUI Application
@@ -3,7 +3,6 @@ package cmd
 import (
 	cmdutils "github.com/algorandfoundation/nodekit/cmd/utils"
 	"github.com/algorandfoundation/nodekit/cmd/utils/explanations"
-	"github.com/algorandfoundation/nodekit/internal/algod"
 	"github.com/algorandfoundation/nodekit/ui/style"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/log"
@@ -24,26 +23,25 @@ var startLong = lipgloss.JoinVertical(
 	style.Yellow.Render("This requires the daemon to be installed on your system."),
 )
 
-// startCmd is a Cobra command used to start the Algod service on the system, ensuring necessary checks are performed beforehand.
-var startCmd = cmdutils.WithAlgodFlags(&cobra.Command{
-	Use:              "start",
-	Short:            startShort,
-	Long:             startLong,
-	SilenceUsage:     true,
-	PersistentPreRun: NeedsToBeStopped,
-	Run: func(cmd *cobra.Command, args []string) {
-		log.Info(style.Green.Render("Starting Algod 🚀"))
-		// Warn user for prompt
-		log.Warn(style.Yellow.Render(explanations.SudoWarningMsg))
-		err := algod.Start()
-		if err != nil {
-			log.Fatal(err)
-		}
-		log.Info(style.Green.Render("Algorand started successfully 🎉"))
-	},
-}, &algodData)
-
-// init initializes the `force` flag for the `start` command, allowing the node to start forcefully when specified.
-func init() {
-	startCmd.Flags().BoolVarP(&force, "force", "f", false, style.Yellow.Render("forcefully start the node"))
+// newStartCmd returns a Cobra command used to start the Algod service on the system, ensuring necessary checks are performed beforehand.
+func newStartCmd(service *cmdutils.Service) *cobra.Command {
+	cmd := cmdutils.WithAlgodFlags(&cobra.Command{
+		Use:              "start",
+		Short:            startShort,
+		Long:             startLong,
+		SilenceUsage:     true,
+		PersistentPreRun: NeedsToBeStopped(service),
+		Run: func(cmd *cobra.Command, args []string) {
+			log.Info(style.Green.Render("Starting Algod 🚀"))
+			// Warn user for prompt
+			log.Warn(style.Yellow.Render(explanations.SudoWarningMsg))
+			err := service.Start()
+			if err != nil {
+				log.Fatal(err)
+			}
+			log.Info(style.Green.Render("Algorand started successfully 🎉"))
+		},
+	}, &algodData)
+	cmd.Flags().BoolVarP(&force, "force", "f", false, style.Yellow.Render("forcefully start the node"))
+	return cmd
 }
@@ -0,0 +1,34 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/cmd/utils"
+	"github.com/algorandfoundation/nodekit/internal/test/mock"
+)
+
+func Test_StartCommand(t *testing.T) {
+	service := &mock.Service{Installed: true, Service: true}
+	startCmd := newStartCmd(&utils.Service{Interface: service})
+
+	startCmd.PersistentPreRun(startCmd, nil)
+	startCmd.Run(startCmd, nil)
+	if strings.Join(service.Calls, ",") != "Start" || !service.Running {
+		t.Errorf("expected the node to be started, got %v", service.Calls)
+	}
+}
+
+func Test_StopCommand(t *testing.T) {
+	defer func(previous time.Duration) { StopTimeout = previous }(StopTimeout)
+	StopTimeout = 0
+	service := &mock.Service{Installed: true, Service: true, Running: true}
+	stopCmd := newStopCmd(&utils.Service{Interface: service})
+
+	stopCmd.PersistentPreRun(stopCmd, nil)
+	stopCmd.Run(stopCmd, nil)
+	if strings.Join(service.Calls, ",") != "Stop" || service.Running {
+		t.Errorf("expected the node to be stopped, got %v", service.Calls)
+	}
+}
@@ -0,0 +1,178 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	cmdutils "github.com/algorandfoundation/nodekit/cmd/utils"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/system"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"github.com/spf13/cobra"
+)
+
+// statusOutput is the output format of the status command
+var statusOutput string
+
+// statusWatch streams the status on every round
+var statusWatch bool
+
+// statusShort provides a brief description of the status command.
+var statusShort = "Display the status of the node"
+
+// statusLong provides a detailed description of the status command.
+var statusLong = lipgloss.JoinVertical(
+	lipgloss.Left,
+	style.Purple(style.BANNER),
+	"",
+	style.Bold(statusShort),
+	"",
+	style.BoldUnderline("Overview:"),
+	"Prints the status, metrics, accounts and participation keys of the node without opening the TUI.",
+	"Use --output json or --output yaml for machine-readable output.",
+	"Use --watch to stream one JSON object per round until interrupted.",
+	"",
+	style.Yellow.Render("This requires the daemon to be running."),
+)
+
+// statusCmd prints the same state that is rendered by the TUI as a table, JSON or YAML document.
+var statusCmd = cmdutils.WithOutputFlag(cmdutils.WithAlgodFlags(&cobra.Command{
+	Use:          "status",
+	Short:        statusShort,
+	Long:         statusLong,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if statusWatch && cmd.Flags().Changed("output") && statusOutput != cmdutils.JSONOutput {
+			return fmt.Errorf("--watch only supports the json output format")
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		state, err := cmdutils.GetStateModel(ctx, cmd, algodData)
+		if err != nil {
+			return err
+		}
+
+		if !statusWatch {
+			return printStatus(cmd, state.Snapshot())
+		}
+
+		// Stream a JSON object for every new round,
+		// the state is only read before the watcher starts and from the event snapshots afterward
+		enc := json.NewEncoder(cmd.OutOrStdout())
+		err = enc.Encode(state.Snapshot())
+		if err != nil {
+			return err
+		}
+
+		watcher := algod.NewWatcher(state, new(system.Clock))
+		events := watcher.Subscribe(ctx, 1)
+		go watcher.Run(ctx)
+		for event := range events {
+			if event.Type != algod.NewRoundEvent {
+				continue
+			}
+			err = enc.Encode(event.Snapshot)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+}, &algodData), &statusOutput)
+
+// printStatus writes the snapshot to the command output using the selected output format.
+func printStatus(cmd *cobra.Command, snapshot algod.Snapshot) error {
+	if statusOutput != cmdutils.TableOutput {
+		data, err := cmdutils.Marshal(statusOutput, snapshot)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(cmd.OutOrStdout(), string(data))
+		return err
+	}
+
+	var (
+		cellStyle      = lipgloss.NewStyle().PaddingRight(2)
+		optionRowStyle = cellStyle.Align(lipgloss.Right)
+		valueRowStyle  = cellStyle.Align(lipgloss.Left)
+	)
+
+	rows := [][]string{
+		{"Version:", snapshot.Status.Version},
+		{"Network:", snapshot.Status.Network},
+		{"State:", string(snapshot.Status.State)},
+		{"Latest Round:", strconv.FormatUint(snapshot.Status.LastRound, 10)},
+		{"Protocol:", snapshot.Status.LastProtocolVersion},
+		{"Needs Update:", strconv.FormatBool(snapshot.Status.NeedsUpdate)},
+		{"Round Time:", fmt.Sprintf("%.2fs", snapshot.Metrics.RoundTime)},
+		{"TPS:", fmt.Sprintf("%.2f", snapshot.Metrics.TPS)},
+		{"Peers:", fmt.Sprintf("%d WS | %d P2P", snapshot.Metrics.PeersWS, snapshot.Metrics.PeersP2P)},
+		{"Rx:", fmt.Sprintf("%d B/s WS | %d B/s P2P", snapshot.Metrics.RX, snapshot.Metrics.RXP2P)},
+		{"Tx:", fmt.Sprintf("%d B/s WS | %d B/s P2P", snapshot.Metrics.TX, snapshot.Metrics.TXP2P)},
+		{"Participation Keys:", strconv.Itoa(snapshot.ParticipationKeys)},
+	}
+	if snapshot.Status.UpgradeVoteRounds > 0 {
+		rows = append(rows,
+			[]string{"Upgrade Votes:", fmt.Sprintf("%d yes | %d no | %d required", snapshot.Status.UpgradeYesVotes, snapshot.Status.UpgradeNoVotes, snapshot.Status.UpgradeVotesRequired)},
+			[]string{"Upgrade Round:", strconv.Itoa(snapshot.Status.NextVersionRound)},
+		)
+	}
+
+	statusTable := table.New().
+		Border(lipgloss.HiddenBorder()).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			if col == 0 {
+				return optionRowStyle
+			}
+			return valueRowStyle
+		}).
+		Rows(rows...)
+
+	accountRows := make([][]string, 0, len(snapshot.Accounts))
+	for _, acct := range snapshot.Accounts {
+		expires := "N/A"
+		if acct.Expires != nil {
+			expires = acct.Expires.Format(time.RFC822)
+		}
+		accountRows = append(accountRows, []string{
+			acct.Address,
+			acct.Nickname,
+			acct.Status,
+			strconv.Itoa(acct.Balance),
+			strconv.FormatBool(acct.IncentiveEligible),
+			strconv.Itoa(acct.Keys),
+			expires,
+		})
+	}
+	accountsTable := table.New().
+		Border(lipgloss.HiddenBorder()).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			return valueRowStyle
+		}).
+		Headers("Address", "Nickname", "Status", "Balance", "Incentives", "Keys", "Expires").
+		Rows(accountRows...)
+
+	_, err := fmt.Fprintln(cmd.OutOrStdout(), lipgloss.JoinVertical(
+		lipgloss.Left,
+		style.BoldUnderline("Status:"),
+		statusTable.String(),
+		style.BoldUnderline("Accounts:"),
+		accountsTable.String(),
+	))
+	return err
+}
+
+// init initializes the flags for the status command.
+func init() {
+	statusCmd.Flags().BoolVarP(&statusWatch, "watch", "w", false, style.LightBlue("Stream one JSON object per round"))
+}
@@ -13,7 +13,7 @@ import (
 )
 
 // StopTimeout defines the duration to wait after attempting to stop the Algod process to ensure it has fully shut down.
-const StopTimeout = 5 * time.Second
+var StopTimeout = 5 * time.Second
 
 const StoppingAlgodMsg = "Stopping Algod 😢"
 
@@ -37,31 +37,32 @@ var stopLong = lipgloss.JoinVertical(
 	style.Yellow.Render("This requires the daemon to be installed on your system."),
 )
 
-var stopCmd = cmdutils.WithAlgodFlags(&cobra.Command{
-	Use:              "stop",
-	Short:            stopShort,
-	Long:             stopLong,
-	SilenceUsage:     true,
-	PersistentPreRun: NeedsToBeRunning,
-	Run: func(cmd *cobra.Command, args []string) {
-		log.Info(style.Green.Render(StoppingAlgodMsg))
-		// Warn user for prompt
-		log.Warn(style.Yellow.Render(explanations.SudoWarningMsg))
+// newStopCmd returns a Cobra command used to stop the Algod service on the system.
+func newStopCmd(service *cmdutils.Service) *cobra.Command {
+	cmd := cmdutils.WithAlgodFlags(&cobra.Command{
+		Use:              "stop",
+		Short:            stopShort,
+		Long:             stopLong,
+		SilenceUsage:     true,
+		PersistentPreRun: NeedsToBeRunning(service),
+		Run: func(cmd *cobra.Command, args []string) {
+			log.Info(style.Green.Render(StoppingAlgodMsg))
+			// Warn user for prompt
+			log.Warn(style.Yellow.Render(explanations.SudoWarningMsg))
 
-		err := algod.Stop()
-		if err != nil {
-			log.Fatal(StopFailureMsg)
-		}
-		time.Sleep(StopTimeout)
+			err := service.Stop()
+			if err != nil {
+				log.Fatal(StopFailureMsg)
+			}
+			time.Sleep(StopTimeout)
 
-		if algod.IsRunning(algodData) {
-			log.Fatal(StopFailureMsg)
-		}
+			if algod.IsRunning(service.Interface, algodData) {
+				log.Fatal(StopFailureMsg)
+			}
 
-		log.Info(style.Green.Render(StopSuccessMsg))
-	},
-}, &algodData)
-
-func init() {
-	stopCmd.Flags().BoolVarP(&force, "force", "f", false, style.Yellow.Render("forcefully stop the node"))
+			log.Info(style.Green.Render(StopSuccessMsg))
+		},
+	}, &algodData)
+	cmd.Flags().BoolVarP(&force, "force", "f", false, style.Yellow.Render("forcefully stop the node"))
+	return cmd
 }
@@ -49,9 +49,10 @@ Enabling telemetry will configure your node to send health metrics to Nodely
 `
 
 var nodelyCmd = cmdutils.WithAlgodFlags(&cobra.Command{
-	Use:   "nodely",
-	Short: nodelyShort,
-	Long:  nodelyLong,
+	Use:     "nodely",
+	PreRunE: cmdutils.NeedsLocalNode,
+	Short:   nodelyShort,
+	Long:    nodelyLong,
 	Run: func(cmd *cobra.Command, args []string) {
 
 		// Resolve Data Directory
@@ -28,9 +28,10 @@ var statusLong = lipgloss.JoinVertical(
 var dataDir = ""
 
 var statusCmd = cmdutils.WithAlgodFlags(&cobra.Command{
-	Use:   "status",
-	Short: statusShort,
-	Long:  statusLong,
+	Use:     "status",
+	PreRunE: cmdutils.NeedsLocalNode,
+	Short:   statusShort,
+	Long:    statusLong,
 	Run: func(cmd *cobra.Command, args []string) {
 		// Resolve Data Directory
 		dataDir, err := algod.GetDataDir(dataDir)
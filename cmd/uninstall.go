@@ -1,7 +1,7 @@
 package cmd
 
 import (
-	"github.com/algorandfoundation/nodekit/internal/algod"
+	cmdutils "github.com/algorandfoundation/nodekit/cmd/utils"
 	"github.com/algorandfoundation/nodekit/ui/style"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/log"
@@ -25,28 +25,27 @@ var uninstallLong = lipgloss.JoinVertical(
 	style.Yellow.Render("This requires the daemon to be installed on your system."),
 )
 
-// uninstallCmd defines a Cobra command used to uninstall the Algorand node (Algod) and related binaries from the system.
-var uninstallCmd = &cobra.Command{
-	Use:              "uninstall",
-	Short:            uninstallShort,
-	Long:             uninstallLong,
-	SilenceUsage:     true,
-	PersistentPreRun: NeedsToBeStopped,
-	Run: func(cmd *cobra.Command, args []string) {
-		if force {
-			log.Warn(style.Red.Render("Uninstalling Algorand (forcefully)"))
-		}
-		// Warn user for prompt
-		log.Warn(style.Yellow.Render(UninstallWarningMsg))
+// newUninstallCmd returns a Cobra command used to uninstall the Algorand node (Algod) and related binaries from the system.
+func newUninstallCmd(service *cmdutils.Service) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:              "uninstall",
+		Short:            uninstallShort,
+		Long:             uninstallLong,
+		SilenceUsage:     true,
+		PersistentPreRun: NeedsToBeStopped(service),
+		Run: func(cmd *cobra.Command, args []string) {
+			if force {
+				log.Warn(style.Red.Render("Uninstalling Algorand (forcefully)"))
+			}
+			// Warn user for prompt
+			log.Warn(style.Yellow.Render(UninstallWarningMsg))
 
-		err := algod.Uninstall(force)
-		if err != nil {
-			log.Fatal(err)
-		}
-	},
-}
-
-// init initializes the uninstall command's flags, including the "force" flag for forced uninstallation.
-func init() {
-	uninstallCmd.Flags().BoolVarP(&force, "force", "f", false, style.Yellow.Render("forcefully uninstall the node"))
+			err := service.Uninstall(force)
+			if err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.Flags().BoolVarP(&force, "force", "f", false, style.Yellow.Render("forcefully uninstall the node"))
+	return cmd
 }
@@ -1,10 +1,13 @@
 package cmd
 
 import (
+	"fmt"
 	"os"
+	"slices"
 	"time"
 
 	"github.com/algorandfoundation/nodekit/api"
+	cmdutils "github.com/algorandfoundation/nodekit/cmd/utils"
 	"github.com/algorandfoundation/nodekit/cmd/utils/explanations"
 	"github.com/algorandfoundation/nodekit/internal/algod"
 	"github.com/algorandfoundation/nodekit/internal/system"
@@ -17,6 +20,9 @@ import (
 // UpgradeMsg is a constant string used to indicate the start of the Algod upgrade process.
 const UpgradeMsg = "Upgrading Algod"
 
+// allowDowngrade lets upgrade install a version older than the installed one
+var allowDowngrade bool
+
 var upgradeShort = "Upgrade the node daemon"
 
 var upgradeLong = lipgloss.JoinVertical(
@@ -28,43 +34,86 @@ var upgradeLong = lipgloss.JoinVertical(
 	style.BoldUnderline("Overview:"),
 	"Upgrade Algorand packages if it was installed with package manager.",
 	"",
+	"Upgrades to the latest release of the channel by default, a pinned version is released again.",
+	"With --version the package is pinned to that version, older versions also need --downgrade.",
+	"The channel algod was installed from is kept, switching channels needs an uninstall and install.",
+	"Use it to match the version of other nodes exactly, e.g. before a consensus upgrade.",
+	"",
+	style.BoldUnderline("Examples:"),
+	"  nodekit upgrade",
+	"  nodekit upgrade --version 3.26.0",
+	"  nodekit upgrade --version 3.25.0 --downgrade",
+	"",
 	style.Yellow.Render("This requires the daemon to be installed on your system."),
 )
 
-// upgradeCmd is a Cobra command used to upgrade Algod, utilizing the OS-specific package manager if applicable.
-var upgradeCmd = &cobra.Command{
-	Use:          "upgrade",
-	Short:        upgradeShort,
-	Long:         upgradeLong,
-	SilenceUsage: true,
-	Run: func(cmd *cobra.Command, args []string) {
-		if NeedsUpgrade {
-			log.Info(style.Green.Render("Upgrading NodeKit"))
-			err := system.Upgrade(new(api.HttpPkg))
+// newUpgradeCmd returns a Cobra command used to upgrade Algod, utilizing the OS-specific package manager if applicable.
+func newUpgradeCmd(service *cmdutils.Service) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "upgrade",
+		Short:        upgradeShort,
+		Long:         upgradeLong,
+		SilenceUsage: true,
+		Run: func(cmd *cobra.Command, args []string) {
+			if NeedsUpgrade {
+				log.Info(style.Green.Render("Upgrading NodeKit"))
+				err := system.Upgrade(new(api.HttpPkg))
+				if err != nil {
+					log.Fatal(err)
+				}
+			}
+
+			// Each channel is its own package, the installed channel is followed unless one is given
+			installedChannel, err := algod.GetInstalledChannel()
+			if err != nil || !slices.Contains(algod.Channels, installedChannel) {
+				installedChannel = ""
+			}
+			if installedChannel != "" && !cmd.Flags().Changed("channel") {
+				releaseChannel = installedChannel
+			}
+			release, tag, err := getRelease(allowDowngrade)
 			if err != nil {
 				log.Fatal(err)
 			}
-		}
-
-		// TODO: get expected version and check if update is required
-		log.Info(style.Green.Render(UpgradeMsg))
-		// Warn user for prompt
-		log.Warn(style.Yellow.Render(explanations.SudoWarningMsg))
-		// TODO: Check Version from S3 against the local binary
-		err := algod.Update()
-		if err != nil {
-			log.Error(err)
-		}
-
-		time.Sleep(5 * time.Second)
+			if installedChannel != "" {
+				err = algod.CheckChannel(installedChannel, release)
+				if err != nil {
+					log.Fatal(err)
+				}
+			}
+			if installed, err := algod.GetInstalledVersion(); err == nil && tag != "" {
+				current, err := algod.CheckRelease(tag, installed, release)
+				if err != nil {
+					log.Fatal(err)
+				}
+				if current && release.Version == "" {
+					log.Info(style.Green.Render(fmt.Sprintf("algod %s is up to date", installed)))
+					return
+				}
+			}
 
-		// If it's not running, start the daemon (can happen)
-		if !algod.IsRunning(algodData) {
-			err = algod.Start()
+			log.Info(style.Green.Render(UpgradeMsg + " " + tag))
+			// Warn user for prompt
+			log.Warn(style.Yellow.Render(explanations.SudoWarningMsg))
+			err = service.Update(release)
 			if err != nil {
 				log.Error(err)
-				os.Exit(1)
 			}
-		}
-	},
+
+			time.Sleep(InstallTimeout)
+
+			// If it's not running, start the daemon (can happen)
+			if !algod.IsRunning(service.Interface, algodData) {
+				err = service.Start()
+				if err != nil {
+					log.Error(err)
+					os.Exit(1)
+				}
+			}
+		},
+	}
+	cmd.Flags().StringVar(&releaseChannel, "channel", "stable", style.LightBlue("Release channel, stable or beta, defaults to the installed channel"))
+	cmd.Flags().StringVar(&releaseVersion, "version", "", style.LightBlue("Upgrade or downgrade to a version and pin it, e.g. 3.26.0"))
+	cmd.Flags().BoolVar(&allowDowngrade, "downgrade", false, style.Yellow.Render("Allow a version older than the installed one"))
+	return cmd
 }
@@ -0,0 +1,30 @@
+package utils
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/algorandfoundation/nodekit/internal/algod/backup"
+	"github.com/algorandfoundation/nodekit/ui/style"
+)
+
+// PrintDiff writes the changes between two versions of a file, it returns false when they are equal.
+func PrintDiff(w io.Writer, name string, old []byte, new []byte) bool {
+	lines := backup.Diff(old, new)
+	if len(lines) == 0 {
+		return false
+	}
+	fmt.Fprintln(w, style.Bold("--- "+name))
+	fmt.Fprintln(w, style.Bold("+++ "+name))
+	for _, line := range lines {
+		switch line.Op {
+		case '-':
+			fmt.Fprintln(w, style.Red.Render(line.String()))
+		case '+':
+			fmt.Fprintln(w, style.Green.Render(line.String()))
+		default:
+			fmt.Fprintln(w, line.String())
+		}
+	}
+	return true
+}
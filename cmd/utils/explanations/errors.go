@@ -20,3 +20,6 @@ const NotRunningErrorMsg = "algod is not running"
 
 // NotSuperUserErrorMsg is the error message displayed when a non-superuser tries to execute a command requiring root privileges.
 const NotSuperUserErrorMsg = "you need to be root to run this command. Please run this command with sudo"
+
+// ProfileNotSupportedErrorMsg is the error message displayed when a command that manages the local node is run with a connection profile.
+const ProfileNotSupportedErrorMsg = "this command manages the node on this machine and does not support --profile"
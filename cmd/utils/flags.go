@@ -25,9 +25,18 @@ func WithInvalidResponsesExplanations(err error, response api.ResponseInterface,
 	}
 }
 
+// Profile is the connection profile selected with --profile, the data directory is used when it is empty.
+var Profile string
+
+// Instance is the local instance selected with --instance, see algod.SelectInstance.
+var Instance string
+
 // WithAlgodFlags enhances a cobra.Command with flags for Algod endpoint and token configuration.
 func WithAlgodFlags(cmd *cobra.Command, algodData *string) *cobra.Command {
 	cmd.Flags().StringVarP(algodData, "datadir", "d", "", style.LightBlue("Data directory for the node"))
+	cmd.Flags().StringVar(&Profile, "profile", "", style.LightBlue("Connection profile from the NodeKit settings, for nodes on other machines"))
+	cmd.Flags().StringVar(&Instance, "instance", "", style.LightBlue("Named instance from the NodeKit settings, for several nodes on this machine"))
+	cmd.MarkFlagsMutuallyExclusive("datadir", "profile", "instance")
 
 	_ = viper.BindPFlag("datadir", cmd.Flags().Lookup("datadir"))
 
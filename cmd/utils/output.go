@@ -0,0 +1,78 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// TableOutput renders human-readable tables.
+	TableOutput = "table"
+	// JSONOutput renders indented JSON documents.
+	JSONOutput = "json"
+	// YAMLOutput renders YAML documents.
+	YAMLOutput = "yaml"
+)
+
+// OutputFormats lists the supported values of the output flag.
+var OutputFormats = []string{TableOutput, JSONOutput, YAMLOutput}
+
+// WithOutputFlag enhances a cobra.Command with an output format flag and validates it before running.
+func WithOutputFlag(cmd *cobra.Command, output *string) *cobra.Command {
+	cmd.Flags().StringVarP(output, "output", "o", TableOutput, style.LightBlue("Output format (table, json, yaml)"))
+	preRunE := cmd.PreRunE
+	cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+		if !slices.Contains(OutputFormats, *output) {
+			return fmt.Errorf("invalid output format %q, expected one of: table, json, yaml", *output)
+		}
+		if preRunE != nil {
+			return preRunE(cmd, args)
+		}
+		return nil
+	}
+	return cmd
+}
+
+// Marshal encodes the value as JSON or YAML.
+// YAML documents keep the field names and ordering of the JSON tags.
+func Marshal(format string, v interface{}) ([]byte, error) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil || format == JSONOutput {
+		return data, err
+	}
+	if format != YAMLOutput {
+		return nil, fmt.Errorf("unsupported output format %q", format)
+	}
+
+	// JSON is valid YAML, decode it into a node tree to preserve the ordering
+	var node yaml.Node
+	err = yaml.Unmarshal(data, &node)
+	if err != nil {
+		return nil, err
+	}
+	resetYAMLStyle(&node)
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	err = enc.Encode(&node)
+	if err != nil {
+		return nil, err
+	}
+	err = enc.Close()
+	return buf.Bytes(), err
+}
+
+// resetYAMLStyle clears the flow and quoting styles inherited from the JSON source.
+func resetYAMLStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetYAMLStyle(child)
+	}
+}
@@ -0,0 +1,28 @@
+package utils
+
+import (
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/system"
+)
+
+// Service is the node service the commands manage.
+// It is selected once at startup and passed to the commands when they are created,
+// the commands read the backend when they run since --instance is only known after the flags are parsed.
+type Service struct {
+	system.Interface
+}
+
+// NewService returns the service of the platform, see algod.NewService.
+func NewService() *Service {
+	return &Service{Interface: algod.NewService()}
+}
+
+// Select points the service to the named instance, see algod.SelectInstance.
+func (s *Service) Select(name string) error {
+	service, err := algod.SelectInstance(name)
+	if err != nil {
+		return err
+	}
+	s.Interface = service
+	return nil
+}
@@ -0,0 +1,42 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/system"
+	"github.com/spf13/cobra"
+)
+
+// GetStateModel creates a StateModel for the node with accounts and metrics populated.
+func GetStateModel(ctx context.Context, cmd *cobra.Command, algodData string) (*algod.StateModel, error) {
+	httpPkg := new(api.HttpPkg)
+	client, dataDir, err := GetClient(algodData)
+	if err != nil {
+		return nil, err
+	}
+
+	state, response, err := algod.NewStateModel(ctx, client, httpPkg, false, cmd.Root().Version, dataDir)
+	WithInvalidResponsesExplanations(err, response, cmd.UsageString())
+	if err != nil {
+		return nil, err
+	}
+	state.UpdateKeys(ctx, new(system.Clock))
+	return state, nil
+}
+
+// GetClient returns the API client for the selected --profile, or for the data directory when no profile is set.
+// The resolved data directory is returned as well, it is empty for a profile as the node is on another machine.
+func GetClient(algodData string) (*api.ClientWithResponses, string, error) {
+	if Profile != "" {
+		client, err := algod.GetProfileClient(Profile)
+		return client, "", err
+	}
+	dataDir, err := algod.GetDataDir(algodData)
+	if err != nil {
+		return nil, "", err
+	}
+	client, err := algod.GetClient(dataDir)
+	return client, dataDir, err
+}
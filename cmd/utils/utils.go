@@ -13,3 +13,11 @@ func IsSudoCmd(cmd *cobra.Command, args []string) error {
 	}
 	return nil
 }
+
+// NeedsLocalNode rejects a --profile for commands that work on the files or service of the local node.
+func NeedsLocalNode(cmd *cobra.Command, args []string) error {
+	if Profile != "" {
+		return errors.New(explanations.ProfileNotSupportedErrorMsg)
+	}
+	return nil
+}
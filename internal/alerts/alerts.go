@@ -0,0 +1,278 @@
+package alerts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/algod/samples"
+	"github.com/algorandfoundation/nodekit/internal/algod/utils"
+	"github.com/algorandfoundation/nodekit/internal/system"
+)
+
+// Level is the severity of an Alert.
+type Level string
+
+const (
+	// WarningLevel is used for keys that expire soon.
+	WarningLevel Level = "warning"
+
+	// CriticalLevel is used for keys that are about to expire or have expired.
+	CriticalLevel Level = "critical"
+)
+
+// Day is the duration of a day, used by the threshold settings.
+const Day = 24 * time.Hour
+
+// Threshold raises an Alert when a participation key expires within Before.
+// A Threshold with a zero Before only matches keys that have already expired.
+type Threshold struct {
+	Name   string
+	Before time.Duration
+	Level  Level
+}
+
+// DefaultThresholds warn two weeks before expiry and escalate at three days and once expired.
+var DefaultThresholds = []Threshold{
+	{Name: "14 days", Before: 14 * Day, Level: WarningLevel},
+	{Name: "3 days", Before: 3 * Day, Level: CriticalLevel},
+	{Name: "expired", Before: 0, Level: CriticalLevel},
+}
+
+// Alert describes an account whose participation key crossed a Threshold.
+type Alert struct {
+	Address         string     `json:"address"`
+	Nickname        string     `json:"nickname,omitempty"`
+	Network         string     `json:"network"`
+	Threshold       string     `json:"threshold"`
+	Level           Level      `json:"level"`
+	Expired         bool       `json:"expired"`
+	LastRound       uint64     `json:"lastRound"`
+	VoteLastValid   int        `json:"voteLastValid"`
+	RoundsRemaining int        `json:"roundsRemaining"`
+	Expires         *time.Time `json:"expires,omitempty"`
+	Message         string     `json:"message"`
+}
+
+// Sink delivers alerts to an external destination.
+type Sink interface {
+	// Name identifies the sink in logs and errors.
+	Name() string
+	// Send delivers a single alert.
+	Send(ctx context.Context, alert Alert) error
+}
+
+// ThresholdsFromSettings converts the settings into thresholds sorted from the furthest to the closest to expiry.
+// The DefaultThresholds are returned when no thresholds are configured.
+func ThresholdsFromSettings(settings []utils.AlertThresholdSettings) ([]Threshold, error) {
+	if len(settings) == 0 {
+		return DefaultThresholds, nil
+	}
+	thresholds := make([]Threshold, 0, len(settings))
+	for _, s := range settings {
+		if s.Days < 0 {
+			return nil, fmt.Errorf("invalid alert threshold %d days", s.Days)
+		}
+		level := Level(strings.ToLower(s.Level))
+		switch level {
+		case "":
+			level = WarningLevel
+			if s.Days <= 3 {
+				level = CriticalLevel
+			}
+		case WarningLevel, CriticalLevel:
+		default:
+			return nil, fmt.Errorf("invalid alert level %q", s.Level)
+		}
+		name := s.Name
+		if name == "" {
+			name = fmt.Sprintf("%d days", s.Days)
+			if s.Days == 0 {
+				name = "expired"
+			}
+		}
+		thresholds = append(thresholds, Threshold{Name: name, Before: time.Duration(s.Days) * Day, Level: level})
+	}
+	sort.SliceStable(thresholds, func(i, j int) bool {
+		return thresholds[i].Before > thresholds[j].Before
+	})
+	return thresholds, nil
+}
+
+// Evaluate returns an Alert for every account whose registered key crossed a threshold.
+// The closest threshold to expiry that was crossed is reported for each account.
+func Evaluate(snapshot algod.Snapshot, thresholds []Threshold, t system.Time) []Alert {
+	var alerts []Alert
+	now := t.Now()
+	for _, acct := range snapshot.Accounts {
+		if acct.VoteLastValid == 0 {
+			continue
+		}
+		rounds := acct.VoteLastValid - int(snapshot.Status.LastRound)
+		expired := rounds <= 0
+
+		var remaining time.Duration
+		var known bool
+		if acct.Expires != nil {
+			remaining, known = acct.Expires.Sub(now), true
+		} else if snapshot.Metrics.RoundTime > 0 {
+			remaining, known = time.Duration(float64(rounds)*snapshot.Metrics.RoundTime*float64(time.Second)), true
+		}
+
+		var crossed *Threshold
+		for i, threshold := range thresholds {
+			if expired || (threshold.Before > 0 && known && remaining <= threshold.Before) {
+				crossed = &thresholds[i]
+			}
+		}
+		if crossed == nil {
+			continue
+		}
+
+		name := acct.Address
+		if acct.Nickname != "" {
+			name = fmt.Sprintf("%s (%s)", acct.Nickname, acct.Address)
+		}
+		message := fmt.Sprintf("Participation key for %s expires in %d rounds", name, rounds)
+		if acct.Expires != nil {
+			message += fmt.Sprintf(" (%s)", acct.Expires.Format(time.RFC822))
+		}
+		if expired {
+			message = fmt.Sprintf("Participation key for %s expired at round %d", name, acct.VoteLastValid)
+		}
+
+		alerts = append(alerts, Alert{
+			Address:         acct.Address,
+			Nickname:        acct.Nickname,
+			Network:         snapshot.Status.Network,
+			Threshold:       crossed.Name,
+			Level:           crossed.Level,
+			Expired:         expired,
+			LastRound:       snapshot.Status.LastRound,
+			VoteLastValid:   acct.VoteLastValid,
+			RoundsRemaining: max(0, rounds),
+			Expires:         acct.Expires,
+			Message:         message,
+		})
+	}
+	return alerts
+}
+
+// DirName is the directory in the home directory holding the sent alerts of each node.
+const DirName = ".nodekit-alerts"
+
+// Path returns the sent alerts file of a node, identified by its data directory or connection profile.
+func Path(dataDir string, profile string) (string, error) {
+	return samples.Path(DirName, dataDir, profile)
+}
+
+// Sent is the last threshold notified for each account and key.
+// It is stored per node so a notification is not sent again by the next run of a scheduled check.
+type Sent struct {
+	Thresholds map[string]string `json:"thresholds"`
+
+	samples.File
+}
+
+// LoadSent reads the sent alerts stored at path, a missing file returns no sent alerts.
+func LoadSent(path string) (*Sent, error) {
+	s := &Sent{File: samples.NewFile(path)}
+	err := s.Read(s)
+	if s.Thresholds == nil {
+		s.Thresholds = make(map[string]string)
+	}
+	return s, err
+}
+
+// Save writes the sent alerts to the path they were loaded from, when they changed.
+func (s *Sent) Save() error {
+	return s.Write(s)
+}
+
+// Notifier evaluates snapshots and sends each new Alert to every Sink.
+// An account is only notified once per threshold for the same key.
+type Notifier struct {
+	Thresholds []Threshold
+	Sinks      []Sink
+	Time       system.Time
+
+	// Sent are the alerts already notified, kept in memory unless loaded with LoadSent
+	Sent *Sent
+}
+
+// NewNotifier creates a Notifier from the NodeKit alert settings.
+func NewNotifier(settings *utils.AlertSettings, t system.Time) (*Notifier, error) {
+	if settings == nil {
+		settings = &utils.AlertSettings{}
+	}
+	thresholds, err := ThresholdsFromSettings(settings.Thresholds)
+	if err != nil {
+		return nil, err
+	}
+	return &Notifier{
+		Thresholds: thresholds,
+		Sinks:      SinksFromSettings(settings),
+		Time:       t,
+		Sent:       &Sent{Thresholds: make(map[string]string)},
+	}, nil
+}
+
+// Evaluate returns the alerts of the snapshot for the thresholds of the Notifier.
+func (n *Notifier) Evaluate(snapshot algod.Snapshot) []Alert {
+	return Evaluate(snapshot, n.Thresholds, n.Time)
+}
+
+// Check evaluates the snapshot and sends the alerts that have not been sent yet.
+// It returns the alerts that were sent and any errors from the sinks.
+func (n *Notifier) Check(ctx context.Context, snapshot algod.Snapshot) ([]Alert, error) {
+	return n.Notify(ctx, n.Evaluate(snapshot))
+}
+
+// Notify sends the raised alerts that have not been sent yet, the keys that no longer raise an alert are forgotten.
+// It returns the alerts that were sent and any errors from the sinks.
+func (n *Notifier) Notify(ctx context.Context, raised []Alert) ([]Alert, error) {
+	if n.Sent == nil {
+		n.Sent = &Sent{Thresholds: make(map[string]string)}
+	}
+	keys := make(map[string]bool, len(raised))
+	var sent []Alert
+	var errs []error
+	for _, alert := range raised {
+		key := fmt.Sprintf("%s:%d", alert.Address, alert.VoteLastValid)
+		keys[key] = true
+		if n.Sent.Thresholds[key] == alert.Threshold {
+			continue
+		}
+		err := Send(ctx, n.Sinks, alert)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		n.Sent.Thresholds[key] = alert.Threshold
+		n.Sent.Changed()
+		sent = append(sent, alert)
+	}
+	for key := range n.Sent.Thresholds {
+		if !keys[key] {
+			delete(n.Sent.Thresholds, key)
+			n.Sent.Changed()
+		}
+	}
+	return sent, errors.Join(errs...)
+}
+
+// Send delivers the alert to every sink and joins the errors.
+func Send(ctx context.Context, sinks []Sink, alert Alert) error {
+	var errs []error
+	for _, sink := range sinks {
+		err := sink.Send(ctx, alert)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", sink.Name(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
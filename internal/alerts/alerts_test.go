@@ -0,0 +1,285 @@
+package alerts
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/smtp"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/algod/utils"
+	"github.com/algorandfoundation/nodekit/internal/test/mock"
+)
+
+// recordSink records every alert it receives
+type recordSink struct {
+	alerts []Alert
+	err    error
+}
+
+func (r *recordSink) Name() string { return "record" }
+
+func (r *recordSink) Send(ctx context.Context, alert Alert) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.alerts = append(r.alerts, alert)
+	return nil
+}
+
+func expiresIn(d time.Duration) *time.Time {
+	t := time.Time{}.Add(d)
+	return &t
+}
+
+func getSnapshot() algod.Snapshot {
+	return algod.Snapshot{
+		Status: algod.Status{LastRound: 1000, Network: "testnet-v1.0"},
+		Accounts: []algod.AccountSnapshot{
+			{Address: "SAFE", VoteLastValid: 1_000_000, Expires: expiresIn(30 * Day)},
+			{Address: "SOON", Nickname: "relay", VoteLastValid: 200_000, Expires: expiresIn(10 * Day)},
+			{Address: "URGENT", VoteLastValid: 50_000, Expires: expiresIn(2 * Day)},
+			{Address: "EXPIRED", VoteLastValid: 900, Expires: expiresIn(0)},
+			{Address: "OFFLINE"},
+		},
+	}
+}
+
+func Test_Evaluate(t *testing.T) {
+	alerts := Evaluate(getSnapshot(), DefaultThresholds, new(mock.Clock))
+	expected := map[string]string{
+		"SOON":    "14 days",
+		"URGENT":  "3 days",
+		"EXPIRED": "expired",
+	}
+	if len(alerts) != len(expected) {
+		t.Fatalf("expected %d alerts, got %d", len(expected), len(alerts))
+	}
+	for _, alert := range alerts {
+		if expected[alert.Address] != alert.Threshold {
+			t.Errorf("expected %s to cross %q, got %q", alert.Address, expected[alert.Address], alert.Threshold)
+		}
+		switch alert.Address {
+		case "SOON":
+			if alert.Level != WarningLevel || !strings.Contains(alert.Message, "relay (SOON)") {
+				t.Errorf("unexpected alert %+v", alert)
+			}
+		case "EXPIRED":
+			if !alert.Expired || alert.RoundsRemaining != 0 || alert.Level != CriticalLevel {
+				t.Errorf("unexpected alert %+v", alert)
+			}
+		}
+	}
+}
+
+func Test_EvaluateWithoutExpiresTime(t *testing.T) {
+	snapshot := algod.Snapshot{
+		Status:  algod.Status{LastRound: 1000},
+		Metrics: algod.MetricsSnapshot{RoundTime: 3},
+		Accounts: []algod.AccountSnapshot{
+			// 10000 rounds at 3 seconds is less than a day
+			{Address: "SOON", VoteLastValid: 11_000},
+		},
+	}
+	alerts := Evaluate(snapshot, DefaultThresholds, new(mock.Clock))
+	if len(alerts) != 1 || alerts[0].Threshold != "3 days" {
+		t.Fatalf("expected a 3 days alert, got %+v", alerts)
+	}
+
+	snapshot.Metrics.RoundTime = 0
+	if len(Evaluate(snapshot, DefaultThresholds, new(mock.Clock))) != 0 {
+		t.Error("expected no alerts without a round time")
+	}
+}
+
+func Test_ThresholdsFromSettings(t *testing.T) {
+	thresholds, err := ThresholdsFromSettings(nil)
+	if err != nil || len(thresholds) != len(DefaultThresholds) {
+		t.Fatal("expected the default thresholds")
+	}
+
+	thresholds, err = ThresholdsFromSettings([]utils.AlertThresholdSettings{
+		{Days: 0},
+		{Days: 30},
+		{Days: 7, Name: "week", Level: "Critical"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if thresholds[0].Name != "30 days" || thresholds[0].Level != WarningLevel {
+		t.Errorf("unexpected first threshold %+v", thresholds[0])
+	}
+	if thresholds[1].Name != "week" || thresholds[1].Level != CriticalLevel {
+		t.Errorf("unexpected second threshold %+v", thresholds[1])
+	}
+	if thresholds[2].Name != "expired" || thresholds[2].Before != 0 {
+		t.Errorf("unexpected last threshold %+v", thresholds[2])
+	}
+
+	_, err = ThresholdsFromSettings([]utils.AlertThresholdSettings{{Days: -1}})
+	if err == nil {
+		t.Error("expected an error for negative days")
+	}
+	_, err = ThresholdsFromSettings([]utils.AlertThresholdSettings{{Days: 1, Level: "panic"}})
+	if err == nil {
+		t.Error("expected an error for an invalid level")
+	}
+}
+
+func Test_NotifierCheck(t *testing.T) {
+	sink := new(recordSink)
+	n, err := NewNotifier(nil, new(mock.Clock))
+	if err != nil {
+		t.Fatal(err)
+	}
+	n.Sinks = []Sink{sink}
+
+	sent, err := n.Check(context.Background(), getSnapshot())
+	if err != nil || len(sent) != 3 || len(sink.alerts) != 3 {
+		t.Fatalf("expected 3 alerts to be sent, got %d: %v", len(sent), err)
+	}
+
+	// The same thresholds are not sent twice
+	sent, _ = n.Check(context.Background(), getSnapshot())
+	if len(sent) != 0 {
+		t.Errorf("expected no new alerts, got %d", len(sent))
+	}
+
+	// Escalating to the next threshold sends again
+	snapshot := getSnapshot()
+	snapshot.Accounts[1].Expires = expiresIn(Day)
+	sent, _ = n.Check(context.Background(), snapshot)
+	if len(sent) != 1 || sent[0].Threshold != "3 days" {
+		t.Errorf("expected an escalation, got %+v", sent)
+	}
+
+	// Failed alerts are retried
+	sink.err = errors.New("unreachable")
+	snapshot.Accounts[0].Expires = expiresIn(Day)
+	_, err = n.Check(context.Background(), snapshot)
+	if err == nil || !strings.Contains(err.Error(), "record: unreachable") {
+		t.Errorf("expected the sink error, got %v", err)
+	}
+	sink.err = nil
+	sent, _ = n.Check(context.Background(), snapshot)
+	if len(sent) != 1 || sent[0].Address != "SAFE" {
+		t.Errorf("expected the failed alert to be retried, got %+v", sent)
+	}
+}
+
+func Test_SentPersisted(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "node.json")
+	sink := new(recordSink)
+	check := func() []Alert {
+		n, err := NewNotifier(nil, new(mock.Clock))
+		if err != nil {
+			t.Fatal(err)
+		}
+		n.Sinks = []Sink{sink}
+		n.Sent, err = LoadSent(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sent, err := n.Check(context.Background(), getSnapshot())
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = n.Sent.Save()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return sent
+	}
+
+	if sent := check(); len(sent) != 3 {
+		t.Fatalf("expected 3 alerts to be sent, got %d", len(sent))
+	}
+	// A scheduled check runs in a new process
+	if sent := check(); len(sent) != 0 {
+		t.Errorf("expected the alerts not to be sent again, got %d", len(sent))
+	}
+
+	// Keys that no longer raise an alert are forgotten
+	n, _ := NewNotifier(nil, new(mock.Clock))
+	n.Sent, _ = LoadSent(path)
+	_, _ = n.Check(context.Background(), algod.Snapshot{})
+	if len(n.Sent.Thresholds) != 0 {
+		t.Errorf("expected the sent alerts to be forgotten, got %v", n.Sent.Thresholds)
+	}
+}
+
+func Test_WebhookSink(t *testing.T) {
+	var received Alert
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&received)
+	}))
+	defer server.Close()
+
+	sink := &WebhookSink{URL: server.URL + "/hook/token", Headers: map[string]string{"Authorization": "Bearer secret"}}
+	if strings.Contains(sink.Name(), "token") {
+		t.Error("the webhook name should not include the path")
+	}
+	err := sink.Send(context.Background(), Alert{Address: "ABC", Level: CriticalLevel})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if received.Address != "ABC" || received.Level != CriticalLevel {
+		t.Errorf("unexpected alert received %+v", received)
+	}
+
+	sink.Headers = nil
+	err = sink.Send(context.Background(), Alert{Address: "ABC"})
+	if err == nil {
+		t.Error("expected an error for an unauthorized response")
+	}
+}
+
+func Test_SMTPSink(t *testing.T) {
+	var addr string
+	var msg string
+	sink := &SMTPSink{
+		SMTPSettings: utils.SMTPSettings{Host: "mail.example.com", From: "nodekit@example.com", To: []string{"ops@example.com"}},
+		sendMail: func(a string, auth smtp.Auth, from string, to []string, m []byte) error {
+			addr = a
+			msg = string(m)
+			return nil
+		},
+	}
+	err := sink.Send(context.Background(), Alert{Address: "ABC", Level: WarningLevel, Threshold: "14 days", Message: "expires soon"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if addr != "mail.example.com:587" {
+		t.Errorf("unexpected address %s", addr)
+	}
+	if !strings.Contains(msg, "Subject: [NodeKit] WARNING: participation key 14 days") || !strings.Contains(msg, "expires soon") {
+		t.Errorf("unexpected message %s", msg)
+	}
+
+	sink.To = nil
+	if sink.Send(context.Background(), Alert{}) == nil {
+		t.Error("expected an error without recipients")
+	}
+}
+
+func Test_CommandSink(t *testing.T) {
+	sink := &CommandSink{Command: []string{"sh", "-c", `test "$NODEKIT_ALERT_ADDRESS" = ABC && grep -q '"level":"critical"'`}}
+	err := sink.Send(context.Background(), Alert{Address: "ABC", Level: CriticalLevel})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = sink.Send(context.Background(), Alert{Address: "DEF", Level: CriticalLevel})
+	if err == nil {
+		t.Error("expected an error when the command fails")
+	}
+}
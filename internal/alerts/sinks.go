@@ -0,0 +1,173 @@
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/smtp"
+	"net/url"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/algorandfoundation/nodekit/internal/algod/utils"
+)
+
+// SinksFromSettings creates a Sink for every destination configured in the settings.
+func SinksFromSettings(settings *utils.AlertSettings) []Sink {
+	var sinks []Sink
+	for _, webhook := range settings.Webhooks {
+		sinks = append(sinks, &WebhookSink{URL: webhook.URL, Headers: webhook.Headers})
+	}
+	if settings.SMTP != nil {
+		sinks = append(sinks, &SMTPSink{SMTPSettings: *settings.SMTP})
+	}
+	for _, command := range settings.Commands {
+		sinks = append(sinks, &CommandSink{Command: command.Command})
+	}
+	return sinks
+}
+
+// WebhookSink posts each alert as JSON to a generic HTTP endpoint.
+type WebhookSink struct {
+	URL     string
+	Headers map[string]string
+
+	// Client is used to send the request, http.DefaultClient is used when nil.
+	Client *http.Client
+}
+
+// Name identifies the webhook by its host.
+// The path is omitted as it often contains a secret token.
+func (w *WebhookSink) Name() string {
+	u, err := url.Parse(w.URL)
+	if err != nil {
+		return "webhook"
+	}
+	return "webhook " + u.Host
+}
+
+// Send posts the alert and fails on any non 2xx response.
+func (w *WebhookSink) Send(ctx context.Context, alert Alert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range w.Headers {
+		req.Header.Set(key, value)
+	}
+
+	client := w.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code %d", res.StatusCode)
+	}
+	return nil
+}
+
+// SMTPSink emails each alert to the configured recipients.
+type SMTPSink struct {
+	utils.SMTPSettings
+
+	// sendMail is replaced in tests, smtp.SendMail is used when nil.
+	sendMail func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+// Name identifies the mail server.
+func (s *SMTPSink) Name() string {
+	return "smtp " + s.Host
+}
+
+// Send emails the alert message.
+func (s *SMTPSink) Send(ctx context.Context, alert Alert) error {
+	if len(s.To) == 0 {
+		return fmt.Errorf("no recipients configured")
+	}
+	port := s.Port
+	if port == 0 {
+		port = 587
+	}
+	addr := net.JoinHostPort(s.Host, strconv.Itoa(port))
+
+	var auth smtp.Auth
+	if s.Username != "" {
+		auth = smtp.PlainAuth("", s.Username, s.Password, s.Host)
+	}
+
+	subject := fmt.Sprintf("[NodeKit] %s: participation key %s", strings.ToUpper(string(alert.Level)), alert.Threshold)
+	msg := strings.Join([]string{
+		"From: " + s.From,
+		"To: " + strings.Join(s.To, ", "),
+		"Subject: " + subject,
+		"Content-Type: text/plain; charset=utf-8",
+		"",
+		alert.Message,
+		"",
+		"Network: " + alert.Network,
+		"Address: " + alert.Address,
+		fmt.Sprintf("Last Valid Round: %d", alert.VoteLastValid),
+		fmt.Sprintf("Current Round: %d", alert.LastRound),
+		"",
+	}, "\r\n")
+
+	sendMail := s.sendMail
+	if sendMail == nil {
+		sendMail = smtp.SendMail
+	}
+	return sendMail(addr, auth, s.From, s.To, []byte(msg))
+}
+
+// CommandSink runs a local command for each alert.
+// The alert is written as JSON to stdin and exposed as NODEKIT_ALERT_* environment variables.
+type CommandSink struct {
+	Command []string
+}
+
+// Name identifies the command.
+func (c *CommandSink) Name() string {
+	if len(c.Command) == 0 {
+		return "command"
+	}
+	return "command " + c.Command[0]
+}
+
+// Send runs the command and fails when it exits with a non-zero status.
+func (c *CommandSink) Send(ctx context.Context, alert Alert) error {
+	if len(c.Command) == 0 {
+		return fmt.Errorf("no command configured")
+	}
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+	cmd := exec.CommandContext(ctx, c.Command[0], c.Command[1:]...)
+	cmd.Stdin = bytes.NewReader(body)
+	cmd.Env = append(os.Environ(),
+		"NODEKIT_ALERT_ADDRESS="+alert.Address,
+		"NODEKIT_ALERT_LEVEL="+string(alert.Level),
+		"NODEKIT_ALERT_THRESHOLD="+alert.Threshold,
+		"NODEKIT_ALERT_MESSAGE="+alert.Message,
+		"NODEKIT_ALERT_ROUNDS_REMAINING="+strconv.Itoa(alert.RoundsRemaining),
+	)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
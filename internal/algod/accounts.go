@@ -23,6 +23,10 @@ type Account struct {
 	NonResidentKey bool
 	// Account Address is the algorand encoded address
 	Address string
+	// AuthAddr is the address that authorizes transactions when the account is rekeyed
+	AuthAddr string
+	// SigType is the signature type used by the account, "msig" for a multisig account
+	SigType string
 	// Status is the Online/Offline/"NotParticipating" status of the account
 	Status string
 	// Balance is the current holdings in ALGO for the address.
@@ -107,6 +111,15 @@ func (a Account) Merge(rpcAccount api.Account) Account {
 
 	a.IncentiveEligible = incentiveEligible
 
+	a.AuthAddr = ""
+	if rpcAccount.AuthAddr != nil {
+		a.AuthAddr = *rpcAccount.AuthAddr
+	}
+	a.SigType = ""
+	if rpcAccount.SigType != nil {
+		a.SigType = string(*rpcAccount.SigType)
+	}
+
 	if rpcAccount.Participation != nil {
 		a.Participation = rpcAccount.Participation
 	}
@@ -114,6 +127,24 @@ func (a Account) Merge(rpcAccount api.Account) Account {
 	return a
 }
 
+// Signer returns the address that authorizes transactions for the account.
+func (a Account) Signer() string {
+	if a.IsRekeyed() {
+		return a.AuthAddr
+	}
+	return a.Address
+}
+
+// IsRekeyed reports whether transactions of the account are authorized by another address.
+func (a Account) IsRekeyed() bool {
+	return a.AuthAddr != "" && a.AuthAddr != a.Address
+}
+
+// IsMultisig reports whether the account signs with a multisig.
+func (a Account) IsMultisig() bool {
+	return a.SigType == string(api.Msig)
+}
+
 // GetExpiresTime calculates the expiration time of the account's participation key based on round differences and duration.
 // Returns nil if the account has no participation or if the expiration time cannot be determined.
 func (a Account) GetExpiresTime(t system.Time, lastRound int, roundTime time.Duration) *time.Time {
@@ -99,3 +99,17 @@ func Test_AccountsFromState(t *testing.T) {
 	state.UpdateKeys(context.Background(), clock)
 
 }
+
+func Test_AccountMergeAuthAddr(t *testing.T) {
+	authAddr := "AUTH"
+	sigType := api.Msig
+	acct := Account{Address: "ABC"}.Merge(api.Account{Address: "ABC", Status: "Online", AuthAddr: &authAddr, SigType: &sigType})
+	if !acct.IsRekeyed() || acct.Signer() != "AUTH" || !acct.IsMultisig() {
+		t.Errorf("expected a rekeyed multisig account, got %+v", acct)
+	}
+
+	acct = acct.Merge(api.Account{Address: "ABC", Status: "Online"})
+	if acct.IsRekeyed() || acct.Signer() != "ABC" || acct.IsMultisig() {
+		t.Errorf("expected the rekey to be cleared, got %+v", acct)
+	}
+}
@@ -0,0 +1,52 @@
+package algod
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/algorandfoundation/nodekit/internal/system"
+	"github.com/algorandfoundation/nodekit/internal/test/mock"
+)
+
+func Test_Service(t *testing.T) {
+	if NewService() == nil {
+		t.Fatal("expected a service backend for every operating system")
+	}
+
+	service := &mock.Service{}
+	dataDir := t.TempDir()
+	if IsInitialized(service, dataDir) {
+		t.Error("expected an empty system")
+	}
+	err := service.Install(system.Release{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = service.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsInitialized(service, dataDir) {
+		t.Errorf("expected a running service, got %+v", service)
+	}
+	err = service.Stop()
+	if err != nil || IsRunning(service, dataDir) {
+		t.Errorf("expected a stopped service: %v", err)
+	}
+
+	// The service settings and the data directory of the service need systemd
+	_, err = GetServiceDataDir(service)
+	if !errors.Is(err, system.ErrUnsupported) {
+		t.Errorf("expected an unsupported data directory, got %v", err)
+	}
+	_, _, err = PreviewServiceSettings(service, map[string]string{"LimitNOFILE": "65536"})
+	if !errors.Is(err, system.ErrUnsupported) {
+		t.Errorf("expected unsupported service settings, got %v", err)
+	}
+
+	expected := []string{"Install", "Start", "Stop"}
+	if strings.Join(service.Calls, ",") != strings.Join(expected, ",") {
+		t.Errorf("unexpected calls %v", service.Calls)
+	}
+}
@@ -0,0 +1,242 @@
+package backup
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/internal/system"
+)
+
+// DirName is the directory in the algod data directory holding the backups.
+const DirName = "nodekit-backups"
+
+// MetaFile describes a backup inside its directory.
+const MetaFile = "meta.json"
+
+// MaxBackups is the number of backups kept, older backups are removed when a new one is created.
+const MaxBackups = 20
+
+// Files are the configuration files of the data directory included in every backup.
+var Files = []string{"config.json", "logging.config"}
+
+// ErrNotFound is returned for a backup id that does not exist.
+var ErrNotFound = errors.New("backup not found")
+
+// Backup is a copy of the configuration files taken before they changed.
+type Backup struct {
+	// ID increases with every backup of the data directory.
+	ID int `json:"id"`
+
+	// Time is when the backup was taken.
+	Time time.Time `json:"time"`
+
+	// Reason describes the change that caused the backup.
+	Reason string `json:"reason"`
+
+	// Files lists which of the configuration Files existed, a missing file is removed on restore.
+	Files map[string]bool `json:"files"`
+
+	// Running is true when algod was running with these files, which makes it a known good configuration.
+	Running bool `json:"running"`
+}
+
+// Path returns the backup directory of a data directory.
+func Path(dataDir string) string {
+	return filepath.Join(dataDir, DirName)
+}
+
+// Create copies the current configuration files of the data directory into a new backup.
+func Create(dataDir string, reason string, running bool) (Backup, error) {
+	backups, err := List(dataDir)
+	if err != nil {
+		return Backup{}, err
+	}
+	b := Backup{ID: 1, Time: time.Now(), Reason: reason, Files: make(map[string]bool), Running: running}
+	if len(backups) > 0 {
+		b.ID = backups[0].ID + 1
+	}
+
+	dir := filepath.Join(Path(dataDir), strconv.Itoa(b.ID))
+	err = os.MkdirAll(dir, 0o775)
+	if err != nil {
+		return b, err
+	}
+	for _, name := range Files {
+		content, err := os.ReadFile(filepath.Join(dataDir, name))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return b, err
+		}
+		err = os.WriteFile(filepath.Join(dir, name), content, 0o664)
+		if err != nil {
+			return b, err
+		}
+		b.Files[name] = true
+	}
+	meta, err := json.MarshalIndent(b, "", "\t")
+	if err != nil {
+		return b, err
+	}
+	err = os.WriteFile(filepath.Join(dir, MetaFile), meta, 0o664)
+	if err != nil {
+		return b, err
+	}
+
+	// Keep the backups owned by the data directory owner when we're sudo'ed
+	if system.IsSudo() {
+		err = matchOwner(dataDir, Path(dataDir))
+		if err != nil {
+			return b, err
+		}
+	}
+
+	return b, prune(dataDir, append([]Backup{b}, backups...))
+}
+
+// List returns the backups of the data directory, the newest first.
+// A data directory without backups returns an empty list.
+func List(dataDir string) ([]Backup, error) {
+	entries, err := os.ReadDir(Path(dataDir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []Backup{}, nil
+		}
+		return nil, err
+	}
+	backups := make([]Backup, 0, len(entries))
+	for _, entry := range entries {
+		id, err := strconv.Atoi(entry.Name())
+		if err != nil || !entry.IsDir() {
+			continue
+		}
+		b, err := Get(dataDir, id)
+		if err != nil {
+			return nil, err
+		}
+		backups = append(backups, b)
+	}
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].ID > backups[j].ID
+	})
+	return backups, nil
+}
+
+// Get reads the description of a single backup.
+func Get(dataDir string, id int) (Backup, error) {
+	var b Backup
+	meta, err := os.ReadFile(filepath.Join(Path(dataDir), strconv.Itoa(id), MetaFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return b, fmt.Errorf("%w: %d", ErrNotFound, id)
+		}
+		return b, err
+	}
+	err = json.Unmarshal(meta, &b)
+	return b, err
+}
+
+// Read returns the content of a file in a backup, nil when the file did not exist.
+func Read(dataDir string, id int, name string) ([]byte, error) {
+	b, err := Get(dataDir, id)
+	if err != nil || !b.Files[name] {
+		return nil, err
+	}
+	return os.ReadFile(filepath.Join(Path(dataDir), strconv.Itoa(id), name))
+}
+
+// Restore replaces the configuration files with the content of a backup.
+// The current files are backed up first so a restore can be undone, files missing from the backup are removed.
+func Restore(dataDir string, id int, running bool) (Backup, error) {
+	b, err := Get(dataDir, id)
+	if err != nil {
+		return b, err
+	}
+	_, err = Create(dataDir, fmt.Sprintf("rollback to backup %d", id), running)
+	if err != nil {
+		return b, err
+	}
+	for _, name := range Files {
+		path := filepath.Join(dataDir, name)
+		if !b.Files[name] {
+			err = os.Remove(path)
+			if err != nil && !os.IsNotExist(err) {
+				return b, err
+			}
+			continue
+		}
+		content, err := Read(dataDir, id, name)
+		if err != nil {
+			return b, err
+		}
+		err = os.WriteFile(path, content, 0o664)
+		if err != nil {
+			return b, err
+		}
+		if system.IsSudo() {
+			err = matchOwner(dataDir, path)
+			if err != nil {
+				return b, err
+			}
+		}
+	}
+	return b, nil
+}
+
+// LastGood returns the newest backup taken while algod was running.
+func LastGood(dataDir string) (Backup, error) {
+	backups, err := List(dataDir)
+	if err != nil {
+		return Backup{}, err
+	}
+	for _, b := range backups {
+		if b.Running {
+			return b, nil
+		}
+	}
+	return Backup{}, ErrNotFound
+}
+
+// prune removes the oldest backups above MaxBackups.
+func prune(dataDir string, backups []Backup) error {
+	for i := MaxBackups; i < len(backups); i++ {
+		err := os.RemoveAll(filepath.Join(Path(dataDir), strconv.Itoa(backups[i].ID)))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// matchOwner sets the user and group of the paths, and of the files inside them, to the owner of the data directory.
+// Will only succeed if run as root (sudo)
+func matchOwner(dataDir string, paths ...string) error {
+	info, err := os.Stat(dataDir)
+	if err != nil {
+		return err
+	}
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return fmt.Errorf("unable to get ownership details on file: %s", dataDir)
+	}
+	for _, path := range paths {
+		err = filepath.Walk(path, func(p string, _ os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			return os.Chown(p, int(stat.Uid), int(stat.Gid))
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
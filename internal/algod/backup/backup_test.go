@@ -0,0 +1,119 @@
+package backup
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func Test_CreateAndRestore(t *testing.T) {
+	dir := t.TempDir()
+	config := filepath.Join(dir, "config.json")
+	err := os.WriteFile(config, []byte(`{"Archival": true}`), 0o664)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	first, err := Create(dir, "update config.json", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.ID != 1 || !first.Files["config.json"] || first.Files["logging.config"] {
+		t.Errorf("unexpected backup %+v", first)
+	}
+
+	// Change both files
+	err = os.WriteFile(config, []byte(`{"Archival": false}`), 0o664)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.WriteFile(filepath.Join(dir, "logging.config"), []byte(`{"Enable": true}`), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := Create(dir, "update logging.config", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second.ID != 2 {
+		t.Errorf("expected the second backup to be 2, got %d", second.ID)
+	}
+
+	backups, err := List(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(backups) != 2 || backups[0].ID != 2 || backups[1].ID != 1 {
+		t.Errorf("expected the newest backup first, got %+v", backups)
+	}
+	good, err := LastGood(dir)
+	if err != nil || good.ID != 1 {
+		t.Errorf("expected backup 1 to be the last good one, got %+v (%v)", good, err)
+	}
+
+	// Restoring the first backup removes logging.config and backs up the current files
+	_, err = Restore(dir, 1, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, _ := os.ReadFile(config)
+	if string(content) != `{"Archival": true}` {
+		t.Errorf("unexpected config.json %s", content)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "logging.config")); !os.IsNotExist(err) {
+		t.Errorf("expected logging.config to be removed, got %v", err)
+	}
+	content, err = Read(dir, 3, "logging.config")
+	if err != nil || string(content) != `{"Enable": true}` {
+		t.Errorf("expected the rollback to be backed up, got %s (%v)", content, err)
+	}
+
+	_, err = Restore(dir, 42, false)
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+	_, err = LastGood(t.TempDir())
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
+
+func Test_Prune(t *testing.T) {
+	dir := t.TempDir()
+	for i := 0; i < MaxBackups+2; i++ {
+		_, err := Create(dir, "test", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	backups, err := List(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(backups) != MaxBackups || backups[len(backups)-1].ID != 3 {
+		t.Errorf("expected the %d newest backups, got %d starting at %d", MaxBackups, len(backups), backups[len(backups)-1].ID)
+	}
+}
+
+func Test_Diff(t *testing.T) {
+	if lines := Diff([]byte("a\nb\n"), []byte("a\nb")); lines != nil {
+		t.Errorf("expected no changes, got %v", lines)
+	}
+
+	lines := Diff([]byte("{\n\"A\": 1,\n\"B\": 2\n}"), []byte("{\n\"B\": 2,\n\"C\": 3\n}"))
+	expected := []string{"  {", "- \"A\": 1,", "- \"B\": 2", "+ \"B\": 2,", "+ \"C\": 3", "  }"}
+	if len(lines) != len(expected) {
+		t.Fatalf("expected %d lines, got %v", len(expected), lines)
+	}
+	for i, line := range lines {
+		if line.String() != expected[i] {
+			t.Errorf("line %d: expected %q, got %q", i, expected[i], line.String())
+		}
+	}
+
+	lines = Diff(nil, []byte("new"))
+	if len(lines) != 1 || lines[0].Op != '+' {
+		t.Errorf("expected a single added line, got %v", lines)
+	}
+}
@@ -0,0 +1,74 @@
+package backup
+
+import (
+	"strings"
+)
+
+// Line is a single line of a Diff.
+type Line struct {
+	// Op is '-' for a removed line, '+' for an added line and ' ' for an unchanged line.
+	Op   byte
+	Text string
+}
+
+// String formats the line the way a unified diff does.
+func (l Line) String() string {
+	return string(l.Op) + " " + l.Text
+}
+
+// Diff compares two files line by line using their longest common subsequence.
+// It returns no lines when the files are equal.
+func Diff(old, new []byte) []Line {
+	a, b := splitLines(old), splitLines(new)
+	if strings.Join(a, "\n") == strings.Join(b, "\n") {
+		return nil
+	}
+
+	// lcs[i][j] is the length of the common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	lines := make([]Line, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			lines = append(lines, Line{' ', a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			lines = append(lines, Line{'-', a[i]})
+			i++
+		default:
+			lines = append(lines, Line{'+', b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		lines = append(lines, Line{'-', a[i]})
+	}
+	for ; j < len(b); j++ {
+		lines = append(lines, Line{'+', b[j]})
+	}
+	return lines
+}
+
+// splitLines returns the lines of a file without the trailing newline.
+func splitLines(file []byte) []string {
+	text := strings.TrimRight(string(file), "\n")
+	if text == "" {
+		return []string{}
+	}
+	return strings.Split(text, "\n")
+}
@@ -63,3 +63,22 @@ func GetBlockMetrics(ctx context.Context, client api.ClientWithResponsesInterfac
 
 	return avgs, currentBlockResponse, nil
 }
+
+// GetBlockTime returns the timestamp of the block at the given round.
+func GetBlockTime(ctx context.Context, client api.ClientWithResponsesInterface, round uint64) (time.Time, api.ResponseInterface, error) {
+	var format api.GetBlockParamsFormat = "json"
+	response, err := client.GetBlockWithResponse(ctx, int(round), &api.GetBlockParams{
+		Format: &format,
+	})
+	if err != nil {
+		return time.Time{}, response, err
+	}
+	if response.StatusCode() != 200 {
+		return time.Time{}, response, errors.New(response.Status())
+	}
+	ts, ok := response.JSON200.Block["ts"].(float64)
+	if !ok {
+		return time.Time{}, response, errors.New("block has no timestamp")
+	}
+	return time.Unix(int64(ts), 0), response, nil
+}
@@ -43,34 +43,39 @@ func AbortCatchup(ctx context.Context, client api.ClientWithResponsesInterface,
 }
 
 // GetLatestCatchpoint fetches the latest catchpoint for the specified network using the provided HTTP package.
-func GetLatestCatchpoint(httpPkg api.HttpPkgInterface, network string) (string, api.ResponseInterface, error) {
-	response, err := api.GetLatestCatchpointWithResponse(httpPkg, network)
+// The genesis hash tells apart networks sharing a genesis ID, see api.Networks.Find.
+func GetLatestCatchpoint(httpPkg api.HttpPkgInterface, network string, genesisHash string) (string, api.ResponseInterface, error) {
+	response, err := api.GetLatestCatchpointWithResponse(httpPkg, network, genesisHash)
 	if err != nil {
 		return "", response, err
 	}
 	return response.JSON200, response, nil
 }
 
-// IsLagging determines if the given round is lagging behind the network's latest catchpoint round by a predefined threshold.
+// IsLagging determines if the given round is lagging behind the network's latest catchpoint round by the lagging threshold of the network.
 // It takes an HTTP package interface, the current round, and the network name as inputs, and returns a boolean and an error.
-func IsLagging(httpPkg api.HttpPkgInterface, round uint64, network string) (bool, error) {
+func IsLagging(httpPkg api.HttpPkgInterface, round uint64, network string, genesisHash string) (bool, error) {
 	// Fetch catchpoint
-	catchpoint, _, err := GetLatestCatchpoint(httpPkg, network)
+	catchpoint, _, err := GetLatestCatchpoint(httpPkg, network, genesisHash)
 	if err != nil {
 		return false, err
 	}
 	if catchpoint == "" {
 		return false, errors.New(NO_CATCHPOINT)
 	}
-	// Parse catchpoint round
-	// Example: 48670000#AXHC4X4SSLE7QUSXE5CLPRPV2YUNK3EL6CFVEYWXGONNRO6GWXRQ
-	catchpointParts := strings.Split(catchpoint, "#")
-	catchpointRound, err := strconv.ParseUint(catchpointParts[0], 10, 64)
+	catchpointRound, err := ParseCatchpointRound(catchpoint)
 	if err != nil {
 		return false, err
 	}
 
 	// Considered lagging if the delta of the rounds are above the threshold
 	delta := int(catchpointRound) - int(round)
-	return CATCHPOINT_THRESHOLD < delta, nil
+	return GetLaggingThreshold(network, "") < delta, nil
+}
+
+// ParseCatchpointRound returns the round of a catchpoint label.
+// Example: 48670000#AXHC4X4SSLE7QUSXE5CLPRPV2YUNK3EL6CFVEYWXGONNRO6GWXRQ
+func ParseCatchpointRound(catchpoint string) (uint64, error) {
+	round, _, _ := strings.Cut(catchpoint, "#")
+	return strconv.ParseUint(round, 10, 64)
 }
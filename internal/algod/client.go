@@ -2,8 +2,12 @@ package algod
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -18,11 +22,18 @@ import (
 const InvalidDataDirMsg = "invalid data directory"
 const ClientTimeoutMsg = "timed out while waiting for the node"
 
+// DefaultTokenHeader is the header algod expects the API token in.
+const DefaultTokenHeader = "X-Algo-API-Token"
+
 func GetDataDir(dataDir string) (string, error) {
 	// Priority:
 	// 1. Use provided `-d` directory
-	// 2. Use environment variable `ALGORAND_DATA`
-	// 3. Use default given by nodekit
+	// 2. Use the directory of the selected instance
+	// 3. Use environment variable `ALGORAND_DATA`
+	// 4. Use default given by nodekit
+	if dataDir == "" && SelectedInstance != nil {
+		return SelectedInstance.DataDir, nil
+	}
 	if dataDir == "" {
 		dataDir = os.Getenv("ALGORAND_DATA")
 
@@ -52,11 +63,60 @@ func GetClient(dataDir string) (*api.ClientWithResponses, error) {
 		return nil, err
 	}
 
-	apiToken, err := securityprovider.NewSecurityProviderApiKey("header", "X-Algo-API-Token", config.Token)
+	return NewClient(config.Endpoint, config.Token)
+}
+
+// NewClient returns an API client for an algod endpoint authenticated with the admin token.
+func NewClient(endpoint string, token string) (*api.ClientWithResponses, error) {
+	return NewProfileClient(utils.ProfileSettings{Endpoint: endpoint, Token: token})
+}
+
+// GetProfileClient returns an API client for the named connection profile in the NodeKit settings.
+func GetProfileClient(name string) (*api.ClientWithResponses, error) {
+	profile, err := utils.GetProfile(name)
+	if err != nil {
+		return nil, err
+	}
+	return NewProfileClient(profile)
+}
+
+// NewProfileClient returns an API client for a connection profile,
+// using its token header, CA bundle and proxy when they are set.
+func NewProfileClient(profile utils.ProfileSettings) (*api.ClientWithResponses, error) {
+	header := profile.TokenHeader
+	if header == "" {
+		header = DefaultTokenHeader
+	}
+	apiToken, err := securityprovider.NewSecurityProviderApiKey("header", header, profile.Token)
 	if err != nil {
 		return nil, err
 	}
-	return api.NewClientWithResponses(config.Endpoint, api.WithRequestEditorFn(apiToken.Intercept))
+	opts := []api.ClientOption{api.WithRequestEditorFn(apiToken.Intercept)}
+
+	if profile.CACert != "" || profile.Proxy != "" {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		if profile.CACert != "" {
+			pem, err := os.ReadFile(profile.CACert)
+			if err != nil {
+				return nil, err
+			}
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(pem) {
+				return nil, fmt.Errorf("no certificates found in %s", profile.CACert)
+			}
+			transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+		}
+		if profile.Proxy != "" {
+			proxy, err := url.Parse(profile.Proxy)
+			if err != nil {
+				return nil, fmt.Errorf("invalid proxy %s: %w", profile.Proxy, err)
+			}
+			transport.Proxy = http.ProxyURL(proxy)
+		}
+		opts = append(opts, api.WithHTTPClient(&http.Client{Transport: transport}))
+	}
+
+	return api.NewClientWithResponses(profile.Endpoint, opts...)
 }
 
 func WaitForClient(ctx context.Context, dataDir string, interval time.Duration, timeout time.Duration) (*api.ClientWithResponses, error) {
@@ -0,0 +1,61 @@
+package algod
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/algorandfoundation/nodekit/internal/algod/utils"
+)
+
+func Test_NewProfileClient(t *testing.T) {
+	var header string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Get("X-Custom-Token")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	// Trust the test server through a CA bundle
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	err := os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := NewProfileClient(utils.ProfileSettings{
+		Endpoint:    server.URL,
+		Token:       "admin",
+		TokenHeader: "X-Custom-Token",
+		CACert:      caFile,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.MetricsWithResponse(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode() != http.StatusOK || header != "admin" {
+		t.Errorf("expected the token in the custom header, got status %d and %q", res.StatusCode(), header)
+	}
+
+	// Without the CA bundle the certificate is rejected
+	client, err = NewProfileClient(utils.ProfileSettings{Endpoint: server.URL, Token: "admin"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.MetricsWithResponse(context.Background())
+	if err == nil {
+		t.Error("expected an untrusted certificate to fail")
+	}
+
+	_, err = NewProfileClient(utils.ProfileSettings{Endpoint: server.URL, Proxy: "://invalid"})
+	if err == nil {
+		t.Error("expected an invalid proxy to fail")
+	}
+}
@@ -0,0 +1,332 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Type is the JSON type of an algod configuration option.
+type Type string
+
+const (
+	// BoolType options are true or false.
+	BoolType Type = "bool"
+
+	// IntType options are whole numbers, stored as int64.
+	IntType Type = "int"
+
+	// StringType options are free text, stored as string.
+	StringType Type = "string"
+)
+
+// Option describes a single key of the algod config.json file.
+type Option struct {
+	// Name is the key in config.json.
+	Name string
+
+	// Type is the JSON type of the value.
+	Type Type
+
+	// Default is the value algod uses when the key is not set, a bool, int64 or string.
+	Default any
+
+	// Description explains what the option does.
+	Description string
+
+	// Since is the first algod version that reads the option, empty when every supported version does.
+	Since string
+
+	// Validate checks the value beyond its type, it is nil when every value of the Type is allowed.
+	Validate func(value any) error
+}
+
+// Options is the algod configuration schema, sorted by name.
+var Options = []Option{
+	{Name: "Archival", Type: BoolType, Default: false, Description: "Keep every block instead of the most recent ones"},
+	{Name: "BaseLoggerDebugLevel", Type: IntType, Default: int64(4), Description: "Log level, 0 (panic) to 5 (debug)", Validate: between(0, 5)},
+	{Name: "BlockDBDir", Type: StringType, Default: "", Description: "Directory of the block database, overrides the hot and cold directories", Since: "3.18.0", Validate: absolutePath},
+	{Name: "CatchpointDir", Type: StringType, Default: "", Description: "Directory of the catchpoint files", Since: "3.18.0", Validate: absolutePath},
+	{Name: "CatchpointFileHistoryLength", Type: IntType, Default: int64(365), Description: "Number of catchpoint files to keep, -1 keeps all of them", Validate: minimum(-1)},
+	{Name: "CatchpointInterval", Type: IntType, Default: int64(10000), Description: "Rounds between catchpoints", Validate: minimum(0)},
+	{Name: "CatchpointTracking", Type: IntType, Default: int64(0), Description: "Catchpoint generation, -1 disables, 0 automatic, 1 tracks, 2 stores files", Validate: between(-1, 2)},
+	{Name: "CatchupBlockDownloadRetryAttempts", Type: IntType, Default: int64(1000), Description: "Attempts to download a block during catchup", Validate: minimum(1)},
+	{Name: "CatchupFailurePeerRefreshRate", Type: IntType, Default: int64(10), Description: "Failed block downloads before the peer list is refreshed", Validate: minimum(1)},
+	{Name: "CatchupGossipBlockFetchTimeoutSec", Type: IntType, Default: int64(4), Description: "Timeout in seconds to fetch a block over gossip", Validate: minimum(1)},
+	{Name: "CatchupHTTPBlockFetchTimeoutSec", Type: IntType, Default: int64(4), Description: "Timeout in seconds to fetch a block over HTTP", Validate: minimum(1)},
+	{Name: "CatchupLedgerDownloadRetryAttempts", Type: IntType, Default: int64(50), Description: "Attempts to download the catchpoint ledger", Validate: minimum(1)},
+	{Name: "CatchupParallelBlocks", Type: IntType, Default: int64(16), Description: "Blocks fetched in parallel during catchup", Validate: minimum(1)},
+	{Name: "ColdDataDir", Type: StringType, Default: "", Description: "Directory for data that is written rarely, e.g. on a hard drive", Since: "3.18.0", Validate: absolutePath},
+	{Name: "ConnectionsRateLimitingCount", Type: IntType, Default: int64(60), Description: "Incoming connections allowed per window from one address", Validate: minimum(0)},
+	{Name: "ConnectionsRateLimitingWindowSeconds", Type: IntType, Default: int64(1), Description: "Window in seconds for the connection rate limit", Validate: minimum(0)},
+	{Name: "CrashDBDir", Type: StringType, Default: "", Description: "Directory of the agreement crash database", Since: "3.18.0", Validate: absolutePath},
+	{Name: "DNSBootstrapID", Type: StringType, Default: "<network>.algorand.network?backup=<network>.algorand.net&dedup=<name>.algorand-<network>.(network|net)", Description: "DNS names used to discover relays"},
+	{Name: "DNSSecurityFlags", Type: IntType, Default: int64(9), Description: "DNSSEC checks, a bitmask of relay (1), SRV (2), TXT (4) and relay SRV (8)", Validate: between(0, 15)},
+	{Name: "DisableAPIAuth", Type: BoolType, Default: false, Description: "Serve the non-admin API without a token"},
+	{Name: "DisableLocalhostConnectionRateLimit", Type: BoolType, Default: true, Description: "Skip the connection rate limit for localhost"},
+	{Name: "DisableNetworking", Type: BoolType, Default: false, Description: "Do not connect to any peer"},
+	{Name: "EnableAgreementReporting", Type: BoolType, Default: false, Description: "Log agreement events"},
+	{Name: "EnableAgreementTimeMetrics", Type: BoolType, Default: false, Description: "Log agreement timing metrics"},
+	{Name: "EnableBlockService", Type: BoolType, Default: false, Description: "Serve blocks over HTTP to other nodes"},
+	{Name: "EnableDHTProviders", Type: BoolType, Default: false, Description: "Advertise the services of the node in the P2P DHT", Since: "3.24.0"},
+	{Name: "EnableDeveloperAPI", Type: BoolType, Default: false, Description: "Enable the developer API endpoints, e.g. compile and dryrun"},
+	{Name: "EnableExperimentalAPI", Type: BoolType, Default: false, Description: "Enable the experimental API endpoints"},
+	{Name: "EnableFollowMode", Type: BoolType, Default: false, Description: "Run as a follower node that does not participate", Since: "3.16.0"},
+	{Name: "EnableGossipBlockService", Type: BoolType, Default: true, Description: "Serve blocks over gossip to other nodes"},
+	{Name: "EnableIncomingMessageFilter", Type: BoolType, Default: false, Description: "Drop duplicate incoming messages"},
+	{Name: "EnableLedgerService", Type: BoolType, Default: false, Description: "Serve catchpoint ledgers to other nodes"},
+	{Name: "EnableMetricReporting", Type: BoolType, Default: false, Description: "Run the node exporter for metrics"},
+	{Name: "EnableNetDevMetrics", Type: BoolType, Default: false, Description: "Collect network device metrics", Since: "3.21.0"},
+	{Name: "EnableP2P", Type: BoolType, Default: false, Description: "Use the P2P network instead of the WebSocket network", Since: "3.24.0"},
+	{Name: "EnableP2PHybridMode", Type: BoolType, Default: false, Description: "Connect to both the P2P and the WebSocket network", Since: "3.25.0"},
+	{Name: "EnableProfiler", Type: BoolType, Default: false, Description: "Serve the Go profiler on the API endpoint"},
+	{Name: "EnableRequestLogger", Type: BoolType, Default: false, Description: "Log every API request"},
+	{Name: "EnableRuntimeMetrics", Type: BoolType, Default: false, Description: "Collect Go runtime metrics", Since: "3.16.0"},
+	{Name: "EnableTxnEvalTracer", Type: BoolType, Default: false, Description: "Trace transaction evaluation for the simulate endpoint", Since: "3.16.0"},
+	{Name: "EndpointAddress", Type: StringType, Default: "127.0.0.1:0", Description: "Address of the REST API, port 0 picks a random port", Validate: address},
+	{Name: "ForceRelayMessages", Type: BoolType, Default: false, Description: "Relay messages even without a NetAddress"},
+	{Name: "GoMemLimit", Type: IntType, Default: int64(0), Description: "Soft memory limit of the Go runtime in bytes, 0 disables", Since: "3.19.0", Validate: minimum(0)},
+	{Name: "GossipFanout", Type: IntType, Default: int64(4), Description: "Outgoing connections to relays", Validate: minimum(1)},
+	{Name: "HotDataDir", Type: StringType, Default: "", Description: "Directory for data that is written often, e.g. on an SSD", Since: "3.18.0", Validate: absolutePath},
+	{Name: "IncomingConnectionsLimit", Type: IntType, Default: int64(2400), Description: "Maximum incoming connections of a relay", Validate: minimum(0)},
+	{Name: "LedgerSynchronousMode", Type: IntType, Default: int64(2), Description: "SQLite synchronous mode of the ledger, 0 (off) to 3 (extra)", Validate: between(0, 3)},
+	{Name: "LogArchiveDir", Type: StringType, Default: "", Description: "Directory of the archived log files", Since: "3.18.0", Validate: absolutePath},
+	{Name: "LogArchiveMaxAge", Type: StringType, Default: "", Description: "Maximum age of archived logs, e.g. 168h, empty keeps them"},
+	{Name: "LogArchiveName", Type: StringType, Default: "node.archive.log", Description: "File name of the archived log, may contain a date template"},
+	{Name: "LogFileDir", Type: StringType, Default: "", Description: "Directory of node.log", Since: "3.18.0", Validate: absolutePath},
+	{Name: "LogSizeLimit", Type: IntType, Default: int64(1073741824), Description: "Size in bytes of node.log before it is archived", Validate: minimum(0)},
+	{Name: "MaxAPIResourcesPerAccount", Type: IntType, Default: int64(100000), Description: "Maximum assets and applications returned per account", Validate: minimum(0)},
+	{Name: "MaxAcctLookback", Type: IntType, Default: int64(4), Description: "Rounds of account state kept in memory", Validate: minimum(1)},
+	{Name: "MaxCatchpointDownloadDuration", Type: IntType, Default: int64(43200000000000), Description: "Maximum time in nanoseconds to download a catchpoint", Validate: minimum(0)},
+	{Name: "MaxConnectionsPerIP", Type: IntType, Default: int64(8), Description: "Incoming connections allowed from one address", Validate: minimum(1)},
+	{Name: "MinCatchpointFileDownloadBytesPerSecond", Type: IntType, Default: int64(20480), Description: "Slowest catchpoint download rate before the peer is dropped", Validate: minimum(0)},
+	{Name: "NetAddress", Type: StringType, Default: "", Description: "Address relays listen on for gossip, empty for non-relays", Validate: address},
+	{Name: "NodeExporterListenAddress", Type: StringType, Default: ":9100", Description: "Address of the node exporter", Validate: address},
+	{Name: "NodeExporterPath", Type: StringType, Default: "./node_exporter", Description: "Path of the node exporter binary"},
+	{Name: "P2PHybridIncomingConnectionsLimit", Type: IntType, Default: int64(1200), Description: "Maximum incoming P2P connections in hybrid mode", Since: "3.25.0", Validate: minimum(0)},
+	{Name: "P2PHybridNetAddress", Type: StringType, Default: "", Description: "Address hybrid relays listen on for P2P gossip", Since: "3.25.0", Validate: address},
+	{Name: "P2PPersistPeerID", Type: BoolType, Default: false, Description: "Keep the P2P peer ID between restarts", Since: "3.24.0"},
+	{Name: "P2PPrivateKeyLocation", Type: StringType, Default: "", Description: "Path of the P2P private key", Since: "3.24.0"},
+	{Name: "PublicAddress", Type: StringType, Default: "", Description: "Public address of a relay, used in telemetry and to avoid self connections"},
+	{Name: "RestReadTimeoutSeconds", Type: IntType, Default: int64(15), Description: "Timeout in seconds to read an API request", Validate: minimum(1)},
+	{Name: "RestWriteTimeoutSeconds", Type: IntType, Default: int64(120), Description: "Timeout in seconds to write an API response", Validate: minimum(1)},
+	{Name: "StateproofDir", Type: StringType, Default: "", Description: "Directory of the state proof database", Since: "3.18.0", Validate: absolutePath},
+	{Name: "TelemetryToLog", Type: BoolType, Default: true, Description: "Write telemetry events to node.log"},
+	{Name: "TrackerDBDir", Type: StringType, Default: "", Description: "Directory of the tracker database", Since: "3.18.0", Validate: absolutePath},
+	{Name: "TxPoolSize", Type: IntType, Default: int64(75000), Description: "Maximum transactions in the pool", Validate: minimum(1)},
+	{Name: "TxSyncIntervalSeconds", Type: IntType, Default: int64(60), Description: "Seconds between transaction pool syncs with peers", Validate: minimum(1)},
+}
+
+// Lookup returns the Option with the given name.
+func Lookup(name string) (Option, bool) {
+	i := sort.Search(len(Options), func(i int) bool { return Options[i].Name >= name })
+	if i < len(Options) && Options[i].Name == name {
+		return Options[i], true
+	}
+	return Option{}, false
+}
+
+// Parse converts a command line value to the Option's Type and validates it.
+func (o Option) Parse(raw string) (any, error) {
+	var value any
+	switch o.Type {
+	case BoolType:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be true or false", o.Name)
+		}
+		value = b
+	case IntType:
+		i, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be a whole number", o.Name)
+		}
+		value = i
+	default:
+		value = raw
+	}
+	return value, o.check(value)
+}
+
+// Decode converts a value read from config.json to the Option's Type and validates it.
+func (o Option) Decode(raw json.RawMessage) (any, error) {
+	var value any
+	var err error
+	switch o.Type {
+	case BoolType:
+		var b bool
+		err = json.Unmarshal(raw, &b)
+		value = b
+	case IntType:
+		var i int64
+		err = json.Unmarshal(raw, &i)
+		value = i
+	default:
+		var s string
+		err = json.Unmarshal(raw, &s)
+		value = s
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s must be a %s", o.Name, o.Type)
+	}
+	return value, o.check(value)
+}
+
+// Format renders a value of the Option for display and editing.
+func (o Option) Format(value any) string {
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprint(value)
+}
+
+// check runs the Option's validation
+func (o Option) check(value any) error {
+	if o.Validate == nil {
+		return nil
+	}
+	if err := o.Validate(value); err != nil {
+		return fmt.Errorf("%s %s", o.Name, err)
+	}
+	return nil
+}
+
+// Supports reports whether the algod version reads the Option, an unknown version supports every Option.
+func (o Option) Supports(version string) bool {
+	if o.Since == "" || version == "" {
+		return true
+	}
+	cmp, err := CompareVersions(version, o.Since)
+	return err != nil || cmp >= 0
+}
+
+// ValidateValues checks every known option against the schema, the algod version and the other options.
+// Unknown keys are ignored, values are the result of Option.Parse or Option.Decode.
+func ValidateValues(values map[string]any, version string) error {
+	var errs []error
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		option, ok := Lookup(name)
+		if !ok {
+			continue
+		}
+		if !option.Supports(version) {
+			errs = append(errs, fmt.Errorf("%s requires algod %s or newer, the node runs %s", name, option.Since, version))
+			continue
+		}
+		if err := option.check(values[name]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Hybrid relays listen on both networks
+	hybrid, _ := values["EnableP2PHybridMode"].(bool)
+	netAddress, _ := values["NetAddress"].(string)
+	hybridAddress, _ := values["P2PHybridNetAddress"].(string)
+	if hybrid && netAddress != "" && hybridAddress == "" {
+		errs = append(errs, errors.New("P2PHybridNetAddress must be set for a hybrid relay with a NetAddress"))
+	}
+	if hybridAddress != "" && hybridAddress == netAddress {
+		errs = append(errs, errors.New("P2PHybridNetAddress must differ from NetAddress"))
+	}
+
+	return errors.Join(errs...)
+}
+
+// DecodeValues converts the known keys of a config.json file with Option.Decode, unknown keys are skipped.
+func DecodeValues(raw map[string]json.RawMessage) (map[string]any, error) {
+	values := make(map[string]any)
+	var errs []error
+	for name, value := range raw {
+		option, ok := Lookup(name)
+		if !ok {
+			continue
+		}
+		decoded, err := option.Decode(value)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		values[name] = decoded
+	}
+	return values, errors.Join(errs...)
+}
+
+var versionRegex = regexp.MustCompile(`(\d+)\.(\d+)\.(\d+)`)
+
+// CompareVersions compares the major, minor and patch numbers of two algod versions,
+// e.g. v3.26.0-stable and 3.25.0, returning -1, 0 or 1.
+func CompareVersions(a string, b string) (int, error) {
+	pa := versionRegex.FindStringSubmatch(a)
+	pb := versionRegex.FindStringSubmatch(b)
+	if pa == nil || pb == nil {
+		return 0, fmt.Errorf("invalid version %s or %s", a, b)
+	}
+	for i := 1; i <= 3; i++ {
+		na, _ := strconv.Atoi(pa[i])
+		nb, _ := strconv.Atoi(pb[i])
+		if na != nb {
+			if na < nb {
+				return -1, nil
+			}
+			return 1, nil
+		}
+	}
+	return 0, nil
+}
+
+// minimum requires an int of at least min
+func minimum(min int64) func(any) error {
+	return func(value any) error {
+		if i, ok := value.(int64); ok && i < min {
+			return fmt.Errorf("must be at least %d", min)
+		}
+		return nil
+	}
+}
+
+// between requires an int from min to max
+func between(min int64, max int64) func(any) error {
+	return func(value any) error {
+		if i, ok := value.(int64); ok && (i < min || i > max) {
+			return fmt.Errorf("must be between %d and %d", min, max)
+		}
+		return nil
+	}
+}
+
+// address requires an empty string or a host:port address
+func address(value any) error {
+	s, _ := value.(string)
+	if s == "" {
+		return nil
+	}
+	_, port, err := net.SplitHostPort(s)
+	if err != nil {
+		return errors.New("must be an address like 127.0.0.1:8080 or :4160")
+	}
+	if p, err := strconv.Atoi(port); err != nil || p < 0 || p > 65535 {
+		return errors.New("must have a port from 0 to 65535")
+	}
+	return nil
+}
+
+// absolutePath requires an empty string or an absolute path
+func absolutePath(value any) error {
+	s, _ := value.(string)
+	if s != "" && !filepath.IsAbs(strings.TrimSpace(s)) {
+		return errors.New("must be an absolute path")
+	}
+	return nil
+}
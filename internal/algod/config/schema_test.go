@@ -0,0 +1,123 @@
+package config
+
+import (
+	"encoding/json"
+	"sort"
+	"testing"
+)
+
+func Test_OptionsSorted(t *testing.T) {
+	if !sort.SliceIsSorted(Options, func(i, j int) bool { return Options[i].Name < Options[j].Name }) {
+		t.Fatal("Options must be sorted by name for Lookup")
+	}
+	for _, option := range Options {
+		if _, ok := Lookup(option.Name); !ok {
+			t.Errorf("expected to find %s", option.Name)
+		}
+		// Every default must be valid for its own option
+		if err := option.check(option.Default); err != nil {
+			t.Errorf("invalid default for %s: %s", option.Name, err)
+		}
+	}
+	if _, ok := Lookup("NotAnOption"); ok {
+		t.Error("expected an unknown option to be missing")
+	}
+}
+
+func Test_Parse(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		value   any
+		invalid bool
+	}{
+		{name: "Archival", raw: "true", value: true},
+		{name: "Archival", raw: "yes", invalid: true},
+		{name: "GossipFanout", raw: "8", value: int64(8)},
+		{name: "GossipFanout", raw: "0", invalid: true},
+		{name: "GossipFanout", raw: "eight", invalid: true},
+		{name: "BaseLoggerDebugLevel", raw: "6", invalid: true},
+		{name: "NetAddress", raw: ":4160", value: ":4160"},
+		{name: "NetAddress", raw: "4160", invalid: true},
+		{name: "EndpointAddress", raw: "0.0.0.0:99999", invalid: true},
+		{name: "HotDataDir", raw: "relative/dir", invalid: true},
+		{name: "DNSBootstrapID", raw: "<network>.algorand.network", value: "<network>.algorand.network"},
+	}
+	for _, test := range tests {
+		option, _ := Lookup(test.name)
+		value, err := option.Parse(test.raw)
+		if test.invalid {
+			if err == nil {
+				t.Errorf("expected %s=%s to be invalid", test.name, test.raw)
+			}
+			continue
+		}
+		if err != nil || value != test.value {
+			t.Errorf("expected %s=%s to parse to %v, got %v (%v)", test.name, test.raw, test.value, value, err)
+		}
+	}
+}
+
+func Test_DecodeValues(t *testing.T) {
+	values, err := DecodeValues(map[string]json.RawMessage{
+		"Archival":     json.RawMessage(`true`),
+		"GossipFanout": json.RawMessage(`6`),
+		"Unknown":      json.RawMessage(`"kept"`),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if values["Archival"] != true || values["GossipFanout"] != int64(6) {
+		t.Errorf("unexpected values %v", values)
+	}
+	if _, ok := values["Unknown"]; ok {
+		t.Error("expected unknown keys to be skipped")
+	}
+
+	_, err = DecodeValues(map[string]json.RawMessage{"Archival": json.RawMessage(`"true"`)})
+	if err == nil {
+		t.Error("expected a string to be rejected for a bool option")
+	}
+}
+
+func Test_ValidateValues(t *testing.T) {
+	err := ValidateValues(map[string]any{"EnableP2PHybridMode": true, "Unknown": 1}, "v3.26.0-stable")
+	if err != nil {
+		t.Errorf("expected hybrid mode to be valid, got %s", err)
+	}
+
+	err = ValidateValues(map[string]any{"EnableP2PHybridMode": true}, "3.20.0")
+	if err == nil {
+		t.Error("expected hybrid mode to require a newer algod")
+	}
+
+	err = ValidateValues(map[string]any{"EnableP2PHybridMode": true, "NetAddress": ":4160"}, "")
+	if err == nil {
+		t.Error("expected a hybrid relay to require a P2PHybridNetAddress")
+	}
+
+	err = ValidateValues(map[string]any{"EnableP2PHybridMode": true, "NetAddress": ":4160", "P2PHybridNetAddress": ":4190"}, "")
+	if err != nil {
+		t.Errorf("expected the hybrid relay to be valid, got %s", err)
+	}
+}
+
+func Test_CompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		cmp  int
+	}{
+		{"v3.26.0-stable", "3.25.0", 1},
+		{"3.25.0", "3.25.0", 0},
+		{"3.9.1", "3.18.0", -1},
+	}
+	for _, test := range tests {
+		cmp, err := CompareVersions(test.a, test.b)
+		if err != nil || cmp != test.cmp {
+			t.Errorf("expected %s vs %s to be %d, got %d (%v)", test.a, test.b, test.cmp, cmp, err)
+		}
+	}
+	if _, err := CompareVersions("stable", "3.25.0"); err == nil {
+		t.Error("expected an invalid version to fail")
+	}
+}
@@ -0,0 +1,100 @@
+package algod
+
+import (
+	"fmt"
+
+	"github.com/algorandfoundation/nodekit/internal/algod/config"
+	"github.com/algorandfoundation/nodekit/internal/algod/utils"
+)
+
+// UpdateConfig parses, validates and writes changes to the config.json file in the data directory.
+// Set maps option names to their command line values, unset lists keys to remove so algod uses the default again.
+// The resulting configuration is validated against the schema and the algod version before anything is written,
+// unknown keys already in the file are preserved. It returns whether the file changed.
+func UpdateConfig(dataDir string, version string, set map[string]string, unset []string) (bool, error) {
+	parsed, changed, err := planConfig(dataDir, version, set, unset)
+	if err != nil || !changed {
+		return false, err
+	}
+	return true, utils.UpdateConfigInDataDir(dataDir, parsed, unset)
+}
+
+// PreviewConfig validates the same changes as UpdateConfig and returns the config.json file
+// before and after the changes, without writing anything.
+func PreviewConfig(dataDir string, version string, set map[string]string, unset []string) ([]byte, []byte, error) {
+	parsed, _, err := planConfig(dataDir, version, set, unset)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Both files are formatted the same way so only the values differ
+	before, err := utils.RenderConfig(dataDir, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	after, err := utils.RenderConfig(dataDir, parsed, unset)
+	return before, after, err
+}
+
+// planConfig parses the changes and validates the resulting configuration.
+// It returns the parsed values to set and whether the file would change.
+func planConfig(dataDir string, version string, set map[string]string, unset []string) (map[string]any, bool, error) {
+	raw, err := utils.GetConfigValuesFromDataDir(dataDir)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// Parse the new values
+	parsed := make(map[string]any, len(set))
+	for name, value := range set {
+		option, ok := config.Lookup(name)
+		if !ok {
+			return nil, false, fmt.Errorf("unknown option %s", name)
+		}
+		parsed[name], err = option.Parse(value)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+	removed := make(map[string]bool, len(unset))
+	for _, name := range unset {
+		if _, ok := parsed[name]; ok {
+			return nil, false, fmt.Errorf("%s cannot be set and unset", name)
+		}
+		removed[name] = true
+	}
+
+	// Merge with the values that are kept
+	merged := make(map[string]any)
+	changed := false
+	for name, value := range raw {
+		if removed[name] {
+			changed = true
+			continue
+		}
+		option, ok := config.Lookup(name)
+		if !ok {
+			continue
+		}
+		current, err := option.Decode(value)
+		if newValue, ok := parsed[name]; ok {
+			changed = changed || err != nil || current != newValue
+			continue
+		}
+		if err != nil {
+			return nil, false, err
+		}
+		merged[name] = current
+	}
+	for name, value := range parsed {
+		if _, ok := raw[name]; !ok {
+			changed = true
+		}
+		merged[name] = value
+	}
+
+	err = config.ValidateValues(merged, version)
+	if err != nil {
+		return nil, false, err
+	}
+	return parsed, changed, nil
+}
@@ -0,0 +1,82 @@
+package algod
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/algorandfoundation/nodekit/internal/algod/utils"
+)
+
+func Test_UpdateConfig(t *testing.T) {
+	dir := t.TempDir()
+	err := os.WriteFile(filepath.Join(dir, "config.json"), []byte(`{"Version": 34, "Archival": true}`), 0o664)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	changed, err := UpdateConfig(dir, "3.26.0", map[string]string{"GossipFanout": "8"}, []string{"Archival"})
+	if err != nil || !changed {
+		t.Fatalf("expected the config to change, got %v (%v)", changed, err)
+	}
+	values, _ := utils.GetConfigValuesFromDataDir(dir)
+	if string(values["GossipFanout"]) != "8" || string(values["Version"]) != "34" {
+		t.Errorf("unexpected values %v", values)
+	}
+	if _, ok := values["Archival"]; ok {
+		t.Error("expected Archival to be removed")
+	}
+
+	// Setting the same value again is a no-op
+	changed, err = UpdateConfig(dir, "3.26.0", map[string]string{"GossipFanout": "8"}, nil)
+	if err != nil || changed {
+		t.Errorf("expected no change, got %v (%v)", changed, err)
+	}
+
+	invalid := []map[string]string{
+		{"NotAnOption": "1"},
+		{"GossipFanout": "zero"},
+		{"EnableP2PHybridMode": "true", "NetAddress": ":4160"},
+	}
+	for _, set := range invalid {
+		if _, err := UpdateConfig(dir, "3.26.0", set, nil); err == nil {
+			t.Errorf("expected %v to be rejected", set)
+		}
+	}
+
+	// Options newer than the node are rejected
+	if _, err := UpdateConfig(dir, "3.20.0", map[string]string{"EnableP2P": "true"}, nil); err == nil {
+		t.Error("expected EnableP2P to require a newer algod")
+	}
+}
+
+func Test_PreviewConfig(t *testing.T) {
+	dir := t.TempDir()
+	original := `{"Version": 34, "Archival": true}`
+	err := os.WriteFile(filepath.Join(dir, "config.json"), []byte(original), 0o664)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	before, after, err := PreviewConfig(dir, "3.26.0", map[string]string{"GossipFanout": "8"}, []string{"Archival"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(before), `"Archival": true`) || strings.Contains(string(after), "Archival") {
+		t.Errorf("expected Archival to be removed, got %s", after)
+	}
+	if !strings.Contains(string(after), `"GossipFanout": 8`) {
+		t.Errorf("expected GossipFanout to be set, got %s", after)
+	}
+
+	// Nothing is written
+	content, _ := os.ReadFile(filepath.Join(dir, "config.json"))
+	if string(content) != original {
+		t.Errorf("expected config.json to be unchanged, got %s", content)
+	}
+
+	if _, _, err := PreviewConfig(dir, "3.26.0", map[string]string{"GossipFanout": "zero"}, nil); err == nil {
+		t.Error("expected an invalid value to be rejected")
+	}
+}
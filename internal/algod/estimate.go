@@ -0,0 +1,190 @@
+package algod
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/internal/algod/utils"
+	"github.com/algorandfoundation/nodekit/internal/system"
+)
+
+// DefaultSyncRate is the number of rounds per second a node applies while syncing block by block,
+// used when the rate could not be observed. It is a conservative estimate for mainnet.
+const DefaultSyncRate = 30.0
+
+// DefaultCatchpointDuration is the time to download, process and verify a catchpoint.
+const DefaultCatchpointDuration = 30 * time.Minute
+
+// CatchpointInterval is the number of rounds between two catchpoints.
+// The network is assumed to be half an interval past the latest catchpoint.
+const CatchpointInterval = 10_000
+
+// CatchpointDiskSpace is the free space needed to download a catchpoint and build the ledger from it.
+const CatchpointDiskSpace = 16 * 1024 * 1024 * 1024
+
+// MaxSyncTime caps the sync estimate of a node that does not sync faster than the network grows.
+const MaxSyncTime = 30 * 24 * time.Hour
+
+// RecommendedScore is the minimum CatchupEstimate.Score to recommend a fast catchup,
+// it must save at least half of the time of a normal sync.
+const RecommendedScore = 50
+
+// CatchupInput is what the catchup estimate is based on.
+type CatchupInput struct {
+	// Round is the last round of the node.
+	Round uint64 `json:"round"`
+
+	// CatchpointRound is the round of the latest catchpoint of the network.
+	CatchpointRound uint64 `json:"catchpointRound"`
+
+	// RoundTime is the measured average round time of the network, see GetBlockMetrics.
+	RoundTime time.Duration `json:"roundTime"`
+
+	// SyncRate is the observed number of rounds per second the node applies, DefaultSyncRate when it is 0.
+	SyncRate float64 `json:"syncRate"`
+
+	// DiskFree is the free space of the data directory in bytes, 0 when unknown.
+	DiskFree uint64 `json:"diskFree"`
+
+	// Archival nodes need every block and cannot use a fast catchup.
+	Archival bool `json:"archival"`
+
+	// Threshold is the lagging threshold of the network, a smaller lag is not worth a fast catchup.
+	Threshold uint64 `json:"threshold"`
+}
+
+// CatchupEstimate compares how long a normal sync and a fast catchup would take.
+type CatchupEstimate struct {
+	CatchupInput
+
+	// Lag is the number of rounds the node is behind the latest catchpoint.
+	Lag uint64 `json:"lag"`
+
+	// SyncTime is the estimated time to sync block by block.
+	SyncTime time.Duration `json:"syncTime"`
+
+	// CatchupTime is the estimated time of a fast catchup, including the rounds after the catchpoint.
+	CatchupTime time.Duration `json:"catchupTime"`
+
+	// Score is the percentage of time a fast catchup saves, 0 when it is not possible.
+	Score int `json:"score"`
+
+	// Recommended is true when the Score reaches RecommendedScore.
+	Recommended bool `json:"recommended"`
+
+	// Reason explains the recommendation.
+	Reason string `json:"reason"`
+}
+
+// EstimateCatchup scores a fast catchup against a normal sync.
+func EstimateCatchup(input CatchupInput) CatchupEstimate {
+	estimate := CatchupEstimate{CatchupInput: input}
+	rate := input.SyncRate
+	if rate <= 0 {
+		rate = DefaultSyncRate
+	}
+	if input.CatchpointRound > input.Round {
+		estimate.Lag = input.CatchpointRound - input.Round
+	}
+
+	// The network keeps growing while the node syncs
+	remaining := float64(estimate.Lag + CatchpointInterval/2)
+	effective := rate
+	if input.RoundTime > 0 {
+		effective -= 1 / input.RoundTime.Seconds()
+	}
+	estimate.SyncTime = MaxSyncTime
+	if effective > 0 && remaining/effective < MaxSyncTime.Seconds() {
+		estimate.SyncTime = time.Duration(remaining / effective * float64(time.Second))
+	}
+	estimate.CatchupTime = DefaultCatchpointDuration
+	if effective > 0 {
+		estimate.CatchupTime += time.Duration(CatchpointInterval / 2 / effective * float64(time.Second))
+	}
+
+	switch {
+	case input.Archival:
+		estimate.Reason = "archival nodes need every block and cannot fast catchup"
+	case estimate.Lag == 0:
+		estimate.Reason = "the node is not behind the latest catchpoint"
+	case estimate.Lag <= input.Threshold:
+		estimate.Reason = fmt.Sprintf("the node is within %d rounds of the latest catchpoint", input.Threshold)
+	case input.DiskFree > 0 && input.DiskFree < CatchpointDiskSpace:
+		estimate.Reason = fmt.Sprintf("%d MB free is not enough to download a catchpoint", input.DiskFree/1024/1024)
+	case estimate.CatchupTime >= estimate.SyncTime:
+		estimate.Reason = fmt.Sprintf("syncing %d rounds (%s) is faster than a fast catchup (%s)",
+			estimate.Lag, estimate.SyncTime.Round(time.Minute), estimate.CatchupTime.Round(time.Minute))
+	default:
+		estimate.Score = int(100 * (1 - estimate.CatchupTime.Seconds()/estimate.SyncTime.Seconds()))
+		estimate.Recommended = estimate.Score >= RecommendedScore
+		estimate.Reason = fmt.Sprintf("a fast catchup (%s) is %d%% faster than syncing %d rounds (%s)",
+			estimate.CatchupTime.Round(time.Minute), estimate.Score, estimate.Lag, estimate.SyncTime.Round(time.Minute))
+	}
+	return estimate
+}
+
+// MeasureSyncRate samples the last round of the node twice and returns the rounds applied per second.
+func MeasureSyncRate(ctx context.Context, status Status, sample time.Duration) (float64, error) {
+	start, _, err := status.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+	began := time.Now()
+	select {
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	case <-time.After(sample):
+	}
+	end, _, err := start.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if end.LastRound <= start.LastRound {
+		return 0, nil
+	}
+	return float64(end.LastRound-start.LastRound) / time.Since(began).Seconds(), nil
+}
+
+// GetCatchupEstimate gathers the CatchupInput of the node and estimates a fast catchup.
+// The sync rate is measured for the sample duration, a zero sample uses DefaultSyncRate.
+// The data directory is optional, it is used for the free space and the archival setting.
+func GetCatchupEstimate(ctx context.Context, status Status, dataDir string, sample time.Duration) (CatchupEstimate, error) {
+	catchpoint, _, err := GetLatestCatchpoint(status.HttpPkg, status.Network, status.GenesisHash)
+	if err != nil {
+		return CatchupEstimate{}, err
+	}
+	if catchpoint == "" {
+		return CatchupEstimate{}, errors.New(NO_CATCHPOINT)
+	}
+	catchpointRound, err := ParseCatchpointRound(catchpoint)
+	if err != nil {
+		return CatchupEstimate{}, err
+	}
+	input := CatchupInput{
+		Round:           status.LastRound,
+		CatchpointRound: catchpointRound,
+		Threshold:       uint64(GetLaggingThreshold(status.Network, status.GenesisHash)),
+	}
+
+	// The round time of the node's recent blocks, the network round time changes rarely
+	if status.LastRound > 10 {
+		metrics, _, err := GetBlockMetrics(ctx, status.Client, status.LastRound, 10)
+		if err == nil {
+			input.RoundTime = metrics.AvgTime
+		}
+	}
+	if sample > 0 {
+		input.SyncRate, err = MeasureSyncRate(ctx, status, sample)
+		if err != nil {
+			return CatchupEstimate{}, err
+		}
+	}
+	if dataDir != "" {
+		input.DiskFree, _ = system.DiskFree(dataDir)
+		values, err := utils.GetConfigValuesFromDataDir(dataDir)
+		input.Archival = err == nil && string(values["Archival"]) == "true"
+	}
+	return EstimateCatchup(input), nil
+}
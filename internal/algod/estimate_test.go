@@ -0,0 +1,86 @@
+package algod
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_EstimateCatchup(t *testing.T) {
+	base := CatchupInput{
+		Round:           1_000_000,
+		CatchpointRound: 1_300_000,
+		RoundTime:       3 * time.Second,
+		SyncRate:        30,
+		DiskFree:        100 * 1024 * 1024 * 1024,
+	}
+
+	estimate := EstimateCatchup(base)
+	if estimate.Lag != 300_000 {
+		t.Errorf("expected a lag of 300000, got %d", estimate.Lag)
+	}
+	if !estimate.Recommended || estimate.Score < RecommendedScore {
+		t.Errorf("expected a recommended catchup, got %d: %s", estimate.Score, estimate.Reason)
+	}
+	if estimate.CatchupTime >= estimate.SyncTime {
+		t.Errorf("expected the catchup to be faster, got %s and %s", estimate.CatchupTime, estimate.SyncTime)
+	}
+
+	input := base
+	input.CatchpointRound = base.Round + 30_000
+	estimate = EstimateCatchup(input)
+	if estimate.Recommended || estimate.Score != 0 {
+		t.Errorf("expected a sync for a small lag, got %d: %s", estimate.Score, estimate.Reason)
+	}
+
+	input = base
+	input.Round = base.CatchpointRound + 100
+	estimate = EstimateCatchup(input)
+	if estimate.Recommended || estimate.Lag != 0 {
+		t.Errorf("expected no catchup ahead of the catchpoint, got %s", estimate.Reason)
+	}
+
+	input = base
+	input.Threshold = 400_000
+	estimate = EstimateCatchup(input)
+	if estimate.Recommended || estimate.Score != 0 {
+		t.Errorf("expected no catchup within the lagging threshold, got %s", estimate.Reason)
+	}
+
+	input = base
+	input.Archival = true
+	estimate = EstimateCatchup(input)
+	if estimate.Recommended || estimate.Score != 0 {
+		t.Errorf("expected no catchup for an archival node, got %s", estimate.Reason)
+	}
+
+	input = base
+	input.DiskFree = 1024 * 1024 * 1024
+	estimate = EstimateCatchup(input)
+	if estimate.Recommended {
+		t.Errorf("expected no catchup with low disk space, got %s", estimate.Reason)
+	}
+
+	// An unknown disk space does not prevent a catchup
+	input = base
+	input.DiskFree = 0
+	estimate = EstimateCatchup(input)
+	if !estimate.Recommended {
+		t.Errorf("expected a recommended catchup, got %s", estimate.Reason)
+	}
+
+	// The node does not sync faster than the network grows
+	input = base
+	input.SyncRate = 0.2
+	estimate = EstimateCatchup(input)
+	if estimate.SyncTime != MaxSyncTime || !estimate.Recommended {
+		t.Errorf("expected a capped sync time, got %s", estimate.SyncTime)
+	}
+
+	// An unknown sync rate uses the default
+	input = base
+	input.SyncRate = 0
+	estimate = EstimateCatchup(input)
+	if estimate.SyncTime != EstimateCatchup(CatchupInput{Round: base.Round, CatchpointRound: base.CatchpointRound, RoundTime: base.RoundTime, SyncRate: DefaultSyncRate}).SyncTime {
+		t.Errorf("expected the default sync rate, got %s", estimate.SyncTime)
+	}
+}
@@ -10,17 +10,108 @@ import (
 	"os"
 	"os/exec"
 	"syscall"
+	"time"
 )
 
+// StopTimeout is how long Restart waits for algod to exit before starting it again.
+const StopTimeout = 10 * time.Second
+
+// Algod manages an algod process without a service manager, it implements system.Interface.
+// Without a DataDir the process is found by name and started with the ALGORAND_DATA environment variable,
+// otherwise it is started for the DataDir and found with its algod.pid file.
+type Algod struct {
+	DataDir string
+}
+
+var _ system.Interface = Algod{}
+
+// IsInstalled checks if the algod binary is available.
+func (Algod) IsInstalled() bool { return system.CmdExists("algod") }
+
+// IsRunning checks the algod PID file of the resolved data directory.
+func (Algod) IsRunning(dataDir string) bool { return utils.IsPidRunning(dataDir) }
+
+// IsService is false, algod is not managed by a service manager.
+func (Algod) IsService() bool { return false }
+
+// IsServiceEnabled is false, algod does not start with the system.
+func (Algod) IsServiceEnabled() bool { return false }
+
+// ServiceStatus is not supported without a service manager.
+func (Algod) ServiceStatus() (system.ServiceStatus, error) {
+	return system.ServiceStatus{}, system.ErrUnsupported
+}
+
+// SetNetwork is not supported.
+func (Algod) SetNetwork(string) error { return system.ErrUnsupported }
+
+// Install installs algod with the updater script.
+func (Algod) Install(release system.Release) error { return Install(release) }
+
+// Update is not supported.
+func (Algod) Update(system.Release) error { return system.ErrUnsupported }
+
+// Uninstall is not supported.
+func (Algod) Uninstall(bool) error { return system.ErrUnsupported }
+
+// Start starts algod for the DataDir or the ALGORAND_DATA directory.
+func (a Algod) Start() error {
+	if a.DataDir == "" {
+		return Start()
+	}
+	return startDataDir(a.DataDir)
+}
+
+// Stop sends SIGTERM to algod.
+func (a Algod) Stop() error {
+	if a.DataDir == "" {
+		return Stop()
+	}
+	pid, err := utils.GetPidFromDataDir(a.DataDir)
+	if err != nil {
+		return err
+	}
+	return terminate(pid)
+}
+
+// Restart stops algod when it is running, waits for it to exit and starts it again.
+func (a Algod) Restart() error {
+	if a.DataDir == "" {
+		return Restart()
+	}
+	if utils.IsPidRunning(a.DataDir) {
+		err := a.Stop()
+		if err != nil {
+			return err
+		}
+		for deadline := time.Now().Add(StopTimeout); utils.IsPidRunning(a.DataDir); time.Sleep(time.Second) {
+			if time.Now().After(deadline) {
+				return fmt.Errorf("algod did not stop within %s", StopTimeout)
+			}
+		}
+	}
+	return startDataDir(a.DataDir)
+}
+
+// UpdateService is not supported without a service manager.
+func (Algod) UpdateService(string) error { return system.ErrUnsupported }
+
+// EnsureService is not supported without a service manager.
+func (Algod) EnsureService() error { return system.ErrUnsupported }
+
 // Install executes a series of commands to set up the Algorand node and development tools on a Unix environment.
+// The updater script installs the latest release of the channel, it cannot pin a version.
 // TODO: Allow for changing of the paths
-func Install() error {
+func Install(release system.Release) error {
+	if release.Version != "" {
+		return errors.New("the updater script only installs the latest release, --version requires apt-get or dnf")
+	}
 	return system.RunAll(system.CmdsList{
 		{"mkdir", "~/node"},
 		{"sh", "-c", "cd ~/node"},
 		{"wget", "https://raw.githubusercontent.com/algorand/go-algorand/rel/stable/cmd/updater/update.sh"},
 		{"chmod", "744", "update.sh"},
-		{"sh", "-c", "./update.sh -i -c stable -p ~/node -d ~/node/data -n"},
+		{"sh", "-c", fmt.Sprintf("./update.sh -i -c %s -p ~/node -d ~/node/data -n", release.GetChannel())},
 	})
 
 }
@@ -51,6 +142,23 @@ func Start() error {
 	return nil
 }
 
+// startDataDir starts a detached algod process for the data directory.
+func startDataDir(dataDir string) error {
+	if !utils.IsDataDir(dataDir) {
+		return errors.New(msgs.InvalidDataDirectory)
+	}
+	log.Debug("Starting algod", "datadir", dataDir)
+	cmd := exec.Command("algod", "-d", dataDir)
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Setsid: true,
+	}
+	err := cmd.Start()
+	if err != nil {
+		return fmt.Errorf("failed to start algod: %v", err)
+	}
+	return nil
+}
+
 // Stop gracefully shuts down the algod process by sending a SIGTERM signal to its process ID. It returns an error if any occurs.
 func Stop() error {
 	log.Debug("Manually shutting down algod")
@@ -60,7 +168,11 @@ func Stop() error {
 		return err
 	}
 
-	// Send SIGTERM to the process
+	return terminate(pid)
+}
+
+// terminate sends SIGTERM to the process.
+func terminate(pid int) error {
 	process, err := os.FindProcess(pid)
 	if err != nil {
 		return err
@@ -74,6 +186,25 @@ func Stop() error {
 	return nil
 }
 
+// Restart stops algod when it is running, waits up to StopTimeout for it to exit and starts it again.
+func Restart() error {
+	if _, err := findAlgodPID(); err == nil {
+		err = Stop()
+		if err != nil {
+			return err
+		}
+		for deadline := time.Now().Add(StopTimeout); ; time.Sleep(time.Second) {
+			if _, err := findAlgodPID(); err != nil {
+				break
+			}
+			if time.Now().After(deadline) {
+				return fmt.Errorf("algod did not stop within %s", StopTimeout)
+			}
+		}
+	}
+	return Start()
+}
+
 // findAlgodPID locates the process ID of the running algod instance by executing the "pgrep" command.
 // It returns the process ID as an integer or an error if the process is not found or the command execution fails.
 func findAlgodPID() (int, error) {
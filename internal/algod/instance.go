@@ -0,0 +1,226 @@
+package algod
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"sort"
+
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/internal/algod/fallback"
+	"github.com/algorandfoundation/nodekit/internal/algod/linux"
+	"github.com/algorandfoundation/nodekit/internal/algod/mac"
+	"github.com/algorandfoundation/nodekit/internal/algod/utils"
+	"github.com/algorandfoundation/nodekit/internal/system"
+)
+
+// InstancePortBase is the first REST API port handed to an instance, the default node listens on 8080.
+const InstancePortBase = 8081
+
+// PackageGenesisDir holds the genesis files installed with the Linux packages.
+const PackageGenesisDir = "/var/lib/algorand/genesis"
+
+// GenesisURL is the genesis file of a network in the go-algorand repository.
+const GenesisURL = "https://raw.githubusercontent.com/algorand/go-algorand/master/installer/genesis/%s/genesis.json"
+
+// ErrInstanceNotFound is returned for an instance missing from the NodeKit settings.
+var ErrInstanceNotFound = errors.New("instance not found")
+
+// ErrInstanceExists is returned when adding an instance whose name, data directory or port is taken.
+var ErrInstanceExists = errors.New("instance already exists")
+
+// instanceName is a name that is safe to use in systemd units and launchd labels.
+var instanceName = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,31}$`)
+
+// Instance is a named algod node on this machine with its own data directory, service and REST API port.
+type Instance struct {
+	Name    string `json:"name"`
+	DataDir string `json:"dataDir"`
+	Network string `json:"network"`
+	Port    int    `json:"port"`
+}
+
+// SelectedInstance is the instance chosen with SelectInstance, GetDataDir defaults to its data directory.
+var SelectedInstance *Instance
+
+// GetInstances returns the instances of the NodeKit settings sorted by name.
+func GetInstances() ([]Instance, error) {
+	settings, err := utils.GetNodekitSettings()
+	if err != nil {
+		return nil, err
+	}
+	instances := make([]Instance, 0, len(settings.Instances))
+	for name, s := range settings.Instances {
+		instances = append(instances, Instance{Name: name, DataDir: s.DataDir, Network: s.Network, Port: s.Port})
+	}
+	sort.Slice(instances, func(i, j int) bool { return instances[i].Name < instances[j].Name })
+	return instances, nil
+}
+
+// GetInstance returns the named instance of the NodeKit settings.
+func GetInstance(name string) (Instance, error) {
+	instances, err := GetInstances()
+	if err != nil {
+		return Instance{}, err
+	}
+	for _, instance := range instances {
+		if instance.Name == name {
+			return instance, nil
+		}
+	}
+	return Instance{}, fmt.Errorf("%w: %s", ErrInstanceNotFound, name)
+}
+
+// NewInstanceService selects the service backend of an instance:
+// the nodekit-algod@ template unit on Linux, its own launchd service on macOS and a plain algod process everywhere else.
+func NewInstanceService(instance Instance) system.Interface {
+	switch {
+	case runtime.GOOS == "linux" && system.CmdExists("systemctl"):
+		return linux.Instance{Name: instance.Name, DataDir: instance.DataDir}
+	case runtime.GOOS == "darwin":
+		return mac.Instance{Name: instance.Name, DataDir: instance.DataDir}
+	default:
+		return fallback.Algod{DataDir: instance.DataDir}
+	}
+}
+
+// SelectInstance makes the named instance the node every command works on:
+// its data directory becomes the default and its service is returned to replace the service of the host.
+func SelectInstance(name string) (system.Interface, error) {
+	instance, err := GetInstance(name)
+	if err != nil {
+		return nil, err
+	}
+	SelectedInstance = &instance
+	return NewInstanceService(instance), nil
+}
+
+// DefaultInstanceDir returns the data directory of a new instance, next to the default data directory.
+func DefaultInstanceDir(name string) string {
+	if runtime.GOOS == "linux" {
+		return "/var/lib/algorand-" + name
+	}
+	return filepath.Join(os.Getenv("HOME"), ".algorand-"+name)
+}
+
+// AllocatePort returns the lowest port from InstancePortBase that no instance uses and nothing listens on.
+func AllocatePort(instances []Instance) int {
+	used := make(map[int]bool)
+	for _, instance := range instances {
+		used[instance.Port] = true
+	}
+	for port := InstancePortBase; ; port++ {
+		if used[port] {
+			continue
+		}
+		listener, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", port))
+		if err != nil {
+			continue
+		}
+		_ = listener.Close()
+		return port
+	}
+}
+
+// AddInstance creates the data directory of a new instance with the genesis file of the network
+// and a REST API on its own port, then saves it to the NodeKit settings.
+// An empty dataDir uses DefaultInstanceDir and a zero port is allocated with AllocatePort.
+// The service of the instance is not registered, see NewInstanceService.
+func AddInstance(httpPkg api.HttpPkgInterface, name string, network string, dataDir string, port int) (Instance, error) {
+	if !instanceName.MatchString(name) {
+		return Instance{}, fmt.Errorf("invalid instance name %q, use lowercase letters, digits and dashes", name)
+	}
+	network = api.ShortNetworkName(network)
+	if dataDir == "" {
+		dataDir = DefaultInstanceDir(name)
+	}
+	dataDir, err := filepath.Abs(dataDir)
+	if err != nil {
+		return Instance{}, err
+	}
+
+	instances, err := GetInstances()
+	if err != nil {
+		return Instance{}, err
+	}
+	for _, other := range instances {
+		switch {
+		case other.Name == name:
+			return Instance{}, fmt.Errorf("%w: %s", ErrInstanceExists, name)
+		case other.DataDir == dataDir:
+			return Instance{}, fmt.Errorf("%w: %s uses %s", ErrInstanceExists, other.Name, dataDir)
+		case port != 0 && other.Port == port:
+			return Instance{}, fmt.Errorf("%w: %s uses port %d", ErrInstanceExists, other.Name, port)
+		}
+	}
+	if port == 0 {
+		port = AllocatePort(instances)
+	}
+	instance := Instance{Name: name, DataDir: dataDir, Network: network, Port: port}
+
+	err = os.MkdirAll(dataDir, 0755)
+	if err != nil {
+		return instance, err
+	}
+	err = WriteGenesis(httpPkg, dataDir, network)
+	if err != nil {
+		return instance, err
+	}
+	err = utils.UpdateConfigInDataDir(dataDir, map[string]any{"EndpointAddress": fmt.Sprintf("127.0.0.1:%d", port)}, nil)
+	if err != nil {
+		return instance, err
+	}
+
+	settings, err := utils.GetNodekitSettings()
+	if err != nil {
+		return instance, err
+	}
+	if settings.Instances == nil {
+		settings.Instances = make(map[string]utils.InstanceSettings)
+	}
+	settings.Instances[name] = utils.InstanceSettings{DataDir: dataDir, Network: network, Port: port}
+	return instance, utils.WriteNodekitSettings(settings)
+}
+
+// RemoveInstance deletes the instance from the NodeKit settings, its data directory is kept.
+func RemoveInstance(name string) error {
+	settings, err := utils.GetNodekitSettings()
+	if err != nil {
+		return err
+	}
+	if _, ok := settings.Instances[name]; !ok {
+		return fmt.Errorf("%w: %s", ErrInstanceNotFound, name)
+	}
+	delete(settings.Instances, name)
+	return utils.WriteNodekitSettings(settings)
+}
+
+// WriteGenesis writes the genesis file of the network to the data directory, unless it has one.
+// The file installed with the Linux packages is used when present, otherwise it is downloaded.
+func WriteGenesis(httpPkg api.HttpPkgInterface, dataDir string, network string) error {
+	genesisPath := filepath.Join(dataDir, "genesis.json")
+	if _, err := os.Stat(genesisPath); err == nil {
+		return nil
+	}
+	genesis, err := os.ReadFile(filepath.Join(PackageGenesisDir, network, "genesis.json"))
+	if err != nil {
+		res, err := httpPkg.Get(fmt.Sprintf(GenesisURL, network))
+		if err != nil {
+			return err
+		}
+		defer res.Body.Close()
+		if res.StatusCode != 200 {
+			return fmt.Errorf("no genesis file found for network %s: %s", network, res.Status)
+		}
+		genesis, err = io.ReadAll(res.Body)
+		if err != nil {
+			return err
+		}
+	}
+	return os.WriteFile(genesisPath, genesis, 0644)
+}
@@ -0,0 +1,108 @@
+package algod
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/internal/algod/utils"
+)
+
+// genesisHttp serves the genesis file of every network
+type genesisHttp struct {
+	api.HttpPkgInterface
+}
+
+func (genesisHttp) Get(url string) (*http.Response, error) {
+	body := fmt.Sprintf(`{"url": %q}`, url)
+	return &http.Response{StatusCode: 200, Status: "200 OK", Body: io.NopCloser(bytes.NewReader([]byte(body)))}, nil
+}
+
+func Test_Instances(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	defer func() { SelectedInstance = nil }()
+
+	testDir := filepath.Join(t.TempDir(), "testnode")
+	testnode, err := AddInstance(genesisHttp{}, "testnode", "testnet-v1.0", testDir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if testnode.Network != "testnet" || testnode.DataDir != testDir || testnode.Port < InstancePortBase {
+		t.Errorf("unexpected instance %+v", testnode)
+	}
+	genesis, err := os.ReadFile(filepath.Join(testDir, "genesis.json"))
+	if err != nil || !bytes.Contains(genesis, []byte("/testnet/genesis.json")) {
+		t.Errorf("expected the testnet genesis file, got %s: %v", genesis, err)
+	}
+	values, err := utils.GetConfigValuesFromDataDir(testDir)
+	if err != nil || string(values["EndpointAddress"]) != fmt.Sprintf(`"127.0.0.1:%d"`, testnode.Port) {
+		t.Errorf("expected the REST API on port %d, got %s: %v", testnode.Port, values["EndpointAddress"], err)
+	}
+
+	mainnode, err := AddInstance(genesisHttp{}, "mainnode", "mainnet", filepath.Join(t.TempDir(), "mainnode"), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mainnode.Port == testnode.Port {
+		t.Errorf("expected another port than %d", testnode.Port)
+	}
+
+	for _, tc := range []struct {
+		name    string
+		dataDir string
+		port    int
+	}{
+		{name: "testnode", dataDir: t.TempDir()},
+		{name: "other", dataDir: testDir},
+		{name: "other", dataDir: t.TempDir(), port: mainnode.Port},
+	} {
+		_, err = AddInstance(genesisHttp{}, tc.name, "testnet", tc.dataDir, tc.port)
+		if !errors.Is(err, ErrInstanceExists) {
+			t.Errorf("expected %s in %s on port %d to exist, got %v", tc.name, tc.dataDir, tc.port, err)
+		}
+	}
+	_, err = AddInstance(genesisHttp{}, "Test Node", "testnet", "", 0)
+	if err == nil {
+		t.Error("expected an invalid name to fail")
+	}
+
+	instances, err := GetInstances()
+	if err != nil || len(instances) != 2 || instances[0].Name != "mainnode" {
+		t.Errorf("expected both instances sorted by name, got %+v: %v", instances, err)
+	}
+
+	service, err := SelectInstance("testnode")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if service == nil {
+		t.Error("expected the service of the instance")
+	}
+	dataDir, err := GetDataDir("")
+	if err != nil || dataDir != testDir {
+		t.Errorf("expected the data directory of the instance, got %s: %v", dataDir, err)
+	}
+	dataDir, _ = GetDataDir("/var/lib/algorand")
+	if dataDir != "/var/lib/algorand" {
+		t.Errorf("expected the data directory flag to take precedence, got %s", dataDir)
+	}
+
+	err = RemoveInstance("mainnode")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = GetInstance("mainnode")
+	if !errors.Is(err, ErrInstanceNotFound) {
+		t.Errorf("expected the instance to be removed, got %v", err)
+	}
+	_, err = SelectInstance("mainnode")
+	if !errors.Is(err, ErrInstanceNotFound) {
+		t.Errorf("expected a missing instance, got %v", err)
+	}
+}
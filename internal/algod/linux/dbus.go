@@ -0,0 +1,247 @@
+package linux
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/algorandfoundation/nodekit/internal/system"
+	"github.com/godbus/dbus/v5"
+)
+
+// ServiceName is the systemd unit of the algod service.
+const ServiceName = "algorand.service"
+
+// JournalLines is the number of journal lines included in the ServiceStatus.
+const JournalLines = 10
+
+const (
+	systemdDestination = "org.freedesktop.systemd1"
+	managerPath        = "/org/freedesktop/systemd1"
+	managerInterface   = "org.freedesktop.systemd1.Manager"
+	unitInterface      = "org.freedesktop.systemd1.Unit"
+	serviceInterface   = "org.freedesktop.systemd1.Service"
+)
+
+// ErrAccessDenied is returned when the user may not manage the unit without authenticating.
+var ErrAccessDenied = errors.New("access denied")
+
+// ErrNoUnit is returned for a unit which does not exist.
+var ErrNoUnit = errors.New("unit does not exist")
+
+// Bus calls the methods and reads the properties of the systemd D-Bus API, see org.freedesktop.systemd1(5).
+// The arguments and values are Go values of the D-Bus types, object paths are returned as strings.
+type Bus interface {
+	// Call invokes a method of an object.
+	Call(path string, iface string, method string, args ...any) ([]any, error)
+	// GetProperty reads a property of an object.
+	GetProperty(path string, iface string, property string) (any, error)
+}
+
+// SystemBus talks to systemd on the system bus.
+// Interactive authorization is not allowed so a call never waits for a password,
+// a call the user may not make returns ErrAccessDenied.
+type SystemBus struct{}
+
+// Call invokes a method of an object.
+func (b SystemBus) Call(path string, iface string, method string, args ...any) ([]any, error) {
+	conn, err := dbus.SystemBus()
+	if err != nil {
+		return nil, err
+	}
+	call := conn.Object(systemdDestination, dbus.ObjectPath(path)).Call(iface+"."+method, 0, args...)
+	if call.Err != nil {
+		return nil, busError(call.Err)
+	}
+	body := make([]any, len(call.Body))
+	for i, value := range call.Body {
+		body[i] = fromDBus(value)
+	}
+	return body, nil
+}
+
+// GetProperty reads a property of an object.
+func (b SystemBus) GetProperty(path string, iface string, property string) (any, error) {
+	conn, err := dbus.SystemBus()
+	if err != nil {
+		return nil, err
+	}
+	variant, err := conn.Object(systemdDestination, dbus.ObjectPath(path)).GetProperty(iface + "." + property)
+	if err != nil {
+		return nil, busError(err)
+	}
+	return fromDBus(variant.Value()), nil
+}
+
+// fromDBus converts the object paths of a reply to strings.
+func fromDBus(value any) any {
+	if path, ok := value.(dbus.ObjectPath); ok {
+		return string(path)
+	}
+	return value
+}
+
+// busError maps the errors of the systemd D-Bus API to ErrAccessDenied and ErrNoUnit.
+func busError(err error) error {
+	var dbusErr dbus.Error
+	if !errors.As(err, &dbusErr) {
+		var ptr *dbus.Error
+		if !errors.As(err, &ptr) {
+			return err
+		}
+		dbusErr = *ptr
+	}
+	switch dbusErr.Name {
+	case "org.freedesktop.DBus.Error.AccessDenied",
+		"org.freedesktop.DBus.Error.InteractiveAuthorizationRequired":
+		return fmt.Errorf("%w: %s", ErrAccessDenied, dbusErr.Error())
+	case "org.freedesktop.systemd1.NoSuchUnit",
+		"org.freedesktop.DBus.Error.FileNotFound":
+		return fmt.Errorf("%w: %s", ErrNoUnit, dbusErr.Error())
+	}
+	return err
+}
+
+// Systemd manages units through the systemd D-Bus API.
+type Systemd struct {
+	Bus Bus
+
+	// Journal returns the most recent log lines of a unit.
+	Journal func(unit string, lines int) ([]string, error)
+}
+
+// System is the systemd of this machine.
+var System = Systemd{Bus: SystemBus{}, Journal: ReadJournal}
+
+// Start queues a start job for the unit.
+func (s Systemd) Start(unit string) error {
+	_, err := s.Bus.Call(managerPath, managerInterface, "StartUnit", unit, "replace")
+	return err
+}
+
+// Stop queues a stop job for the unit.
+func (s Systemd) Stop(unit string) error {
+	_, err := s.Bus.Call(managerPath, managerInterface, "StopUnit", unit, "replace")
+	return err
+}
+
+// Restart queues a restart job for the unit, it is started when it was not running.
+func (s Systemd) Restart(unit string) error {
+	_, err := s.Bus.Call(managerPath, managerInterface, "RestartUnit", unit, "replace")
+	return err
+}
+
+// Reload reloads the unit files, like systemctl daemon-reload.
+func (s Systemd) Reload() error {
+	_, err := s.Bus.Call(managerPath, managerInterface, "Reload")
+	return err
+}
+
+// Enable enables the unit file so it starts with the system, like systemctl enable.
+func (s Systemd) Enable(unit string) error {
+	_, err := s.Bus.Call(managerPath, managerInterface, "EnableUnitFiles", []string{unit}, false, true)
+	return err
+}
+
+// Disable disables the unit file, like systemctl disable.
+func (s Systemd) Disable(unit string) error {
+	_, err := s.Bus.Call(managerPath, managerInterface, "DisableUnitFiles", []string{unit}, false)
+	return err
+}
+
+// UnitFileState returns whether the unit starts with the system, e.g. enabled or disabled.
+// A unit without unit file returns ErrNoUnit.
+func (s Systemd) UnitFileState(unit string) (string, error) {
+	data, err := s.Bus.Call(managerPath, managerInterface, "GetUnitFileState", unit)
+	if err != nil {
+		return "", err
+	}
+	return firstString(data)
+}
+
+// Status returns the state, exit code, restarts and recent journal lines of a service unit.
+func (s Systemd) Status(unit string) (system.ServiceStatus, error) {
+	status := system.ServiceStatus{Unit: unit}
+	data, err := s.Bus.Call(managerPath, managerInterface, "LoadUnit", unit)
+	if err != nil {
+		return status, err
+	}
+	path, err := firstString(data)
+	if err != nil {
+		return status, err
+	}
+
+	for property, value := range map[string]*string{
+		"LoadState":     &status.LoadState,
+		"ActiveState":   &status.ActiveState,
+		"SubState":      &status.SubState,
+		"UnitFileState": &status.UnitFileState,
+	} {
+		v, err := s.Bus.GetProperty(path, unitInterface, property)
+		if err != nil {
+			return status, err
+		}
+		*value, _ = v.(string)
+	}
+	if status.LoadState == "not-found" {
+		return status, fmt.Errorf("%w: %s", ErrNoUnit, unit)
+	}
+	for property, value := range map[string]*int{
+		"ExecMainStatus": &status.ExitCode,
+		"NRestarts":      &status.Restarts,
+	} {
+		v, err := s.Bus.GetProperty(path, serviceInterface, property)
+		if err != nil {
+			return status, err
+		}
+		*value = toInt(v)
+	}
+
+	if s.Journal != nil {
+		status.Journal, _ = s.Journal(unit, JournalLines)
+	}
+	return status, nil
+}
+
+// ReadJournal returns the most recent log lines of a unit with journalctl.
+func ReadJournal(unit string, lines int) ([]string, error) {
+	output, err := exec.Command("journalctl", "--unit", unit, "--lines", fmt.Sprint(lines), "--no-pager", "--output", "short-iso").Output()
+	if err != nil {
+		return nil, err
+	}
+	trimmed := strings.TrimSpace(string(output))
+	if trimmed == "" {
+		return []string{}, nil
+	}
+	return strings.Split(trimmed, "\n"), nil
+}
+
+// toInt returns a numeric property as an int, 0 for other values.
+func toInt(value any) int {
+	switch v := value.(type) {
+	case int32:
+		return int(v)
+	case uint32:
+		return int(v)
+	case int64:
+		return int(v)
+	case uint64:
+		return int(v)
+	case int:
+		return v
+	}
+	return 0
+}
+
+// firstString returns the first value of a method reply as a string.
+func firstString(data []any) (string, error) {
+	if len(data) == 0 {
+		return "", errors.New("empty D-Bus reply")
+	}
+	value, ok := data[0].(string)
+	if !ok {
+		return "", fmt.Errorf("unexpected D-Bus reply %v", data[0])
+	}
+	return value, nil
+}
@@ -0,0 +1,104 @@
+package linux
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/godbus/dbus/v5"
+)
+
+// fakeBus is an in-memory systemd with a single unit.
+type fakeBus struct {
+	unit       string
+	properties map[string]any
+	calls      []string
+	err        error
+}
+
+func (b *fakeBus) Call(path string, iface string, method string, args ...any) ([]any, error) {
+	b.calls = append(b.calls, strings.TrimSpace(fmt.Sprintln(append([]any{method}, args...)...)))
+	if b.err != nil {
+		return nil, b.err
+	}
+	if path != managerPath || iface != managerInterface {
+		return nil, fmt.Errorf("unknown object %s %s", path, iface)
+	}
+	switch method {
+	case "GetUnitFileState":
+		if args[0] != b.unit {
+			return nil, fmt.Errorf("%w: unit file %s does not exist", ErrNoUnit, args[0])
+		}
+		return []any{b.properties["UnitFileState"]}, nil
+	case "LoadUnit":
+		return []any{"/org/freedesktop/systemd1/unit/" + strings.ReplaceAll(args[0].(string), ".", "_2e")}, nil
+	}
+	return []any{"/org/freedesktop/systemd1/job/1"}, nil
+}
+
+func (b *fakeBus) GetProperty(path string, iface string, property string) (any, error) {
+	if !strings.HasSuffix(path, strings.ReplaceAll(b.unit, ".", "_2e")) {
+		if property == "LoadState" {
+			return "not-found", nil
+		}
+		return "", nil
+	}
+	return b.properties[property], nil
+}
+
+func Test_Systemd(t *testing.T) {
+	bus := &fakeBus{unit: ServiceName, properties: map[string]any{
+		"LoadState":      "loaded",
+		"ActiveState":    "failed",
+		"SubState":       "failed",
+		"UnitFileState":  "enabled",
+		"ExecMainStatus": int32(1),
+		"NRestarts":      uint32(3),
+	}}
+	systemd := Systemd{Bus: bus, Journal: func(unit string, lines int) ([]string, error) {
+		return []string{"algod: unable to open ledger"}, nil
+	}}
+
+	for _, call := range []func(string) error{systemd.Start, systemd.Stop, systemd.Restart} {
+		err := call(ServiceName)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err := systemd.Reload()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"StartUnit algorand.service replace", "StopUnit algorand.service replace", "RestartUnit algorand.service replace", "Reload"}
+	if strings.Join(bus.calls, ",") != strings.Join(expected, ",") {
+		t.Errorf("unexpected calls %v", bus.calls)
+	}
+
+	state, err := systemd.UnitFileState(ServiceName)
+	if err != nil || state != "enabled" {
+		t.Errorf("expected an enabled unit, got %s: %v", state, err)
+	}
+	_, err = systemd.UnitFileState("missing.service")
+	if !errors.Is(err, ErrNoUnit) {
+		t.Errorf("expected a missing unit, got %v", err)
+	}
+
+	status, err := systemd.Status(ServiceName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !status.IsFailed() || status.SubState != "failed" || status.ExitCode != 1 || status.Restarts != 3 || len(status.Journal) != 1 {
+		t.Errorf("unexpected status %+v", status)
+	}
+	_, err = systemd.Status("missing.service")
+	if !errors.Is(err, ErrNoUnit) {
+		t.Errorf("expected a missing unit, got %v", err)
+	}
+
+	bus.err = busError(dbus.Error{Name: "org.freedesktop.DBus.Error.InteractiveAuthorizationRequired", Body: []any{"Interactive authentication required."}})
+	err = systemd.Start(ServiceName)
+	if !errors.Is(err, ErrAccessDenied) {
+		t.Errorf("expected access denied, got %v", err)
+	}
+}
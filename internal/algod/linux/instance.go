@@ -0,0 +1,198 @@
+package linux
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"text/template"
+
+	"github.com/algorandfoundation/nodekit/internal/algod/utils"
+	"github.com/algorandfoundation/nodekit/internal/system"
+	"github.com/charmbracelet/log"
+)
+
+// InstanceTemplate is the systemd template unit of the named instances, an instance runs as nodekit-algod@<name>.service.
+const InstanceTemplate = "nodekit-algod@.service"
+
+// UnitDir is the directory the unit files and drop-ins of the instances are written to.
+var UnitDir = "/etc/systemd/system"
+
+// instanceTemplate runs algod for the data directory set by the drop-in of each instance.
+const instanceTemplate = `[Unit]
+Description=Algorand daemon instance %i
+After=network.target
+
+[Service]
+ExecStart={{.AlgodPath}} -d ${ALGORAND_DATA}
+User=algorand
+Group=algorand
+Restart=always
+RestartSec=5s
+LimitNOFILE=65536
+
+[Install]
+WantedBy=multi-user.target
+`
+
+// instanceDropIn sets the data directory of an instance.
+const instanceDropIn = `[Service]
+Environment="ALGORAND_DATA={{.DataDirectoryPath}}"
+`
+
+// Instance manages a named algod instance with the nodekit-algod@ template unit, it implements system.Interface.
+// The algod binary is shared with the algorand.service unit, each instance has its own data directory.
+type Instance struct {
+	Name    string
+	DataDir string
+}
+
+var _ system.Interface = Instance{}
+
+// Unit returns the systemd unit of the instance.
+func (i Instance) Unit() string {
+	return fmt.Sprintf("nodekit-algod@%s.service", i.Name)
+}
+
+// dropInPath returns the path of the drop-in setting the data directory of the instance.
+func (i Instance) dropInPath() string {
+	return filepath.Join(UnitDir, i.Unit()+".d", "override.conf")
+}
+
+// IsInstalled checks if the algod binary is available.
+func (i Instance) IsInstalled() bool { return system.CmdExists("algod") }
+
+// IsRunning checks the algod PID file of the resolved data directory.
+func (i Instance) IsRunning(dataDir string) bool { return utils.IsPidRunning(dataDir) }
+
+// IsService checks if the instance is registered, its drop-in exists.
+func (i Instance) IsService() bool {
+	_, err := os.Stat(i.dropInPath())
+	return err == nil
+}
+
+// IsServiceEnabled checks if the unit of the instance starts with the system.
+func (i Instance) IsServiceEnabled() bool {
+	state, err := System.UnitFileState(i.Unit())
+	return err == nil && state == "enabled"
+}
+
+// ServiceStatus returns the state of the unit of the instance.
+func (i Instance) ServiceStatus() (system.ServiceStatus, error) { return System.Status(i.Unit()) }
+
+// SetNetwork is not supported, the network is chosen when the instance is added.
+func (i Instance) SetNetwork(string) error { return system.ErrUnsupported }
+
+// Install registers the instance with systemd, algod must be installed already.
+// The release has no effect, the algod binary is shared by the instances.
+func (i Instance) Install(system.Release) error {
+	if !i.IsInstalled() {
+		return system.ErrNotInstalled
+	}
+	return i.EnsureService()
+}
+
+// Update moves the shared algod binary to the release with the package manager.
+func (i Instance) Update(release system.Release) error { return Upgrade(release) }
+
+// Uninstall stops and unregisters the instance, the data directory and the algod binary are kept.
+func (i Instance) Uninstall(bool) error {
+	log.Info("Removing instance " + i.Name)
+	if i.IsRunning(i.DataDir) {
+		err := i.Stop()
+		if err != nil {
+			return err
+		}
+	}
+	err := manage(i.Unit(), "disable", System.Disable)
+	if err != nil {
+		return err
+	}
+	err = os.RemoveAll(filepath.Dir(i.dropInPath()))
+	if err != nil {
+		return err
+	}
+	return reload()
+}
+
+// Start starts the unit of the instance.
+func (i Instance) Start() error { return manage(i.Unit(), "start", System.Start) }
+
+// Stop stops the unit of the instance.
+func (i Instance) Stop() error { return manage(i.Unit(), "stop", System.Stop) }
+
+// Restart restarts the unit of the instance.
+func (i Instance) Restart() error { return manage(i.Unit(), "restart", System.Restart) }
+
+// UpdateService points the instance to another data directory and reloads systemd.
+func (i Instance) UpdateService(dataDirectoryPath string) error {
+	err := writeUnitFile(i.dropInPath(), instanceDropIn, map[string]string{"DataDirectoryPath": dataDirectoryPath})
+	if err != nil {
+		return err
+	}
+	return reload()
+}
+
+// EnsureService writes the template unit and the drop-in of the instance, hands the data directory
+// to the algorand user and enables the unit.
+func (i Instance) EnsureService() error {
+	algodPath, err := exec.LookPath("algod")
+	if err != nil {
+		return err
+	}
+	err = writeUnitFile(filepath.Join(UnitDir, InstanceTemplate), instanceTemplate, map[string]string{"AlgodPath": algodPath})
+	if err != nil {
+		return err
+	}
+	err = writeUnitFile(i.dropInPath(), instanceDropIn, map[string]string{"DataDirectoryPath": i.DataDir})
+	if err != nil {
+		return err
+	}
+	err = system.RunAll(system.CmdsList{{"chown", "-R", "algorand:algorand", i.DataDir}})
+	if err != nil {
+		return err
+	}
+	err = reload()
+	if err != nil {
+		return err
+	}
+	return manage(i.Unit(), "enable", System.Enable)
+}
+
+// manage runs the D-Bus call for the unit.
+// It returns ErrAccessDenied when the user may not manage the unit, as the main service does.
+func manage(unit string, action string, call func(string) error) error {
+	err := call(unit)
+	if err != nil {
+		return fmt.Errorf("unable to %s %s: %w", action, unit, err)
+	}
+	return nil
+}
+
+// reload reloads the unit files over D-Bus.
+func reload() error {
+	err := System.Reload()
+	if err != nil {
+		return fmt.Errorf("unable to reload the unit files: %w", err)
+	}
+	return nil
+}
+
+// writeUnitFile renders the template with the data and writes it to the path, creating its directory.
+func writeUnitFile(path string, text string, data map[string]string) error {
+	tmpl, err := template.New(filepath.Base(path)).Parse(text)
+	if err != nil {
+		return err
+	}
+	var content bytes.Buffer
+	err = tmpl.Execute(&content, data)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, content.Bytes(), 0644)
+}
@@ -0,0 +1,58 @@
+package linux
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/godbus/dbus/v5"
+)
+
+func Test_Instance(t *testing.T) {
+	bus := &fakeBus{unit: "nodekit-algod@testnode.service", properties: map[string]any{"UnitFileState": "enabled"}}
+	defer func(previous Systemd, dir string) { System, UnitDir = previous, dir }(System, UnitDir)
+	System = Systemd{Bus: bus}
+	UnitDir = t.TempDir()
+
+	instance := Instance{Name: "testnode", DataDir: "/var/lib/algorand-testnode"}
+	if instance.IsService() {
+		t.Error("expected an unregistered instance")
+	}
+	err := instance.UpdateService(instance.DataDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dropIn, err := os.ReadFile(filepath.Join(UnitDir, "nodekit-algod@testnode.service.d", "override.conf"))
+	if err != nil || !strings.Contains(string(dropIn), "ALGORAND_DATA=/var/lib/algorand-testnode") {
+		t.Errorf("expected the data directory in the drop-in, got %s: %v", dropIn, err)
+	}
+	if !instance.IsService() || !instance.IsServiceEnabled() {
+		t.Error("expected a registered and enabled instance")
+	}
+
+	for _, call := range []func() error{instance.Start, instance.Restart, instance.Stop} {
+		err = call()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	expected := []string{
+		"Reload",
+		"GetUnitFileState nodekit-algod@testnode.service",
+		"StartUnit nodekit-algod@testnode.service replace",
+		"RestartUnit nodekit-algod@testnode.service replace",
+		"StopUnit nodekit-algod@testnode.service replace",
+	}
+	if strings.Join(bus.calls, ",") != strings.Join(expected, ",") {
+		t.Errorf("unexpected calls %v", bus.calls)
+	}
+
+	// A user who may not manage the unit gets an error instead of a sudo prompt
+	bus.err = busError(dbus.Error{Name: "org.freedesktop.DBus.Error.AccessDenied", Body: []any{"Access denied"}})
+	err = instance.Start()
+	if !errors.Is(err, ErrAccessDenied) {
+		t.Errorf("expected access denied, got %v", err)
+	}
+}
@@ -2,30 +2,76 @@ package linux
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"os/user"
+	"path/filepath"
 	"runtime"
+	"sort"
 	"strconv"
 	"strings"
 	"text/template"
 
 	"github.com/algorandfoundation/nodekit/internal/algod/fallback"
+	"github.com/algorandfoundation/nodekit/internal/algod/utils"
 	"github.com/algorandfoundation/nodekit/internal/system"
 	"github.com/charmbracelet/log"
 )
 
+// DefaultDataDir is the data directory of the algorand.service unit installed by the packages.
+const DefaultDataDir = "/var/lib/algorand"
+
 // PackageManagerNotFoundMsg is an error message indicating the absence of a supported package manager for uninstalling Algorand.
 const PackageManagerNotFoundMsg = "could not find a package manager to uninstall Algorand"
 
-// Algod represents an implementation of the system.Interface tailored for managing the Algod service.
-// It includes details about the service's executable path and associated data directory.
-type Algod struct {
-	system.Interface
-	Path              string
-	DataDirectoryPath string
-}
+// Algod manages the algorand.service systemd unit, it implements system.Interface.
+type Algod struct{}
+
+var _ system.Interface = Algod{}
+
+// IsInstalled checks if the algod binary is available.
+func (Algod) IsInstalled() bool { return system.CmdExists("algod") }
+
+// IsRunning checks the algod PID file of the resolved data directory.
+func (Algod) IsRunning(dataDir string) bool { return utils.IsPidRunning(dataDir) }
+
+// IsService checks if the algorand.service unit file exists.
+func (Algod) IsService() bool { return IsService() }
+
+// IsServiceEnabled checks if the algorand.service unit starts with the system.
+func (Algod) IsServiceEnabled() bool { return IsServiceEnabled() }
+
+// ServiceStatus returns the state of the algorand.service unit.
+func (Algod) ServiceStatus() (system.ServiceStatus, error) { return GetServiceStatus() }
+
+// SetNetwork is not supported, the package installs a single network.
+func (Algod) SetNetwork(string) error { return system.ErrUnsupported }
+
+// Install installs the algod release with the package manager.
+func (Algod) Install(release system.Release) error { return Install(release) }
+
+// Update moves algod to the release with the package manager.
+func (Algod) Update(release system.Release) error { return Upgrade(release) }
+
+// Uninstall removes algod with the package manager, force has no effect.
+func (Algod) Uninstall(bool) error { return Uninstall() }
+
+// Start starts the algorand.service unit.
+func (Algod) Start() error { return Start() }
+
+// Stop stops the algorand.service unit.
+func (Algod) Stop() error { return Stop() }
+
+// Restart restarts the algorand.service unit.
+func (Algod) Restart() error { return Restart() }
+
+// UpdateService points the algorand.service unit to the data directory.
+func (Algod) UpdateService(dataDirectoryPath string) error { return UpdateService(dataDirectoryPath) }
+
+// EnsureService is not supported, the package creates the unit.
+func (Algod) EnsureService() error { return system.ErrUnsupported }
 
 func hasConflictingUser() bool {
 	if runtime.GOOS != "linux" {
@@ -63,7 +109,8 @@ func InstallRequirements() system.CmdsList {
 }
 
 // Install installs Algorand development tools or node software depending on the package manager.
-func Install() error {
+// The release selects the channel of the package repository and pins the package when it has a Version.
+func Install(release system.Release) error {
 	if hasConflictingUser() {
 		return fmt.Errorf("Your system has a user called \"algorand\". The algorand node requires the \"algorand\" username for internal usage. Rename or remove the algorand user to continue.")
 	}
@@ -73,27 +120,27 @@ func Install() error {
 	// Based off of https://developer.algorand.org/docs/run-a-node/setup/install/#installation-with-a-package-manager
 	if system.CmdExists("apt-get") { // On some Debian systems we use apt-get
 		log.Info("Installing with apt-get")
-		return system.RunAll(append(InstallRequirements(), system.CmdsList{
+		return system.RunAll(append(append(InstallRequirements(), system.CmdsList{
 			{"sudo", "apt-get", "update"},
 			{"sudo", "apt-get", "install", "-y", "gnupg2", "curl"},
 			{"sh", "-c", "curl -sL https://releases.algorand.com/key.pub | sudo gpg --dearmor -o /usr/share/keyrings/algorand-keyring.gpg"},
 			{"sh", "-c", fmt.Sprintf(
-				"echo 'deb [arch=%s signed-by=/usr/share/keyrings/algorand-keyring.gpg] https://releases.algorand.com/deb/ stable main' | sudo tee /etc/apt/sources.list.d/algorand.list",
-				runtime.GOARCH,
+				"echo 'deb [arch=%s signed-by=/usr/share/keyrings/algorand-keyring.gpg] https://releases.algorand.com/deb/ %s main' | sudo tee /etc/apt/sources.list.d/algorand.list",
+				runtime.GOARCH, release.GetChannel(),
 			)},
-			{"sudo", "apt-get", "update"},
-			{"sudo", "apt-get", "install", "-y", "algorand"},
-		}...))
+		}...), AptCmds(release, false)...))
 	}
 
 	if system.CmdExists("dnf") { // On Fedora and CentOs8 there's the dnf package manager
 		log.Printf("Installing with dnf")
-		return system.RunAll(append(InstallRequirements(), system.CmdsList{
+		cmds := append(InstallRequirements(), system.CmdsList{
 			{"curl", "-O", "https://releases.algorand.com/rpm/rpm_algorand.pub"},
 			{"sudo", "rpmkeys", "--import", "rpm_algorand.pub"},
 			{"sudo", "dnf", "install", "-y", "dnf-command(config-manager)"},
-			{"sudo", "dnf", "config-manager", "--add-repo=https://releases.algorand.com/rpm/stable/algorand.repo"},
-			{"sudo", "dnf", "install", "-y", "algorand"},
+			{"sudo", "dnf", "config-manager", fmt.Sprintf("--add-repo=https://releases.algorand.com/rpm/%s/algorand.repo", release.GetChannel())},
+		}...)
+		cmds = append(cmds, DnfCmds(release, false)...)
+		return system.RunAll(append(cmds, system.CmdsList{
 			{"sudo", "systemctl", "enable", "algorand.service"},
 			{"sudo", "systemctl", "start", "algorand.service"},
 			{"rm", "-f", "rpm_algorand.pub"},
@@ -102,7 +149,60 @@ func Install() error {
 	}
 
 	// TODO: watch this method to see if it is ever used
-	return fallback.Install()
+	return fallback.Install(release)
+}
+
+// PackageName returns the package of the release channel, the beta channel has its own package.
+func PackageName(release system.Release) string {
+	if release.GetChannel() == "beta" {
+		return "algorand-beta"
+	}
+	return "algorand"
+}
+
+// AptCmds installs, upgrades or downgrades the package with apt-get, without a Version an upgrade only upgrades
+// an installed package. A release with a Version is held at that version so apt-get upgrade leaves it alone.
+func AptCmds(release system.Release, upgrade bool) system.CmdsList {
+	pkg := PackageName(release)
+	cmds := system.CmdsList{
+		{"sudo", "apt-get", "update"},
+		{"sudo", "apt-mark", "unhold", pkg},
+	}
+	if release.Version == "" && upgrade {
+		return append(cmds, []string{"sudo", "apt-get", "install", "--only-upgrade", "-y", pkg})
+	}
+	if release.Version == "" {
+		return append(cmds, []string{"sudo", "apt-get", "install", "-y", pkg})
+	}
+	install := []string{"sudo", "apt-get", "install", "-y"}
+	if release.Downgrade {
+		install = append(install, "--allow-downgrades")
+	}
+	return append(cmds, append(install, fmt.Sprintf("%s=%s*", pkg, release.Version)), []string{"sudo", "apt-mark", "hold", pkg})
+}
+
+// DnfCmds installs, upgrades or downgrades the package with dnf, without a Version an upgrade only updates
+// an installed package. A release with a Version is locked with the versionlock plugin so dnf update leaves it alone.
+func DnfCmds(release system.Release, upgrade bool) system.CmdsList {
+	pkg := PackageName(release)
+	cmds := system.CmdsList{
+		{"sh", "-c", fmt.Sprintf("sudo dnf versionlock delete %s || true", pkg)},
+	}
+	if release.Version == "" && upgrade {
+		return append(cmds, []string{"sudo", "dnf", "update", "-y", "--refresh", pkg})
+	}
+	if release.Version == "" {
+		return append(cmds, []string{"sudo", "dnf", "install", "-y", pkg})
+	}
+	action := "install"
+	if release.Downgrade {
+		action = "downgrade"
+	}
+	return append(cmds,
+		[]string{"sudo", "dnf", action, "-y", "--refresh", fmt.Sprintf("%s-%s", pkg, release.Version)},
+		[]string{"sudo", "dnf", "install", "-y", "dnf-command(versionlock)"},
+		[]string{"sudo", "dnf", "versionlock", "add", pkg},
+	)
 }
 
 // Uninstall removes the Algorand software using a supported package manager or clears related system files if necessary.
@@ -113,16 +213,12 @@ func Uninstall() error {
 	// On Ubuntu and Debian there's the apt package manager
 	if system.CmdExists("apt-get") {
 		log.Info("Using apt-get package manager")
-		unInstallCmds = [][]string{
-			{"sudo", "apt-get", "autoremove", "algorand", "-y"},
-		}
+		unInstallCmds = AptRemoveCmds(installedPackages("dpkg-query", "-W", "-f=${Status}"))
 	}
 	// On Fedora and CentOs8 there's the dnf package manager
 	if system.CmdExists("dnf") {
 		log.Info("Using dnf package manager")
-		unInstallCmds = [][]string{
-			{"sudo", "dnf", "remove", "algorand", "-y"},
-		}
+		unInstallCmds = DnfRemoveCmds(installedPackages("rpm", "-q"))
 	}
 	// Error on unsupported package managers
 	if len(unInstallCmds) == 0 {
@@ -136,49 +232,178 @@ func Uninstall() error {
 	return system.RunAll(unInstallCmds)
 }
 
-// Upgrade updates Algorand and its dev tools using an approved package
+// installedPackages returns the packages of both channels the query reports as installed,
+// the stable package when it finds none.
+func installedPackages(query ...string) []string {
+	var pkgs []string
+	for _, channel := range []string{"stable", "beta"} {
+		pkg := PackageName(system.Release{Channel: channel})
+		out, err := system.Run(append(query, pkg))
+		if err == nil && !strings.Contains(out, "not-installed") && !strings.Contains(out, "deinstall") {
+			pkgs = append(pkgs, pkg)
+		}
+	}
+	if len(pkgs) == 0 {
+		pkgs = append(pkgs, PackageName(system.Release{}))
+	}
+	return pkgs
+}
+
+// AptRemoveCmds removes the packages with apt-get, releasing the hold of a pinned version first.
+func AptRemoveCmds(pkgs []string) system.CmdsList {
+	var cmds system.CmdsList
+	for _, pkg := range pkgs {
+		cmds = append(cmds, []string{"sudo", "apt-mark", "unhold", pkg})
+	}
+	return append(cmds, append([]string{"sudo", "apt-get", "autoremove", "-y"}, pkgs...))
+}
+
+// DnfRemoveCmds removes the packages with dnf, deleting the versionlock of a pinned version first.
+func DnfRemoveCmds(pkgs []string) system.CmdsList {
+	var cmds system.CmdsList
+	for _, pkg := range pkgs {
+		cmds = append(cmds, []string{"sh", "-c", fmt.Sprintf("sudo dnf versionlock delete %s || true", pkg)})
+	}
+	return append(cmds, append([]string{"sudo", "dnf", "remove", "-y"}, pkgs...))
+}
+
+// Upgrade moves Algorand to the release using an approved package
 // manager if available, otherwise returns an error.
-func Upgrade() error {
+func Upgrade(release system.Release) error {
 	if system.CmdExists("apt-get") {
-		return system.RunAll(system.CmdsList{
-			{"sudo", "apt-get", "update"},
-			{"sudo", "apt-get", "install", "--only-upgrade", "-y", "algorand"},
-		})
+		return system.RunAll(AptCmds(release, true))
 	}
 	if system.CmdExists("dnf") {
-		return system.RunAll(system.CmdsList{
-			{"sudo", "dnf", "update", "-y", "--refresh", "algorand"},
-		})
+		return system.RunAll(DnfCmds(release, true))
 	}
 	return fmt.Errorf("the *node upgrade* command is currently only available for installations done with an approved package manager. Please use a different method to upgrade")
 }
 
 // Start attempts to start the Algorand service using the system's service manager.
-// It executes the appropriate command for systemd on Linux-based systems.
+// It queues a start job over D-Bus, a user who may not manage the service gets ErrAccessDenied.
 // Returns an error if the command fails.
-// TODO: Replace with D-Bus integration
 func Start() error {
-	return exec.Command("sudo", "systemctl", "start", "algorand").Run()
+	err := System.Start(ServiceName)
+	if err != nil {
+		return fmt.Errorf("unable to start %s: %w", ServiceName, err)
+	}
+	return nil
 }
 
-// Stop shuts down the Algorand algod system process on Linux using the systemctl stop command.
-// Returns an error if the operation fails.
-// TODO: Replace with D-Bus integration
+// Stop shuts down the Algorand algod system process on Linux over D-Bus.
+// Returns an error if the operation fails, ErrAccessDenied when the user may not manage the service.
 func Stop() error {
-	return exec.Command("sudo", "systemctl", "stop", "algorand").Run()
+	err := System.Stop(ServiceName)
+	if err != nil {
+		return fmt.Errorf("unable to stop %s: %w", ServiceName, err)
+	}
+	return nil
 }
 
-// IsService checks if the "algorand.service" is listed as a systemd unit file on Linux.
+// Restart restarts the Algorand service over D-Bus, the service is started when it was not running.
+// Returns ErrAccessDenied when the user may not manage the service.
+func Restart() error {
+	err := System.Restart(ServiceName)
+	if err != nil {
+		return fmt.Errorf("unable to restart %s: %w", ServiceName, err)
+	}
+	return nil
+}
+
+// IsService checks if the "algorand.service" unit file exists on Linux.
 // Returns true if it exists.
-// TODO: Replace with D-Bus integration
 func IsService() bool {
-	out, err := system.Run([]string{"sudo", "systemctl", "list-unit-files", "algorand.service"})
+	_, err := System.UnitFileState(ServiceName)
+	if err == nil || errors.Is(err, ErrNoUnit) {
+		return err == nil
+	}
+	out, err := system.Run([]string{"systemctl", "list-unit-files", "algorand.service"})
 	if err != nil {
 		return false
 	}
 	return strings.Contains(out, "algorand.service")
 }
 
+// IsServiceEnabled checks if the algorand.service unit starts with the system.
+func IsServiceEnabled() bool {
+	state, err := System.UnitFileState(ServiceName)
+	if err == nil || errors.Is(err, ErrNoUnit) {
+		return state == "enabled"
+	}
+	out, err := system.Run([]string{"systemctl", "is-enabled", "algorand.service"})
+	return err == nil && strings.TrimSpace(out) == "enabled"
+}
+
+// GetServiceStatus returns the state, exit code, restarts and recent journal lines of the algorand.service unit.
+func GetServiceStatus() (system.ServiceStatus, error) {
+	return System.Status(ServiceName)
+}
+
+// ServiceOverridePath is the override of the algorand.service unit written by UpdateService.
+// Assuming that this is the same everywhere systemd is used
+var ServiceOverridePath = "/etc/systemd/system/algorand.service.d/override.conf"
+
+// ServiceDataDir returns the data directory of the algorand.service unit,
+// set by the override of UpdateService or the package default.
+func ServiceDataDir() (string, error) {
+	content, err := os.ReadFile(ServiceOverridePath)
+	if os.IsNotExist(err) {
+		return DefaultDataDir, nil
+	}
+	if err != nil {
+		return "", err
+	}
+	for _, line := range strings.Split(string(content), "\n") {
+		_, dataDir, found := strings.Cut(line, " -d ")
+		if strings.HasPrefix(line, "ExecStart=") && found {
+			return strings.TrimSpace(dataDir), nil
+		}
+	}
+	return DefaultDataDir, nil
+}
+
+// ServiceSettingsPath is the drop-in of the algorand.service unit written by UpdateServiceSettings.
+// It is kept apart from the override of UpdateService, which owns ExecStart.
+var ServiceSettingsPath = "/etc/systemd/system/algorand.service.d/nodekit.conf"
+
+// RenderServiceSettings returns the drop-in with the [Service] settings, sorted by name.
+func RenderServiceSettings(settings map[string]string) []byte {
+	names := make([]string, 0, len(settings))
+	for name := range settings {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var content bytes.Buffer
+	content.WriteString("# Written by nodekit, changes are overwritten by nodekit apply\n[Service]\n")
+	for _, name := range names {
+		fmt.Fprintf(&content, "%s=%s\n", name, settings[name])
+	}
+	return content.Bytes()
+}
+
+// ReadServiceSettings returns the drop-in written by UpdateServiceSettings, nil when there is none.
+func ReadServiceSettings() ([]byte, error) {
+	content, err := os.ReadFile(ServiceSettingsPath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	return content, err
+}
+
+// UpdateServiceSettings writes the [Service] settings to the drop-in of the algorand.service unit and reloads systemd.
+// The service must be restarted to use them.
+func UpdateServiceSettings(settings map[string]string) error {
+	err := os.MkdirAll(filepath.Dir(ServiceSettingsPath), 0755)
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(ServiceSettingsPath, RenderServiceSettings(settings), 0644)
+	if err != nil {
+		return err
+	}
+	return System.Reload()
+}
+
 // UpdateService updates the systemd service file for the Algorand daemon
 // with a new data directory path and reloads the daemon.
 func UpdateService(dataDirectoryPath string) error {
@@ -190,11 +415,10 @@ func UpdateService(dataDirectoryPath string) error {
 	}
 
 	// Path to the systemd service override file
-	// Assuming that this is the same everywhere systemd is used
-	overrideFilePath := "/etc/systemd/system/algorand.service.d/override.conf"
+	overrideFilePath := ServiceOverridePath
 
 	// Create the override directory if it doesn't exist
-	err = os.MkdirAll("/etc/systemd/system/algorand.service.d", 0755)
+	err = os.MkdirAll(filepath.Dir(overrideFilePath), 0755)
 	if err != nil {
 		fmt.Printf("Failed to create override directory: %v\n", err)
 		os.Exit(1)
@@ -235,8 +459,11 @@ ExecStart={{.AlgodPath}} -d {{.DataDirectoryPath}}`
 	}
 
 	// Reload systemd manager configuration
-	cmd := exec.Command("systemctl", "daemon-reload")
-	err = cmd.Run()
+	err = System.Reload()
+	if err != nil {
+		log.Debug(fmt.Sprintf("D-Bus reload failed, using systemctl: %s", err))
+		err = exec.Command("systemctl", "daemon-reload").Run()
+	}
 	if err != nil {
 		fmt.Printf("Failed to reload systemd daemon: %v\n", err)
 		os.Exit(1)
@@ -0,0 +1,113 @@
+package linux
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/algorandfoundation/nodekit/internal/system"
+)
+
+// joinCmds formats the commands one per line
+func joinCmds(cmds system.CmdsList) string {
+	lines := make([]string, len(cmds))
+	for i, cmd := range cmds {
+		lines[i] = strings.Join(cmd, " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+func Test_PackageCmds(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		cmds     system.CmdsList
+		expected string
+	}{
+		{
+			name:     "apt upgrade",
+			cmds:     AptCmds(system.Release{}, true),
+			expected: "sudo apt-get update\nsudo apt-mark unhold algorand\nsudo apt-get install --only-upgrade -y algorand",
+		},
+		{
+			name:     "apt beta install",
+			cmds:     AptCmds(system.Release{Channel: "beta"}, false),
+			expected: "sudo apt-get update\nsudo apt-mark unhold algorand-beta\nsudo apt-get install -y algorand-beta",
+		},
+		{
+			name:     "apt downgrade",
+			cmds:     AptCmds(system.Release{Version: "3.25.0", Downgrade: true}, true),
+			expected: "sudo apt-get update\nsudo apt-mark unhold algorand\nsudo apt-get install -y --allow-downgrades algorand=3.25.0*\nsudo apt-mark hold algorand",
+		},
+		{
+			name:     "dnf upgrade",
+			cmds:     DnfCmds(system.Release{}, true),
+			expected: "sh -c sudo dnf versionlock delete algorand || true\nsudo dnf update -y --refresh algorand",
+		},
+		{
+			name: "dnf pinned",
+			cmds: DnfCmds(system.Release{Version: "3.26.0"}, false),
+			expected: "sh -c sudo dnf versionlock delete algorand || true\nsudo dnf install -y --refresh algorand-3.26.0\n" +
+				"sudo dnf install -y dnf-command(versionlock)\nsudo dnf versionlock add algorand",
+		},
+		{
+			name:     "apt remove",
+			cmds:     AptRemoveCmds([]string{"algorand", "algorand-beta"}),
+			expected: "sudo apt-mark unhold algorand\nsudo apt-mark unhold algorand-beta\nsudo apt-get autoremove -y algorand algorand-beta",
+		},
+		{
+			name:     "dnf remove",
+			cmds:     DnfRemoveCmds([]string{"algorand-beta"}),
+			expected: "sh -c sudo dnf versionlock delete algorand-beta || true\nsudo dnf remove -y algorand-beta",
+		},
+		{
+			name:     "dnf downgrade",
+			cmds:     DnfCmds(system.Release{Version: "3.25.0", Downgrade: true}, true)[1:2],
+			expected: "sudo dnf downgrade -y --refresh algorand-3.25.0",
+		},
+	} {
+		if joinCmds(tc.cmds) != tc.expected {
+			t.Errorf("%s: unexpected commands\n%s", tc.name, joinCmds(tc.cmds))
+		}
+	}
+}
+
+func Test_ServiceDataDir(t *testing.T) {
+	defer func(previous string) { ServiceOverridePath = previous }(ServiceOverridePath)
+	ServiceOverridePath = filepath.Join(t.TempDir(), "override.conf")
+
+	dataDir, err := ServiceDataDir()
+	if err != nil || dataDir != DefaultDataDir {
+		t.Errorf("expected the default data directory, got %s: %v", dataDir, err)
+	}
+	err = os.WriteFile(ServiceOverridePath, []byte("[Service]\nExecStart=\nExecStart=/usr/bin/algod -d /srv/algorand\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dataDir, err = ServiceDataDir()
+	if err != nil || dataDir != "/srv/algorand" {
+		t.Errorf("expected the data directory of the override, got %s: %v", dataDir, err)
+	}
+}
+
+func Test_ServiceSettings(t *testing.T) {
+	defer func(previous string) { ServiceSettingsPath = previous }(ServiceSettingsPath)
+	ServiceSettingsPath = filepath.Join(t.TempDir(), "nodekit.conf")
+
+	content, err := ReadServiceSettings()
+	if err != nil || content != nil {
+		t.Errorf("expected no drop-in, got %q: %v", content, err)
+	}
+	rendered := string(RenderServiceSettings(map[string]string{"Nice": "-5", "LimitNOFILE": "65536"}))
+	if !strings.HasSuffix(rendered, "[Service]\nLimitNOFILE=65536\nNice=-5\n") {
+		t.Errorf("unexpected drop-in %q", rendered)
+	}
+	err = os.WriteFile(ServiceSettingsPath, []byte(rendered), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, err = ReadServiceSettings()
+	if err != nil || string(content) != rendered {
+		t.Errorf("expected the drop-in, got %q: %v", content, err)
+	}
+}
@@ -0,0 +1,157 @@
+package mac
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/internal/algod/utils"
+	"github.com/algorandfoundation/nodekit/internal/system"
+	"github.com/charmbracelet/log"
+)
+
+// LaunchDaemonsDir is the directory the launchd plists of the instances are written to.
+var LaunchDaemonsDir = "/Library/LaunchDaemons"
+
+// instancePlist runs algod for the data directory of an instance, the logs are written to /tmp/algod-<name>.
+const instancePlist = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>Label</key>
+	<string>%s</string>
+	<key>ProgramArguments</key>
+	<array>
+			<string>%s</string>
+			<string>-d</string>
+			<string>%s</string>
+	</array>
+	<key>RunAtLoad</key>
+	<true/>
+	<key>StandardOutPath</key>
+	<string>/tmp/algod-%s.out</string>
+	<key>StandardErrorPath</key>
+	<string>/tmp/algod-%s.err</string>
+</dict>
+</plist>`
+
+// Instance manages a named algod instance with its own launchd service, it implements system.Interface.
+// The algod binary is shared with the com.algorand.algod service, each instance has its own data directory.
+type Instance struct {
+	Name    string
+	DataDir string
+}
+
+var _ system.Interface = Instance{}
+
+// Label returns the launchd label of the instance.
+func (i Instance) Label() string {
+	return "com.algorand.algod." + i.Name
+}
+
+// plistPath returns the path of the launchd plist of the instance.
+func (i Instance) plistPath() string {
+	return filepath.Join(LaunchDaemonsDir, i.Label()+".plist")
+}
+
+// IsInstalled checks if the algod binary is available.
+func (i Instance) IsInstalled() bool { return system.CmdExists("algod") }
+
+// IsRunning checks the algod PID file of the resolved data directory.
+func (i Instance) IsRunning(dataDir string) bool { return utils.IsPidRunning(dataDir) }
+
+// IsService checks if the launchd service of the instance is loaded.
+func (i Instance) IsService() bool {
+	_, err := system.Run([]string{"sudo", "launchctl", "list", i.Label()})
+	return err == nil
+}
+
+// IsServiceEnabled is the same as IsService, the launchd service always runs at load.
+func (i Instance) IsServiceEnabled() bool { return i.IsService() }
+
+// ServiceStatus is not supported by launchd.
+func (i Instance) ServiceStatus() (system.ServiceStatus, error) {
+	return system.ServiceStatus{}, system.ErrUnsupported
+}
+
+// SetNetwork is not supported, the network is chosen when the instance is added.
+func (i Instance) SetNetwork(string) error { return system.ErrUnsupported }
+
+// Install registers the instance with launchd, algod must be installed already.
+// The release has no effect, the algod binary is shared by the instances.
+func (i Instance) Install(system.Release) error {
+	if !i.IsInstalled() {
+		return system.ErrNotInstalled
+	}
+	return i.EnsureService()
+}
+
+// Update upgrades the shared algod binary with Homebrew.
+func (i Instance) Update(release system.Release) error { return Upgrade(release) }
+
+// Uninstall unloads and removes the launchd service of the instance, the data directory and the algod binary are kept.
+func (i Instance) Uninstall(bool) error {
+	log.Info("Removing instance " + i.Name)
+	err := system.RunAll(system.CmdsList{
+		{"sudo", "launchctl", "unload", "-w", i.plistPath()},
+	})
+	if err != nil {
+		return err
+	}
+	return os.Remove(i.plistPath())
+}
+
+// Start starts the launchd service of the instance.
+func (i Instance) Start() error {
+	return system.RunAll(system.CmdsList{{"sudo", "launchctl", "start", i.Label()}})
+}
+
+// Stop stops the launchd service of the instance.
+func (i Instance) Stop() error {
+	return system.RunAll(system.CmdsList{{"sudo", "launchctl", "stop", i.Label()}})
+}
+
+// Restart stops and starts the launchd service of the instance.
+func (i Instance) Restart() error {
+	err := i.Stop()
+	if err != nil {
+		return err
+	}
+	// See Restart, launchctl reports a false start when called too quickly
+	time.Sleep(1 * time.Second)
+	return i.Start()
+}
+
+// UpdateService points the instance to another data directory and reloads its launchd service.
+func (i Instance) UpdateService(dataDirectoryPath string) error {
+	i.DataDir = dataDirectoryPath
+	if i.IsService() {
+		err := system.RunAll(system.CmdsList{{"sudo", "launchctl", "unload", i.plistPath()}})
+		if err != nil {
+			return err
+		}
+	}
+	return i.EnsureService()
+}
+
+// EnsureService writes the launchd plist of the instance and loads it.
+func (i Instance) EnsureService() error {
+	path, err := exec.LookPath("algod")
+	if err != nil {
+		return err
+	}
+	plist := fmt.Sprintf(instancePlist, i.Label(), path, i.DataDir, i.Name, i.Name)
+	err = os.MkdirAll(LaunchDaemonsDir, 0755)
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(i.plistPath(), []byte(plist), 0644)
+	if err != nil {
+		return err
+	}
+	return system.RunAll(system.CmdsList{
+		{"sudo", "launchctl", "load", "-w", i.plistPath()},
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 	"strings"
 	"text/template"
+	"time"
 
 	"github.com/algorandfoundation/nodekit/internal/algod/utils"
 	"github.com/algorandfoundation/nodekit/internal/system"
@@ -24,6 +25,55 @@ const MustBeServiceMsg = "service must be installed to be able to manage it"
 // HomeBrewNotFoundMsg is the error message returned when Homebrew is not detected on the system during execution.
 const HomeBrewNotFoundMsg = "brew not found. please go to https://brew.sh to install Homebrew before trying again"
 
+// Algod manages the com.algorand.algod launchd service, it implements system.Interface.
+type Algod struct{}
+
+var _ system.Interface = Algod{}
+
+// IsInstalled checks if the algod binary is available.
+func (Algod) IsInstalled() bool { return system.CmdExists("algod") }
+
+// IsRunning checks the algod PID file of the resolved data directory.
+func (Algod) IsRunning(dataDir string) bool { return utils.IsPidRunning(dataDir) }
+
+// IsService checks if the launchd service is loaded.
+func (Algod) IsService() bool { return IsService() }
+
+// IsServiceEnabled is the same as IsService, the launchd service always runs at load.
+func (Algod) IsServiceEnabled() bool { return IsService() }
+
+// ServiceStatus is not supported by launchd.
+func (Algod) ServiceStatus() (system.ServiceStatus, error) {
+	return system.ServiceStatus{}, system.ErrUnsupported
+}
+
+// SetNetwork is not supported.
+func (Algod) SetNetwork(string) error { return system.ErrUnsupported }
+
+// Install installs algod with Homebrew and creates the launchd service.
+func (Algod) Install(release system.Release) error { return Install(release) }
+
+// Update upgrades algod with Homebrew.
+func (Algod) Update(release system.Release) error { return Upgrade(release) }
+
+// Uninstall removes algod and the launchd service.
+func (Algod) Uninstall(force bool) error { return Uninstall(force) }
+
+// Start starts the launchd service.
+func (Algod) Start() error { return Start(false) }
+
+// Stop stops the launchd service.
+func (Algod) Stop() error { return Stop(false) }
+
+// Restart stops and starts the launchd service.
+func (Algod) Restart() error { return Restart(false) }
+
+// UpdateService points the launchd service to the data directory.
+func (Algod) UpdateService(dataDirectoryPath string) error { return UpdateService(dataDirectoryPath) }
+
+// EnsureService creates and loads the launchd service.
+func (Algod) EnsureService() error { return EnsureService() }
+
 // IsService check if Algorand service has been created with launchd (macOS)
 // Note that it needs to be run in super-user privilege mode to
 // be able to view the root level services.
@@ -32,18 +82,33 @@ func IsService() bool {
 	return err == nil
 }
 
+// ReleaseNotSupportedMsg is the error message returned for a pinned version or the beta channel, Homebrew only has the latest stable release.
+const ReleaseNotSupportedMsg = "homebrew only provides the latest stable release, --version and --channel require apt-get or dnf"
+
+// checkRelease rejects the releases Homebrew does not provide.
+func checkRelease(release system.Release) error {
+	if release.Version != "" || release.GetChannel() != "stable" {
+		return errors.New(ReleaseNotSupportedMsg)
+	}
+	return nil
+}
+
 // Install sets up Algod on macOS using Homebrew,
 // configures necessary directories, and ensures it
 // runs as a background service.
-func Install() error {
+func Install(release system.Release) error {
 	log.Info("Installing Algod on macOS")
 
 	// Homebrew is our package manager of choice
 	if !system.CmdExists("brew") {
 		return errors.New(HomeBrewNotFoundMsg)
 	}
+	err := checkRelease(release)
+	if err != nil {
+		return err
+	}
 
-	err := system.RunAll(system.CmdsList{
+	err = system.RunAll(system.CmdsList{
 		{"brew", "tap", "algorandfoundation/homebrew-node"},
 		{"brew", "install", "algorand"},
 		{"brew", "--prefix", "algorand", "--installed"},
@@ -110,11 +175,15 @@ func Uninstall(force bool) error {
 }
 
 // Upgrade updates the installed Algorand package using Homebrew if it's available and properly configured.
-func Upgrade(force bool) error {
+func Upgrade(release system.Release) error {
 	if !system.CmdExists("brew") {
 		return errors.New("homebrew is not installed")
 	}
-	err := system.RunAll(system.CmdsList{
+	err := checkRelease(release)
+	if err != nil {
+		return err
+	}
+	err = system.RunAll(system.CmdsList{
 		{"brew", "--prefix", "algorand", "--installed"},
 		{"brew", "update"},
 		{"brew", "upgrade", "algorand", "--formula"},
@@ -152,6 +221,18 @@ func Stop(force bool) error {
 	})
 }
 
+// Restart stops and starts the Algorand service with launchd.
+func Restart(force bool) error {
+	err := Stop(force)
+	if err != nil {
+		return err
+	}
+	// Calling stop & start too quickly on Mac (launchctl) appears to
+	// result in a false successfully start. Haven't investigated why.
+	time.Sleep(1 * time.Second)
+	return Start(force)
+}
+
 // UpdateService updates the Algorand launchd service with
 // a new data directory path and reloads the service configuration.
 // TODO: Deduplicate this method, redundant version of EnsureService.
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/internal/algod/config"
 )
 
 // Metrics represents runtime and performance metrics,
@@ -85,6 +86,31 @@ type Metrics struct {
 	HttpPkg api.HttpPkgInterface
 }
 
+// IsNetworkMismatch checks the observed WS and P2P traffic against the P2P mode of the algod config.
+// Hybrid mode expects both, P2P mode only P2P traffic and the default only WS traffic.
+// It is false until the node reports some traffic.
+func IsNetworkMismatch(cfg *config.Config, metrics Metrics) bool {
+	isP2PHybridEnabled := cfg != nil && cfg.EnableP2PHybridMode != nil && *cfg.EnableP2PHybridMode
+	isP2PEnabled := cfg != nil && cfg.EnableP2P != nil && *cfg.EnableP2P && !isP2PHybridEnabled
+
+	hasWSData := metrics.TX != 0 || metrics.RX != 0
+	hasP2PData := metrics.TXP2P != 0 || metrics.RXP2P != 0
+	if !hasWSData && !hasP2PData {
+		return false
+	}
+	switch {
+	case isP2PHybridEnabled:
+		// Should be P2P and WS
+		return !hasP2PData || !hasWSData
+	case isP2PEnabled:
+		// Should be ONLY P2P
+		return !hasP2PData || hasWSData
+	default:
+		// Should be ONLY WS
+		return !hasWSData || hasP2PData
+	}
+}
+
 // MetricsResponse represents a mapping of metric names to their integer values.
 type MetricsResponse map[string]uint64
 
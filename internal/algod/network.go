@@ -0,0 +1,35 @@
+package algod
+
+import (
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/internal/algod/utils"
+)
+
+// LoadNetworks registers the custom networks of the NodeKit settings in the api.Registry.
+func LoadNetworks() error {
+	settings, err := utils.GetNodekitSettings()
+	if err != nil {
+		return err
+	}
+	networks := make([]api.Network, 0, len(settings.Networks))
+	for _, n := range settings.Networks {
+		networks = append(networks, api.Network{
+			GenesisID:        n.GenesisID,
+			GenesisHash:      n.GenesisHash,
+			CatchpointURL:    api.CatchPointUrl(n.CatchpointURL),
+			LoraNetwork:      n.LoraNetwork,
+			LaggingThreshold: n.LaggingThreshold,
+		})
+	}
+	api.RegisterNetworks(networks...)
+	return nil
+}
+
+// GetLaggingThreshold returns the lagging threshold of a network, CATCHPOINT_THRESHOLD when it is not set.
+func GetLaggingThreshold(genesisID string, genesisHash string) int {
+	network, ok := api.Registry.Find(genesisID, genesisHash)
+	if !ok || network.LaggingThreshold <= 0 {
+		return CATCHPOINT_THRESHOLD
+	}
+	return network.LaggingThreshold
+}
@@ -0,0 +1,54 @@
+package algod
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/internal/algod/participation"
+	"github.com/algorandfoundation/nodekit/internal/algod/utils"
+)
+
+func Test_LoadNetworks(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	defer func() { api.Registry = api.DefaultNetworks }()
+
+	err := utils.WriteNodekitSettings(utils.Settings{Networks: []utils.NetworkSettings{
+		{GenesisID: "privnet-v1", CatchpointURL: "http://localhost/latest", LoraNetwork: "localnet", LaggingThreshold: 5000},
+		{GenesisID: "mainnet-v1.0", GenesisHash: "AAAA", LoraNetwork: "mainnet-fork"},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = LoadNetworks()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if GetLaggingThreshold("privnet-v1", "") != 5000 {
+		t.Errorf("expected the custom threshold, got %d", GetLaggingThreshold("privnet-v1", ""))
+	}
+	if GetLaggingThreshold("mainnet-v1.0", "") != CATCHPOINT_THRESHOLD {
+		t.Errorf("expected the default threshold, got %d", GetLaggingThreshold("mainnet-v1.0", ""))
+	}
+	if participation.GetLoraNetwork("privnet-v1", "") != "localnet" {
+		t.Errorf("expected the custom lora network, got %s", participation.GetLoraNetwork("privnet-v1", ""))
+	}
+	if participation.GetLoraNetwork("othernet-v1", "") != "othernet" {
+		t.Errorf("expected the genesis ID without version, got %s", participation.GetLoraNetwork("othernet-v1", ""))
+	}
+
+	// The fork shares the genesis ID of mainnet, the genesis hash tells them apart
+	mainnetHash := "wGHE2Pwdvd7S12BL5FaOP20EGYesN73ktiC1qzkkit8="
+	if participation.GetLoraNetwork("mainnet-v1.0", mainnetHash) != "mainnet" || participation.GetLoraNetwork("mainnet-v1.0", "AAAA") != "mainnet-fork" {
+		t.Errorf("expected the lora network of the genesis hash, got %s", participation.GetLoraNetwork("mainnet-v1.0", mainnetHash))
+	}
+	sources, _, err := GetCatchpointSources(nil, "mainnet-v1.0", mainnetHash)
+	if err != nil || len(sources) != 1 || sources[0].Name() != "catchpoint service" {
+		t.Errorf("expected the catchpoint service of mainnet, got %v (%v)", sources, err)
+	}
+	_, _, err = GetCatchpointSources(nil, "mainnet-v1.0", "AAAA")
+	if !errors.Is(err, api.ErrInvalidNetwork) {
+		t.Errorf("expected the fork to have no catchpoint sources, got %v", err)
+	}
+}
@@ -0,0 +1,266 @@
+package participation
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-algorand-sdk/v2/types"
+)
+
+// ErrInvalidKeyFile is returned when a participation key file cannot be read.
+var ErrInvalidKeyFile = errors.New("invalid participation key file")
+
+// keyFileTable is the table of the key file holding the account of the key.
+const keyFileTable = "ParticipationAccount"
+
+// KeyFileAddress returns the account of a participation key file created by `algokey part generate`.
+// The key file is a SQLite database, the account is the parent column of its ParticipationAccount table.
+// The database is read directly since nodekit is built without cgo.
+func KeyFileAddress(content []byte) (string, error) {
+	db, err := openKeyFile(content)
+	if err != nil {
+		return "", err
+	}
+
+	// Find the table in the schema, its columns are type, name, tbl_name, rootpage and sql
+	var root int64
+	err = db.walk(1, func(record []any) bool {
+		if len(record) > 3 && record[0] == "table" && record[1] == keyFileTable {
+			root, _ = record[3].(int64)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return "", err
+	}
+	if root == 0 {
+		return "", fmt.Errorf("%w: missing the %s table", ErrInvalidKeyFile, keyFileTable)
+	}
+
+	var parent []byte
+	err = db.walk(uint32(root), func(record []any) bool {
+		if len(record) > 0 {
+			parent, _ = record[0].([]byte)
+		}
+		return false
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(parent) != len(types.Address{}) {
+		return "", fmt.Errorf("%w: missing the account", ErrInvalidKeyFile)
+	}
+	return types.Address(parent).String(), nil
+}
+
+// keyFile is a read only SQLite database held in memory.
+type keyFile struct {
+	content  []byte
+	pageSize int
+	usable   int
+}
+
+func openKeyFile(content []byte) (*keyFile, error) {
+	if len(content) < 100 || !bytes.HasPrefix(content, []byte("SQLite format 3\x00")) {
+		return nil, ErrInvalidKeyFile
+	}
+	pageSize := int(binary.BigEndian.Uint16(content[16:18]))
+	if pageSize == 1 {
+		pageSize = 65536
+	}
+	if pageSize < 512 || len(content)%pageSize != 0 {
+		return nil, ErrInvalidKeyFile
+	}
+	return &keyFile{content: content, pageSize: pageSize, usable: pageSize - int(content[20])}, nil
+}
+
+// page returns the content of a page, numbered from 1.
+func (db *keyFile) page(number uint32) ([]byte, error) {
+	start := (int(number) - 1) * db.pageSize
+	if number == 0 || start+db.pageSize > len(db.content) {
+		return nil, fmt.Errorf("%w: page %d out of range", ErrInvalidKeyFile, number)
+	}
+	return db.content[start : start+db.pageSize], nil
+}
+
+// walk calls fn with the records of the table b-tree rooted at page, in order, until fn returns false.
+func (db *keyFile) walk(number uint32, fn func(record []any) bool) error {
+	_, err := db.walkPage(number, fn, 0)
+	return err
+}
+
+func (db *keyFile) walkPage(number uint32, fn func(record []any) bool, depth int) (bool, error) {
+	if depth > 20 {
+		return false, fmt.Errorf("%w: b-tree too deep", ErrInvalidKeyFile)
+	}
+	page, err := db.page(number)
+	if err != nil {
+		return false, err
+	}
+	// The first page starts with the database header
+	header := 0
+	if number == 1 {
+		header = 100
+	}
+	if header+12 > len(page) {
+		return false, ErrInvalidKeyFile
+	}
+	kind := page[header]
+	cells := int(binary.BigEndian.Uint16(page[header+3 : header+5]))
+	pointers := header + 8
+	if kind == 0x05 {
+		pointers = header + 12
+	}
+	if pointers+2*cells > len(page) {
+		return false, ErrInvalidKeyFile
+	}
+
+	for i := 0; i < cells; i++ {
+		offset := int(binary.BigEndian.Uint16(page[pointers+2*i:]))
+		if offset+4 > len(page) {
+			return false, ErrInvalidKeyFile
+		}
+		switch kind {
+		// Interior table page, the cell points to the child page holding the smaller rows
+		case 0x05:
+			next, err := db.walkPage(binary.BigEndian.Uint32(page[offset:]), fn, depth+1)
+			if err != nil || !next {
+				return next, err
+			}
+		// Leaf table page, the cell holds a row
+		case 0x0d:
+			payload, err := db.payload(page, offset)
+			if err != nil {
+				return false, err
+			}
+			record, err := decodeRecord(payload)
+			if err != nil {
+				return false, err
+			}
+			if !fn(record) {
+				return false, nil
+			}
+		default:
+			return false, fmt.Errorf("%w: unexpected page type %d", ErrInvalidKeyFile, kind)
+		}
+	}
+	if kind == 0x05 {
+		return db.walkPage(binary.BigEndian.Uint32(page[header+8:]), fn, depth+1)
+	}
+	return true, nil
+}
+
+// payload returns the record of a leaf table cell, following its overflow pages.
+func (db *keyFile) payload(page []byte, offset int) ([]byte, error) {
+	size, n := readVarint(page[offset:])
+	offset += n
+	_, n = readVarint(page[offset:])
+	offset += n
+	if size < 0 || size > int64(len(db.content)) {
+		return nil, ErrInvalidKeyFile
+	}
+
+	// The part of the payload stored on the page, as computed by SQLite
+	total := int(size)
+	local := total
+	if limit := db.usable - 35; total > limit {
+		least := (db.usable-12)*32/255 - 23
+		local = least + (total-least)%(db.usable-4)
+		if local > limit {
+			local = least
+		}
+	}
+	if offset+local > len(page) {
+		return nil, ErrInvalidKeyFile
+	}
+	payload := append([]byte{}, page[offset:offset+local]...)
+	if local == total {
+		return payload, nil
+	}
+
+	if offset+local+4 > len(page) {
+		return nil, ErrInvalidKeyFile
+	}
+	next := binary.BigEndian.Uint32(page[offset+local:])
+	for len(payload) < total {
+		overflow, err := db.page(next)
+		if err != nil {
+			return nil, err
+		}
+		next = binary.BigEndian.Uint32(overflow)
+		payload = append(payload, overflow[4:min(db.usable, 4+total-len(payload))]...)
+	}
+	return payload, nil
+}
+
+// decodeRecord returns the columns of a record, as nil, int64, float64 bits, []byte or string.
+func decodeRecord(payload []byte) ([]any, error) {
+	headerSize, n := readVarint(payload)
+	if headerSize < int64(n) || headerSize > int64(len(payload)) {
+		return nil, ErrInvalidKeyFile
+	}
+	var record []any
+	data := int(headerSize)
+	for offset := n; offset < int(headerSize); {
+		serial, n := readVarint(payload[offset:int(headerSize)])
+		offset += n
+
+		var size int
+		switch {
+		case serial >= 12:
+			size = int(serial-12) / 2
+		case serial >= 1 && serial <= 4:
+			size = int(serial)
+		case serial == 5:
+			size = 6
+		case serial == 6 || serial == 7:
+			size = 8
+		}
+		if data+size > len(payload) {
+			return nil, ErrInvalidKeyFile
+		}
+		value := payload[data : data+size]
+		data += size
+
+		switch {
+		case serial == 0:
+			record = append(record, nil)
+		case serial >= 1 && serial <= 6:
+			// Big-endian two's complement integer
+			v := int64(int8(value[0]))
+			for _, b := range value[1:] {
+				v = v<<8 | int64(b)
+			}
+			record = append(record, v)
+		case serial == 7:
+			record = append(record, binary.BigEndian.Uint64(value))
+		case serial == 8 || serial == 9:
+			record = append(record, serial-8)
+		case serial >= 12 && serial%2 == 0:
+			record = append(record, value)
+		case serial >= 13:
+			record = append(record, string(value))
+		default:
+			return nil, ErrInvalidKeyFile
+		}
+	}
+	return record, nil
+}
+
+// readVarint decodes a SQLite variable length integer, it returns the value and the number of bytes read.
+func readVarint(b []byte) (int64, int) {
+	var v int64
+	for i := 0; i < len(b) && i < 9; i++ {
+		if i == 8 {
+			return v<<8 | int64(b[i]), 9
+		}
+		v = v<<7 | int64(b[i]&0x7f)
+		if b[i]&0x80 == 0 {
+			return v, i + 1
+		}
+	}
+	return v, len(b)
+}
@@ -0,0 +1,40 @@
+package participation
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/algorand/go-algorand-sdk/v2/types"
+)
+
+// testKeyAddress is the account of testdata/test.partkey, its public key is the bytes 0 to 31
+func testKeyAddress() string {
+	var address types.Address
+	for i := range address {
+		address[i] = byte(i)
+	}
+	return address.String()
+}
+
+func Test_KeyFileAddress(t *testing.T) {
+	// The voting keys of the file overflow the page of the row
+	content, err := os.ReadFile("testdata/test.partkey")
+	if err != nil {
+		t.Fatal(err)
+	}
+	address, err := KeyFileAddress(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if address != testKeyAddress() {
+		t.Errorf("expected %s, got %s", testKeyAddress(), address)
+	}
+
+	for _, invalid := range [][]byte{[]byte("partkey"), content[:100], content[:len(content)-1]} {
+		_, err = KeyFileAddress(invalid)
+		if !errors.Is(err, ErrInvalidKeyFile) {
+			t.Errorf("expected an invalid key file, got %v", err)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package participation
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorand/go-algorand-sdk/v2/encoding/msgpack"
+	"github.com/algorand/go-algorand-sdk/v2/transaction"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+	"github.com/algorandfoundation/nodekit/api"
+)
+
+// KeyregType is the kind of key registration transaction.
+type KeyregType string
+
+const (
+	// OnlineKeyreg registers a participation key so the account participates in consensus.
+	OnlineKeyreg KeyregType = "online"
+
+	// OfflineKeyreg removes the registered participation key from the account.
+	OfflineKeyreg KeyregType = "offline"
+
+	// NonParticipatingKeyreg permanently marks the account as not participating in consensus.
+	NonParticipatingKeyreg KeyregType = "nonparticipating"
+)
+
+// IncentiveEligibleFee is the fee in microalgos that makes an account eligible for incentives on an online keyreg.
+const IncentiveEligibleFee = 2_000_000
+
+// KeyregValidity is the number of rounds an unsigned keyreg transaction can be submitted for.
+const KeyregValidity = 1000
+
+// GetSuggestedParams fetches the transaction parameters from the node,
+// the transaction is valid from the latest round for KeyregValidity rounds.
+func GetSuggestedParams(ctx context.Context, client api.ClientWithResponsesInterface) (types.SuggestedParams, error) {
+	res, err := client.TransactionParamsWithResponse(ctx)
+	if err != nil {
+		return types.SuggestedParams{}, err
+	}
+	if res.StatusCode() != 200 || res.JSON200 == nil {
+		return types.SuggestedParams{}, errors.New(res.Status())
+	}
+	return types.SuggestedParams{
+		Fee:              types.MicroAlgos(res.JSON200.Fee),
+		GenesisID:        res.JSON200.GenesisId,
+		GenesisHash:      res.JSON200.GenesisHash,
+		FirstRoundValid:  types.Round(res.JSON200.LastRound),
+		LastRoundValid:   types.Round(res.JSON200.LastRound + KeyregValidity),
+		ConsensusVersion: res.JSON200.ConsensusVersion,
+		MinFee:           uint64(res.JSON200.MinFee),
+	}, nil
+}
+
+// MakeKeyregTxn builds an unsigned key registration transaction for the address.
+// The participation key is required for an OnlineKeyreg and ignored otherwise.
+// The IncentiveEligibleFee is only applied to an OnlineKeyreg.
+func MakeKeyregTxn(kind KeyregType, address string, part *api.ParticipationKey, params types.SuggestedParams, incentiveEligibleFee bool) (types.Transaction, error) {
+	switch kind {
+	case OnlineKeyreg:
+		if part == nil {
+			return types.Transaction{}, errors.New("an online keyreg requires a participation key")
+		}
+		if part.Address != address {
+			return types.Transaction{}, fmt.Errorf("participation key %s is for %s instead of %s", part.Id, part.Address, address)
+		}
+		if incentiveEligibleFee {
+			params.Fee = IncentiveEligibleFee
+			params.FlatFee = true
+		}
+		stateProofKey := ""
+		if part.Key.StateProofKey != nil {
+			stateProofKey = base64.StdEncoding.EncodeToString(*part.Key.StateProofKey)
+		}
+		return transaction.MakeKeyRegTxnWithStateProofKey(
+			address,
+			nil,
+			params,
+			base64.StdEncoding.EncodeToString(part.Key.VoteParticipationKey),
+			base64.StdEncoding.EncodeToString(part.Key.SelectionParticipationKey),
+			stateProofKey,
+			uint64(part.Key.VoteFirstValid),
+			uint64(part.Key.VoteLastValid),
+			uint64(part.Key.VoteKeyDilution),
+			false,
+		)
+	case OfflineKeyreg:
+		return transaction.MakeKeyRegTxnWithStateProofKey(address, nil, params, "", "", "", 0, 0, 0, false)
+	case NonParticipatingKeyreg:
+		return transaction.MakeKeyRegTxnWithStateProofKey(address, nil, params, "", "", "", 0, 0, 0, true)
+	default:
+		return types.Transaction{}, fmt.Errorf("unknown keyreg type %s", kind)
+	}
+}
+
+// EncodeUnsignedTxn encodes the transaction as an unsigned msgpack SignedTxn,
+// the format written by `goal clerk send -o` and read by `goal clerk sign`.
+// The authAddr of a rekeyed account is set as the signer, and the multisig preimage,
+// when not nil, is included so `goal clerk multisig sign` can add each signature.
+func EncodeUnsignedTxn(txn types.Transaction, authAddr string, msig *crypto.MultisigAccount) ([]byte, error) {
+	stxn := types.SignedTxn{Txn: txn}
+
+	signer := txn.Sender
+	if authAddr != "" && authAddr != txn.Sender.String() {
+		addr, err := types.DecodeAddress(authAddr)
+		if err != nil {
+			return nil, err
+		}
+		stxn.AuthAddr = addr
+		signer = addr
+	}
+
+	if msig != nil {
+		addr, err := msig.Address()
+		if err != nil {
+			return nil, err
+		}
+		if addr != signer {
+			return nil, fmt.Errorf("multisig address %s does not match the authorizing address %s", addr, signer)
+		}
+		stxn.Msig = types.MultisigSig{Version: msig.Version, Threshold: msig.Threshold}
+		for _, pk := range msig.Pks {
+			stxn.Msig.Subsigs = append(stxn.Msig.Subsigs, types.MultisigSubsig{Key: pk})
+		}
+	}
+	return msgpack.Encode(stxn), nil
+}
+
+// NewMultisig creates the multisig preimage from its threshold and ordered member addresses.
+func NewMultisig(threshold int, addresses []string) (*crypto.MultisigAccount, error) {
+	if threshold < 1 || threshold > len(addresses) || threshold > 255 {
+		return nil, fmt.Errorf("invalid multisig threshold %d for %d addresses", threshold, len(addresses))
+	}
+	addrs := make([]types.Address, len(addresses))
+	for i, address := range addresses {
+		addr, err := types.DecodeAddress(address)
+		if err != nil {
+			return nil, err
+		}
+		addrs[i] = addr
+	}
+	msig, err := crypto.MultisigAccountWithParams(1, uint8(threshold), addrs)
+	if err != nil {
+		return nil, err
+	}
+	return &msig, nil
+}
+
+// DecodeSignedTxn decodes a msgpack signed transaction and ensures it carries a signature.
+func DecodeSignedTxn(data []byte) (types.SignedTxn, error) {
+	var stxn types.SignedTxn
+	err := msgpack.Decode(data, &stxn)
+	if err != nil {
+		return stxn, fmt.Errorf("invalid signed transaction: %s", err)
+	}
+	if stxn.Sig == (types.Signature{}) && stxn.Msig.Version == 0 && len(stxn.Lsig.Logic) == 0 {
+		return stxn, errors.New("the transaction is not signed")
+	}
+	return stxn, nil
+}
+
+// SendRawTxn submits signed transaction bytes through the node and returns the transaction ID.
+func SendRawTxn(ctx context.Context, client api.ClientWithResponsesInterface, stxn []byte) (string, error) {
+	res, err := client.RawTransactionWithBodyWithResponse(ctx, "application/x-binary", bytes.NewReader(stxn))
+	if err != nil {
+		return "", err
+	}
+	if res.StatusCode() != 200 || res.JSON200 == nil {
+		if res.JSON400 != nil {
+			return "", errors.New(res.JSON400.Message)
+		}
+		return "", errors.New(res.Status())
+	}
+	return res.JSON200.TxId, nil
+}
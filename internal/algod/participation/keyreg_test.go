@@ -0,0 +1,192 @@
+package participation
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorand/go-algorand-sdk/v2/encoding/msgpack"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/internal/test"
+)
+
+const keyregAddress = "TUIDKH2C7MUHZDD77MAMUREJRKNK25SYXB7OAFA6JFBB24PEL5UX4S4GUU"
+
+func keyregKey() *api.ParticipationKey {
+	stateProofKey := bytes.Repeat([]byte{3}, 64)
+	return &api.ParticipationKey{
+		Id:      "KEY",
+		Address: keyregAddress,
+		Key: api.AccountParticipation{
+			VoteParticipationKey:      bytes.Repeat([]byte{1}, 32),
+			SelectionParticipationKey: bytes.Repeat([]byte{2}, 32),
+			StateProofKey:             &stateProofKey,
+			VoteFirstValid:            1000,
+			VoteLastValid:             2000,
+			VoteKeyDilution:           100,
+		},
+	}
+}
+
+func Test_GetSuggestedParams(t *testing.T) {
+	params, err := GetSuggestedParams(context.Background(), test.GetClient(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if params.FirstRoundValid != 1000 || params.LastRoundValid != 1000+KeyregValidity || params.GenesisID != "tuinet-v1" {
+		t.Errorf("unexpected params %+v", params)
+	}
+
+	_, err = GetSuggestedParams(context.Background(), test.GetClient(true))
+	if err == nil {
+		t.Error("expected an error")
+	}
+}
+
+func Test_MakeKeyregTxn(t *testing.T) {
+	params, err := GetSuggestedParams(context.Background(), test.GetClient(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	online, err := MakeKeyregTxn(OnlineKeyreg, keyregAddress, keyregKey(), params, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if online.Type != types.KeyRegistrationTx || online.Fee != IncentiveEligibleFee {
+		t.Errorf("unexpected online keyreg %+v", online.Header)
+	}
+	if online.VoteFirst != 1000 || online.VoteLast != 2000 || online.VoteKeyDilution != 100 || online.VotePK[0] != 1 || online.StateProofPK[0] != 3 {
+		t.Errorf("unexpected keyreg fields %+v", online.KeyregTxnFields)
+	}
+
+	online, err = MakeKeyregTxn(OnlineKeyreg, keyregAddress, keyregKey(), params, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if online.Fee != 1000 {
+		t.Errorf("expected the minimum fee, got %d", online.Fee)
+	}
+
+	offline, err := MakeKeyregTxn(OfflineKeyreg, keyregAddress, keyregKey(), params, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if offline.VotePK != (types.VotePK{}) || offline.Nonparticipation || offline.Fee == IncentiveEligibleFee {
+		t.Errorf("unexpected offline keyreg %+v", offline)
+	}
+
+	nonpart, err := MakeKeyregTxn(NonParticipatingKeyreg, keyregAddress, nil, params, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !nonpart.Nonparticipation {
+		t.Error("expected a nonparticipating keyreg")
+	}
+
+	_, err = MakeKeyregTxn(OnlineKeyreg, keyregAddress, nil, params, false)
+	if err == nil {
+		t.Error("expected an error without a key")
+	}
+	key := keyregKey()
+	key.Address = "ABC"
+	_, err = MakeKeyregTxn(OnlineKeyreg, keyregAddress, key, params, false)
+	if err == nil {
+		t.Error("expected an error for a key of another account")
+	}
+}
+
+func Test_EncodeUnsignedTxn(t *testing.T) {
+	params, _ := GetSuggestedParams(context.Background(), test.GetClient(false))
+	txn, err := MakeKeyregTxn(OnlineKeyreg, keyregAddress, keyregKey(), params, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := EncodeUnsignedTxn(txn, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded types.SignedTxn
+	err = msgpack.Decode(data, &decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Txn.Sender.String() != keyregAddress || decoded.Txn.VoteLast != 2000 {
+		t.Errorf("unexpected transaction %+v", decoded.Txn)
+	}
+
+	_, err = DecodeSignedTxn(data)
+	if err == nil {
+		t.Error("expected an error for an unsigned transaction")
+	}
+	_, err = DecodeSignedTxn([]byte("invalid"))
+	if err == nil {
+		t.Error("expected an error for invalid msgpack")
+	}
+
+	decoded.Sig = types.Signature{1}
+	stxn, err := DecodeSignedTxn(msgpack.Encode(decoded))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stxn.Txn.Sender.String() != keyregAddress {
+		t.Error("expected the signed transaction to round trip")
+	}
+}
+
+func Test_EncodeUnsignedTxnForMultisig(t *testing.T) {
+	params, _ := GetSuggestedParams(context.Background(), test.GetClient(false))
+	txn, err := MakeKeyregTxn(OfflineKeyreg, keyregAddress, nil, params, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	members := []string{crypto.GenerateAccount().Address.String(), crypto.GenerateAccount().Address.String()}
+	msig, err := NewMultisig(2, members)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msigAddr, _ := msig.Address()
+
+	// The multisig must be the authorizing address
+	_, err = EncodeUnsignedTxn(txn, "", msig)
+	if err == nil {
+		t.Error("expected an error when the multisig is not the sender")
+	}
+
+	data, err := EncodeUnsignedTxn(txn, msigAddr.String(), msig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded types.SignedTxn
+	err = msgpack.Decode(data, &decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.AuthAddr != msigAddr || decoded.Msig.Threshold != 2 || len(decoded.Msig.Subsigs) != 2 {
+		t.Errorf("unexpected signed transaction %+v", decoded)
+	}
+
+	_, err = NewMultisig(3, members)
+	if err == nil {
+		t.Error("expected an error for a threshold above the member count")
+	}
+	_, err = NewMultisig(1, []string{"ABC"})
+	if err == nil {
+		t.Error("expected an error for an invalid address")
+	}
+}
+
+func Test_SendRawTxn(t *testing.T) {
+	txId, err := SendRawTxn(context.Background(), test.GetClient(false), []byte("stxn"))
+	if err != nil || txId != "TXID" {
+		t.Errorf("expected the transaction id, got %s: %v", txId, err)
+	}
+	_, err = SendRawTxn(context.Background(), test.NewClient(false, true), []byte("stxn"))
+	if err == nil || err.Error() != "transaction rejected" {
+		t.Errorf("expected the node error, got %v", err)
+	}
+}
@@ -3,9 +3,11 @@ package participation
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/algorandfoundation/nodekit/api"
@@ -156,6 +158,45 @@ func Delete(ctx context.Context, client api.ClientWithResponsesInterface, partic
 	return nil
 }
 
+// Import uploads a participation key file created by `algokey part generate` and returns the installed key.
+// When address is not empty, a key file for any other account is rejected before it is uploaded.
+func Import(ctx context.Context, client api.ClientWithResponsesInterface, address string, body io.Reader) (*api.ParticipationKey, error) {
+	if address != "" {
+		content, err := io.ReadAll(body)
+		if err != nil {
+			return nil, err
+		}
+		keyAddress, err := KeyFileAddress(content)
+		if err != nil {
+			return nil, err
+		}
+		if keyAddress != address {
+			return nil, fmt.Errorf("key is for %s instead of %s", keyAddress, address)
+		}
+		body = bytes.NewReader(content)
+	}
+
+	res, err := client.AddParticipationKeyWithBodyWithResponse(ctx, "application/msgpack", body)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode() != 200 || res.JSON200 == nil {
+		switch {
+		case res.JSON400 != nil:
+			return nil, errors.New(res.JSON400.Message)
+		case res.JSON500 != nil:
+			return nil, errors.New(res.JSON500.Message)
+		}
+		return nil, errors.New(res.Status())
+	}
+
+	key, _, err := GetKey(ctx, client, res.JSON200.PartId)
+	if err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
 // RemovePartKeyByID Removes a participation key from the list of keys
 func RemovePartKeyByID(slice *List, id string) {
 	for i, item := range *slice {
@@ -201,6 +242,31 @@ type OnlineShortLinkBody struct {
 	Network          string `json:"network"`
 }
 
+// GetLoraNetwork converts an algod network name into the network name used by lora and the short link service.
+// Networks without a lora name in the api.Registry use the genesis ID without its version.
+// The genesis hash tells apart networks sharing a genesis ID, see api.Networks.Find.
+func GetLoraNetwork(network string, genesisHash string) string {
+	n, ok := api.Registry.Find(network, genesisHash)
+	if ok && n.LoraNetwork != "" {
+		return n.LoraNetwork
+	}
+	return api.ShortNetworkName(network)
+}
+
+// ToOnlineShortLinkBody creates the online short link payload for a participation key on the algod network.
+func ToOnlineShortLinkBody(part api.ParticipationKey, network string, genesisHash string) OnlineShortLinkBody {
+	return OnlineShortLinkBody{
+		Account:          part.Address,
+		VoteKeyB64:       base64.RawURLEncoding.EncodeToString(part.Key.VoteParticipationKey),
+		SelectionKeyB64:  base64.RawURLEncoding.EncodeToString(part.Key.SelectionParticipationKey),
+		StateProofKeyB64: base64.RawURLEncoding.EncodeToString(*part.Key.StateProofKey),
+		VoteFirstValid:   part.Key.VoteFirstValid,
+		VoteLastValid:    part.Key.VoteLastValid,
+		KeyDilution:      part.Key.VoteKeyDilution,
+		Network:          GetLoraNetwork(network, genesisHash),
+	}
+}
+
 // GetOnlineShortLink sends a POST request to create an online short link
 // and returns the response or an error if it occurs.
 func GetOnlineShortLink(http api.HttpPkgInterface, part OnlineShortLinkBody) (ShortLinkResponse, error) {
@@ -3,14 +3,27 @@ package participation
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"github.com/algorandfoundation/nodekit/api"
 	"github.com/algorandfoundation/nodekit/internal/test"
 	"io"
 	"net/http"
+	"os"
 	"testing"
 )
 
+// uploadClient counts the uploaded key files
+type uploadClient struct {
+	api.ClientWithResponsesInterface
+	uploads int
+}
+
+func (c *uploadClient) AddParticipationKeyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...api.RequestEditorFn) (*api.AddParticipationKeyResponse, error) {
+	c.uploads++
+	return c.ClientWithResponsesInterface.AddParticipationKeyWithBodyWithResponse(ctx, contentType, body, reqEditors...)
+}
+
 func Test_ListParticipationKeys(t *testing.T) {
 	ctx := context.Background()
 	client, err := api.NewClientWithResponses("https://mainnet-api.4160.nodely.dev:443")
@@ -118,6 +131,48 @@ func Test_DeleteParticipationKey(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+func Test_ImportParticipationKey(t *testing.T) {
+	ctx := context.Background()
+
+	key, err := Import(ctx, test.GetClient(false), "", bytes.NewReader([]byte("partkey")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key.Id != "123" || key.Address != "ABC" {
+		t.Errorf("unexpected key %+v", key)
+	}
+
+	content, err := os.ReadFile("testdata/test.partkey")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = Import(ctx, test.GetClient(false), testKeyAddress(), bytes.NewReader(content))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A key for another account is rejected before it is uploaded
+	client := &uploadClient{ClientWithResponsesInterface: test.GetClient(false)}
+	_, err = Import(ctx, client, "DEF", bytes.NewReader(content))
+	if err == nil || err.Error() != "key is for "+testKeyAddress()+" instead of DEF" || client.uploads != 0 {
+		t.Errorf("expected the key to be rejected before the upload, got %v", err)
+	}
+	_, err = Import(ctx, client, "DEF", bytes.NewReader([]byte("partkey")))
+	if !errors.Is(err, ErrInvalidKeyFile) || client.uploads != 0 {
+		t.Errorf("expected an invalid key file, got %v", err)
+	}
+
+	_, err = Import(ctx, test.NewClient(false, true), "", bytes.NewReader([]byte("partkey")))
+	if err == nil || err.Error() != "invalid participation key file" {
+		t.Errorf("expected the node error, got %v", err)
+	}
+
+	_, err = Import(ctx, test.GetClient(true), "", bytes.NewReader([]byte("partkey")))
+	if err == nil {
+		t.Error("expected an error")
+	}
+}
+
 func Test_RemovePartKeyByID(t *testing.T) {
 	// Test case: Remove an existing key
 	t.Run("Remove existing key", func(t *testing.T) {
@@ -0,0 +1,214 @@
+package progress
+
+import (
+	"time"
+
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/algod/samples"
+)
+
+// DirName is the directory in the home directory holding a progress file per node.
+const DirName = ".nodekit-catchup"
+
+// SampleInterval is the minimum time between two stored samples.
+const SampleInterval = 15 * time.Second
+
+// MaxSamples is the number of samples kept, the oldest samples are dropped first.
+const MaxSamples = 720
+
+// RateWindow is how far back the samples are used to compute the rate of a phase.
+const RateWindow = 5 * time.Minute
+
+// StallTimeout is how long the catchup may go without progress before it is reported as stalled.
+const StallTimeout = 10 * time.Minute
+
+// Phase is a step of a fast catchup.
+type Phase string
+
+const (
+	// AccountsPhase processes the accounts of the catchpoint.
+	AccountsPhase Phase = "accounts"
+
+	// KeyValuesPhase processes the key values (boxes) of the catchpoint.
+	KeyValuesPhase Phase = "key values"
+
+	// VerifyPhase verifies the accounts and key values against the catchpoint.
+	VerifyPhase Phase = "verification"
+
+	// BlocksPhase downloads the blocks following the catchpoint.
+	BlocksPhase Phase = "blocks"
+)
+
+// Phases are the phases of a fast catchup, in order.
+var Phases = []Phase{AccountsPhase, KeyValuesPhase, VerifyPhase, BlocksPhase}
+
+// Sample is the catchup progress reported by the node at a point in time.
+type Sample struct {
+	Time              time.Time `json:"time"`
+	AccountsTotal     int       `json:"accountsTotal"`
+	AccountsProcessed int       `json:"accountsProcessed"`
+	AccountsVerified  int       `json:"accountsVerified"`
+	KeyValueTotal     int       `json:"kvTotal"`
+	KeyValueProcessed int       `json:"kvProcessed"`
+	KeyValueVerified  int       `json:"kvVerified"`
+	BlocksTotal       int       `json:"blocksTotal"`
+	BlocksAcquired    int       `json:"blocksAcquired"`
+}
+
+// NewSample captures the catchup progress of a status.
+func NewSample(now time.Time, status algod.Status) Sample {
+	return Sample{
+		Time:              now,
+		AccountsTotal:     status.CatchpointAccountsTotal,
+		AccountsProcessed: status.CatchpointAccountsProcessed,
+		AccountsVerified:  status.CatchpointAccountsVerified,
+		KeyValueTotal:     status.CatchpointKeyValueTotal,
+		KeyValueProcessed: status.CatchpointKeyValueProcessed,
+		KeyValueVerified:  status.CatchpointKeyValueVerified,
+		BlocksTotal:       status.CatchpointBlocksTotal,
+		BlocksAcquired:    status.CatchpointBlocksAcquired,
+	}
+}
+
+// At returns when the sample was taken.
+func (s Sample) At() time.Time {
+	return s.Time
+}
+
+// Count returns the done and total items of a phase.
+func (s Sample) Count(phase Phase) (done int, total int) {
+	switch phase {
+	case AccountsPhase:
+		return s.AccountsProcessed, s.AccountsTotal
+	case KeyValuesPhase:
+		return s.KeyValueProcessed, s.KeyValueTotal
+	case VerifyPhase:
+		return s.AccountsVerified + s.KeyValueVerified, s.AccountsTotal + s.KeyValueTotal
+	case BlocksPhase:
+		return s.BlocksAcquired, s.BlocksTotal
+	}
+	return 0, 0
+}
+
+// sameCounts is true when no progress was made between two samples.
+func (s Sample) sameCounts(other Sample) bool {
+	other.Time = s.Time
+	return s == other
+}
+
+// History is when a phase started and finished.
+type History struct {
+	Started  *time.Time `json:"started,omitempty"`
+	Finished *time.Time `json:"finished,omitempty"`
+}
+
+// Tracker records the progress of a fast catchup over time.
+type Tracker struct {
+	// Catchpoint is the catchpoint being tracked, a different catchpoint starts a new tracker.
+	Catchpoint string `json:"catchpoint"`
+
+	// Started is when the catchup was first seen.
+	Started time.Time `json:"started"`
+
+	// Finished is when the node was first seen out of fast catchup, nil while running.
+	Finished *time.Time `json:"finished,omitempty"`
+
+	// LastChange is when the node last reported progress.
+	LastChange time.Time `json:"lastChange"`
+
+	// Latest is the last recorded sample, it is stored even when it is too close to the previous sample.
+	Latest Sample `json:"latest"`
+
+	// Samples are stored at most every SampleInterval.
+	Samples []Sample `json:"samples"`
+
+	// History is the start and finish of each phase.
+	History map[Phase]*History `json:"history"`
+
+	samples.File
+}
+
+// Path returns the progress file of a node, identified by its data directory or connection profile.
+func Path(dataDir string, profile string) (string, error) {
+	return samples.Path(DirName, dataDir, profile)
+}
+
+// Load reads the tracker stored at path, a missing file returns an empty tracker.
+func Load(path string) (*Tracker, error) {
+	t := &Tracker{File: samples.NewFile(path)}
+	err := t.Read(t)
+	if t.History == nil {
+		t.History = make(map[Phase]*History)
+	}
+	return t, err
+}
+
+// Save writes the tracker to the path it was loaded from.
+// Nothing is written unless a sample was added, or the catchup started or finished, since it was loaded or saved.
+func (t *Tracker) Save() error {
+	return t.Write(t)
+}
+
+// Record adds the status of the node, it returns false when there is no catchup to track.
+// A status outside of fast catchup finishes the tracked catchup, later statuses return false until the next catchup.
+func (t *Tracker) Record(now time.Time, status algod.Status) bool {
+	if status.State != algod.FastCatchupState || status.Catchpoint == nil {
+		if t.Catchpoint == "" || t.Finished != nil {
+			return false
+		}
+		t.Finished = &now
+		for _, phase := range Phases {
+			h := t.history(phase)
+			if h.Started != nil && h.Finished == nil {
+				h.Finished = &now
+			}
+		}
+		t.Changed()
+		return true
+	}
+
+	sample := NewSample(now, status)
+	if *status.Catchpoint != t.Catchpoint || t.Finished != nil {
+		*t = Tracker{
+			Catchpoint: *status.Catchpoint,
+			Started:    now,
+			LastChange: now,
+			History:    make(map[Phase]*History),
+			File:       t.File,
+		}
+	} else if !sample.sameCounts(t.Latest) {
+		t.LastChange = now
+	}
+	t.Latest = sample
+
+	var added bool
+	t.Samples, added = samples.Append(t.Samples, sample, SampleInterval, MaxSamples)
+	if added {
+		t.Changed()
+	}
+
+	for _, phase := range Phases {
+		done, total := sample.Count(phase)
+		h := t.history(phase)
+		if h.Started == nil && done > 0 {
+			h.Started = &now
+		}
+		if h.Finished == nil && total > 0 && done >= total {
+			if h.Started == nil {
+				h.Started = &now
+			}
+			h.Finished = &now
+		}
+	}
+	return true
+}
+
+// history returns the History of a phase, creating it when missing.
+func (t *Tracker) history(phase Phase) *History {
+	h, ok := t.History[phase]
+	if !ok {
+		h = &History{}
+		t.History[phase] = h
+	}
+	return h
+}
@@ -0,0 +1,116 @@
+package progress
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/internal/algod"
+)
+
+func catchupStatus(catchpoint string, accounts int, verified int, blocks int) algod.Status {
+	return algod.Status{
+		State:                       algod.FastCatchupState,
+		Catchpoint:                  &catchpoint,
+		CatchpointAccountsTotal:     1000,
+		CatchpointAccountsProcessed: accounts,
+		CatchpointAccountsVerified:  verified,
+		CatchpointBlocksTotal:       100,
+		CatchpointBlocksAcquired:    blocks,
+	}
+}
+
+func Test_Tracker(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "node.json")
+	tracker, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tracker.Record(time.Now(), algod.Status{State: algod.StableState}) {
+		t.Error("expected a stable node not to be tracked")
+	}
+	if tracker.Save() != nil {
+		t.Fatal("expected nothing to save")
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Error("expected no file without a catchup")
+	}
+
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	tracker.Record(start, catchupStatus("1000#ABC", 0, 0, 0))
+	tracker.Record(start.Add(time.Minute), catchupStatus("1000#ABC", 100, 0, 0))
+	tracker.Record(start.Add(2*time.Minute), catchupStatus("1000#ABC", 200, 0, 0))
+
+	report := tracker.Report(start.Add(2 * time.Minute))
+	accounts := report.Phases[0]
+	if accounts.Phase != AccountsPhase || accounts.Done != 200 || accounts.Total != 1000 {
+		t.Fatalf("unexpected accounts phase %+v", accounts)
+	}
+	if accounts.Rate < 1.66 || accounts.Rate > 1.67 {
+		t.Errorf("expected 100 accounts per minute, got %f", accounts.Rate)
+	}
+	if accounts.ETA != 8*time.Minute {
+		t.Errorf("expected an ETA of 8m, got %s", accounts.ETA)
+	}
+	if report.ETA != accounts.ETA || report.Stalled {
+		t.Errorf("unexpected report %+v", report)
+	}
+
+	// Survives a restart
+	err = tracker.Save()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tracker, err = Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tracker.Catchpoint != "1000#ABC" || len(tracker.Samples) != 3 || tracker.History[AccountsPhase].Started == nil {
+		t.Errorf("unexpected tracker after loading %+v", tracker)
+	}
+
+	// Finish the accounts and stall during verification
+	tracker.Record(start.Add(3*time.Minute), catchupStatus("1000#ABC", 1000, 10, 0))
+	tracker.Record(start.Add(20*time.Minute), catchupStatus("1000#ABC", 1000, 10, 0))
+	report = tracker.Report(start.Add(20 * time.Minute))
+	if !report.Phases[0].IsFinished() || report.Phases[0].ETA != 0 {
+		t.Errorf("expected the accounts phase to be finished, got %+v", report.Phases[0])
+	}
+	if !report.Stalled {
+		t.Error("expected the catchup to be stalled")
+	}
+
+	// A new catchpoint starts over
+	tracker.Record(start.Add(21*time.Minute), catchupStatus("2000#DEF", 0, 0, 0))
+	if tracker.Catchpoint != "2000#DEF" || len(tracker.Samples) != 1 || tracker.History[AccountsPhase].Started != nil {
+		t.Errorf("expected a new tracker, got %+v", tracker)
+	}
+
+	// Leaving fast catchup finishes it
+	done := start.Add(30 * time.Minute)
+	if !tracker.Record(done, algod.Status{State: algod.StableState}) {
+		t.Error("expected the finished catchup to be tracked")
+	}
+	if tracker.Record(done.Add(time.Minute), algod.Status{State: algod.StableState}) {
+		t.Error("expected a finished catchup not to be recorded again")
+	}
+	report = tracker.Report(done.Add(time.Hour))
+	if report.Finished == nil || report.Elapsed != 9*time.Minute || report.Stalled {
+		t.Errorf("unexpected finished report %+v", report)
+	}
+}
+
+func Test_Path(t *testing.T) {
+	local, err := Path("/var/lib/algorand", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	remote, err := Path("", "relay")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if filepath.Base(local) != "datadir_var_lib_algorand.json" || filepath.Base(remote) != "profile-relay.json" {
+		t.Errorf("unexpected paths %s and %s", local, remote)
+	}
+}
@@ -0,0 +1,89 @@
+package progress
+
+import (
+	"time"
+
+	"github.com/algorandfoundation/nodekit/internal/algod/samples"
+)
+
+// PhaseReport is the progress of a single phase.
+type PhaseReport struct {
+	Phase Phase `json:"phase"`
+	Done  int   `json:"done"`
+	Total int   `json:"total"`
+
+	// Rate is the number of items per second over the RateWindow, 0 when unknown.
+	Rate float64 `json:"rate"`
+
+	// ETA is the remaining time of the phase, 0 when finished or unknown.
+	ETA time.Duration `json:"eta"`
+
+	History
+}
+
+// IsFinished is true once every item of the phase is done.
+func (p PhaseReport) IsFinished() bool {
+	return p.Finished != nil
+}
+
+// Report is the progress of a fast catchup, see Tracker.Report.
+type Report struct {
+	Catchpoint string        `json:"catchpoint"`
+	Started    time.Time     `json:"started"`
+	Finished   *time.Time    `json:"finished,omitempty"`
+	Elapsed    time.Duration `json:"elapsed"`
+
+	// ETA is the sum of the remaining time of the phases with a known rate,
+	// the phases which have not started are not included.
+	ETA time.Duration `json:"eta"`
+
+	// Stalled is true when the node made no progress for StallTimeout.
+	Stalled bool `json:"stalled"`
+
+	// LastChange is when the node last reported progress.
+	LastChange time.Time `json:"lastChange"`
+
+	Phases []PhaseReport `json:"phases"`
+}
+
+// Report computes the rate and ETA of every phase.
+func (t *Tracker) Report(now time.Time) Report {
+	report := Report{
+		Catchpoint: t.Catchpoint,
+		Started:    t.Started,
+		Finished:   t.Finished,
+		LastChange: t.LastChange,
+		Phases:     make([]PhaseReport, 0, len(Phases)),
+	}
+	end := now
+	if t.Finished != nil {
+		end = *t.Finished
+	} else if t.Catchpoint != "" {
+		report.Stalled = now.Sub(t.LastChange) >= StallTimeout
+	}
+	if t.Catchpoint != "" {
+		report.Elapsed = end.Sub(t.Started)
+	}
+
+	// The oldest sample within the rate window
+	first := samples.Oldest(t.Samples, t.Latest, RateWindow)
+
+	for _, phase := range Phases {
+		p := PhaseReport{Phase: phase}
+		p.Done, p.Total = t.Latest.Count(phase)
+		if h, ok := t.History[phase]; ok {
+			p.History = *h
+		}
+		if !p.IsFinished() && t.Finished == nil {
+			from, _ := first.Count(phase)
+			elapsed := t.Latest.Time.Sub(first.Time).Seconds()
+			if elapsed > 0 && p.Done > from {
+				p.Rate = float64(p.Done-from) / elapsed
+				p.ETA = time.Duration(float64(p.Total-p.Done) / p.Rate * float64(time.Second))
+				report.ETA += p.ETA
+			}
+		}
+		report.Phases = append(report.Phases, p)
+	}
+	return report
+}
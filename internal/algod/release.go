@@ -0,0 +1,100 @@
+package algod
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/internal/algod/config"
+	"github.com/algorandfoundation/nodekit/internal/system"
+)
+
+// Channels are the release channels algod can be installed from.
+var Channels = []string{"stable", "beta"}
+
+// ErrDowngrade is returned for a release older than the installed version when no downgrade was requested.
+var ErrDowngrade = errors.New("the release is older than the installed version")
+
+// ErrChannelChange is returned for a release of another channel than the installed algod.
+// Each channel is a different package, the package manager does not switch between them.
+var ErrChannelChange = errors.New("algod is installed from another channel")
+
+// releaseVersion matches the version of a release tag or version flag, e.g. v3.26.0-stable or 3.26.0
+var releaseVersion = regexp.MustCompile(`^v?(\d+\.\d+\.\d+)(?:[-.](?:stable|beta))?$`)
+
+// NewRelease validates the channel and version flags, the version may be given as a tag, e.g. v3.26.0-stable.
+func NewRelease(channel string, version string, downgrade bool) (system.Release, error) {
+	release := system.Release{Channel: channel, Downgrade: downgrade}
+	if !slices.Contains(Channels, release.GetChannel()) {
+		return release, fmt.Errorf("invalid channel %s, use one of %s", channel, strings.Join(Channels, ", "))
+	}
+	if version != "" {
+		match := releaseVersion.FindStringSubmatch(version)
+		if match == nil {
+			return release, fmt.Errorf("invalid version %s, use major.minor.patch, e.g. 3.26.0", version)
+		}
+		release.Version = match[1]
+	}
+	return release, nil
+}
+
+// ResolveRelease returns the GitHub release tag of the release, e.g. v3.26.0-stable.
+// Without a Version it is the latest release of the Channel, a Version is looked up by its tag
+// so releases older than the first page of the release list are found.
+func ResolveRelease(httpPkg api.HttpPkgInterface, release system.Release) (string, error) {
+	if release.Version != "" {
+		tag := fmt.Sprintf("v%s-%s", release.Version, release.GetChannel())
+		response, err := api.GetGoAlgorandReleaseByTagWithResponse(httpPkg, tag)
+		if err != nil {
+			return "", err
+		}
+		if response == nil || response.StatusCode() == 404 {
+			return "", fmt.Errorf("release %s not found in the go-algorand releases", tag)
+		}
+		if response.StatusCode() != 200 {
+			return "", errors.New("unable to fetch the go-algorand release " + tag)
+		}
+		return response.JSON200, nil
+	}
+
+	channel := release.GetChannel()
+	response, err := api.GetGoAlgorandReleaseWithResponse(httpPkg, channel)
+	if err != nil {
+		if err.Error() == api.ChannelNotFoundMsg {
+			return "", fmt.Errorf("release %s not found in the recent go-algorand releases", channel)
+		}
+		return "", err
+	}
+	if response == nil || response.StatusCode() != 200 {
+		return "", errors.New("unable to fetch the go-algorand releases")
+	}
+	return response.JSON200, nil
+}
+
+// CheckRelease compares the release tag with the installed version and returns true when algod is already at the release.
+// An unpinned release is current when the installed version is at least as new, as the package manager never downgrades it.
+// A pinned release older than the installed version returns ErrDowngrade, unless the release allows the downgrade.
+func CheckRelease(tag string, installed string, release system.Release) (bool, error) {
+	cmp, err := config.CompareVersions(tag, installed)
+	if err != nil {
+		return false, err
+	}
+	if release.Version == "" {
+		return cmp <= 0, nil
+	}
+	if cmp < 0 && !release.Downgrade {
+		return false, fmt.Errorf("%w: %s is older than %s, pass --downgrade to install it anyway", ErrDowngrade, tag, installed)
+	}
+	return cmp == 0, nil
+}
+
+// CheckChannel returns ErrChannelChange when the release is not on the channel algod was installed from.
+func CheckChannel(installed string, release system.Release) error {
+	if installed != release.GetChannel() {
+		return fmt.Errorf("%w: algod %s is installed, uninstall it and install the %s channel to switch", ErrChannelChange, installed, release.GetChannel())
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package algod
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/internal/system"
+)
+
+// releasesHttp serves a list of go-algorand releases, newest first
+type releasesHttp struct {
+	api.HttpPkgInterface
+}
+
+func (releasesHttp) Get(url string) (*http.Response, error) {
+	body := `[{"tag_name": "v3.27.0-beta"}, {"tag_name": "v3.26.0-stable"}, {"tag_name": "v3.25.0-stable"}]`
+	// Single releases are served by tag, including releases past the first page of the list
+	if tag, ok := strings.CutPrefix(url, "https://api.github.com/repos/algorand/go-algorand/releases/tags/"); ok {
+		if !slices.Contains([]string{"v3.27.0-beta", "v3.26.0-stable", "v3.25.0-stable", "v3.9.0-stable"}, tag) {
+			return &http.Response{StatusCode: 404, Status: "404 Not Found", Body: io.NopCloser(bytes.NewReader(nil))}, nil
+		}
+		body = `{"tag_name": "` + tag + `"}`
+	}
+	return &http.Response{StatusCode: 200, Status: "200 OK", Body: io.NopCloser(bytes.NewReader([]byte(body)))}, nil
+}
+
+func Test_Release(t *testing.T) {
+	release, err := NewRelease("stable", "v3.25.0-stable", false)
+	if err != nil || release.Version != "3.25.0" {
+		t.Errorf("expected version 3.25.0, got %+v: %v", release, err)
+	}
+	for _, tc := range [][2]string{{"nightly", ""}, {"stable", "latest"}, {"stable", "3.25"}} {
+		_, err = NewRelease(tc[0], tc[1], false)
+		if err == nil {
+			t.Errorf("expected channel %s and version %s to be invalid", tc[0], tc[1])
+		}
+	}
+
+	for _, tc := range []struct {
+		release system.Release
+		tag     string
+	}{
+		{release: system.Release{}, tag: "v3.26.0-stable"},
+		{release: system.Release{Channel: "beta"}, tag: "v3.27.0-beta"},
+		{release: system.Release{Version: "3.25.0"}, tag: "v3.25.0-stable"},
+		{release: system.Release{Version: "3.9.0"}, tag: "v3.9.0-stable"},
+	} {
+		tag, err := ResolveRelease(releasesHttp{}, tc.release)
+		if err != nil || tag != tc.tag {
+			t.Errorf("expected %s for %+v, got %s: %v", tc.tag, tc.release, tag, err)
+		}
+	}
+	_, err = ResolveRelease(releasesHttp{}, system.Release{Version: "3.24.0"})
+	if err == nil {
+		t.Error("expected a missing release to fail")
+	}
+
+	err = CheckChannel("stable", system.Release{})
+	if err != nil {
+		t.Errorf("expected the stable channel to match: %v", err)
+	}
+	err = CheckChannel("stable", system.Release{Channel: "beta"})
+	if !errors.Is(err, ErrChannelChange) {
+		t.Errorf("expected a channel change, got %v", err)
+	}
+
+	current, err := CheckRelease("v3.26.0-stable", "3.26.0", system.Release{})
+	if err != nil || !current {
+		t.Errorf("expected the latest release to be current: %v", err)
+	}
+	current, err = CheckRelease("v3.26.0-stable", "3.25.0", system.Release{})
+	if err != nil || current {
+		t.Errorf("expected an upgrade: %v", err)
+	}
+	_, err = CheckRelease("v3.25.0-stable", "3.26.0", system.Release{Version: "3.25.0"})
+	if !errors.Is(err, ErrDowngrade) {
+		t.Errorf("expected a refused downgrade, got %v", err)
+	}
+	current, err = CheckRelease("v3.25.0-stable", "3.26.0", system.Release{Version: "3.25.0", Downgrade: true})
+	if err != nil || current {
+		t.Errorf("expected a downgrade: %v", err)
+	}
+}
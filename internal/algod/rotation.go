@@ -0,0 +1,183 @@
+package algod
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/internal/algod/participation"
+)
+
+// RotationAction is the next step of a participation key rotation for an account.
+type RotationAction string
+
+const (
+	// RotationSkip means the account does not need any action.
+	RotationSkip RotationAction = "skip"
+
+	// RotationGenerate means a successor key must be generated on the node.
+	RotationGenerate RotationAction = "generate"
+
+	// RotationRegister means the successor key exists and must be registered with an online keyreg.
+	RotationRegister RotationAction = "register"
+
+	// RotationDelete means the account switched to a newer key and the old key can be removed.
+	RotationDelete RotationAction = "delete"
+
+	// RotationPending means the account switched to a newer key, but consensus still uses the old key.
+	// The old key is deleted by a later rotation, once KeyRegLookback rounds passed.
+	RotationPending RotationAction = "pending"
+)
+
+// KeyRegLookback is the number of rounds consensus keeps using the previous key after a keyreg took effect,
+// the balance lookback of the protocol.
+const KeyRegLookback = 320
+
+// RotationOptions configures when and how successor keys are created.
+type RotationOptions struct {
+	// Threshold is the number of rounds before the active key's VoteLastValid when rotation starts.
+	Threshold int
+
+	// Rounds is the validity length of the successor key.
+	Rounds int
+
+	// Dilution is the key dilution of the successor key, the node default is used when nil.
+	Dilution *int
+}
+
+// RotationStep is a single planned action for an account.
+type RotationStep struct {
+	// Address is the account being rotated.
+	Address string `json:"address"`
+
+	// Action is the next step for the account.
+	Action RotationAction `json:"action"`
+
+	// Reason explains why the action was planned.
+	Reason string `json:"reason"`
+
+	// Key is the active key, or the key to delete for a RotationDelete.
+	Key *api.ParticipationKey `json:"key,omitempty"`
+
+	// Successor is the key that replaces the active key.
+	Successor *api.ParticipationKey `json:"successor,omitempty"`
+
+	// Params are the generation parameters for a RotationGenerate.
+	Params *api.GenerateParticipationKeysParams `json:"params,omitempty"`
+}
+
+// PlanRotation returns the next rotation steps for every account with keys on the node.
+// A successor key overlaps the active key by starting at the current round,
+// the old key is only deleted once the account's on-chain VoteParticipationKey switched to a newer key
+// and KeyRegLookback rounds passed since the switch.
+func PlanRotation(accounts map[string]Account, keys participation.List, lastRound int, opts RotationOptions) []RotationStep {
+	var steps []RotationStep
+
+	addresses := make([]string, 0, len(accounts))
+	for address := range accounts {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	for _, address := range addresses {
+		acct := accounts[address]
+		if acct.Status != "Online" || acct.Participation == nil {
+			steps = append(steps, RotationStep{Address: address, Action: RotationSkip, Reason: "account is not online"})
+			continue
+		}
+
+		// Find the key the account is registered with
+		var active *api.ParticipationKey
+		for i, key := range keys {
+			if key.Address == address && participation.IsActive(key, *acct.Participation) {
+				active = &keys[i]
+				break
+			}
+		}
+		if active == nil {
+			steps = append(steps, RotationStep{Address: address, Action: RotationSkip, Reason: "registered key is not on this node"})
+			continue
+		}
+
+		// Keys replaced by the active key can be removed once consensus stopped using them.
+		// The switch is the round the active key was registered from, or its first valid round.
+		switchRound := active.Key.VoteFirstValid
+		if active.EffectiveFirstValid != nil && *active.EffectiveFirstValid > switchRound {
+			switchRound = *active.EffectiveFirstValid
+		}
+		retired := false
+		for i, key := range keys {
+			if key.Address == address && key.Id != active.Id && key.Key.VoteLastValid < active.Key.VoteLastValid {
+				step := RotationStep{
+					Address:   address,
+					Action:    RotationDelete,
+					Reason:    fmt.Sprintf("account is registered with the newer key %s", active.Id),
+					Key:       &keys[i],
+					Successor: active,
+				}
+				if lastRound < switchRound+KeyRegLookback {
+					step.Action = RotationPending
+					step.Reason = fmt.Sprintf("account is registered with the newer key %s, the old key is used until round %d", active.Id, switchRound+KeyRegLookback)
+				}
+				steps = append(steps, step)
+				retired = true
+			}
+		}
+
+		// Find the newest key that outlives the active key
+		var successor *api.ParticipationKey
+		for i, key := range keys {
+			if key.Address == address && key.Id != active.Id && key.Key.VoteLastValid > active.Key.VoteLastValid &&
+				(successor == nil || key.Key.VoteLastValid > successor.Key.VoteLastValid) {
+				successor = &keys[i]
+			}
+		}
+
+		remaining := active.Key.VoteLastValid - lastRound
+		switch {
+		case successor != nil:
+			steps = append(steps, RotationStep{
+				Address:   address,
+				Action:    RotationRegister,
+				Reason:    fmt.Sprintf("successor key is valid until round %d, active key expires in %d rounds", successor.Key.VoteLastValid, remaining),
+				Key:       active,
+				Successor: successor,
+			})
+		case remaining <= opts.Threshold:
+			steps = append(steps, RotationStep{
+				Address: address,
+				Action:  RotationGenerate,
+				Reason:  fmt.Sprintf("active key expires in %d rounds", remaining),
+				Key:     active,
+				Params: &api.GenerateParticipationKeysParams{
+					Dilution: opts.Dilution,
+					First:    lastRound,
+					Last:     lastRound + opts.Rounds,
+				},
+			})
+		case !retired:
+			steps = append(steps, RotationStep{
+				Address: address,
+				Action:  RotationSkip,
+				Reason:  fmt.Sprintf("active key expires in %d rounds", remaining),
+				Key:     active,
+			})
+		}
+	}
+	return steps
+}
+
+// ApplyRotationStep runs the node side of a step.
+// It generates the successor key for a RotationGenerate and removes the old key for a RotationDelete,
+// other actions do not change the node. The generated key is returned for a RotationGenerate.
+func ApplyRotationStep(ctx context.Context, client api.ClientWithResponsesInterface, step RotationStep) (*api.ParticipationKey, error) {
+	switch step.Action {
+	case RotationGenerate:
+		return participation.GenerateKeys(ctx, client, step.Address, step.Params)
+	case RotationDelete:
+		return nil, participation.Delete(ctx, client, step.Key.Id)
+	default:
+		return nil, nil
+	}
+}
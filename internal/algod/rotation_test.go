@@ -0,0 +1,99 @@
+package algod
+
+import (
+	"testing"
+
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/internal/algod/participation"
+)
+
+func rotationKey(id string, address string, first int, last int) api.ParticipationKey {
+	return api.ParticipationKey{
+		Id:      id,
+		Address: address,
+		Key: api.AccountParticipation{
+			VoteParticipationKey: []byte(id),
+			VoteFirstValid:       first,
+			VoteLastValid:        last,
+		},
+	}
+}
+
+func onlineAccount(address string, key api.ParticipationKey) Account {
+	participation := key.Key
+	return Account{Address: address, Status: "Online", Participation: &participation}
+}
+
+func Test_PlanRotation(t *testing.T) {
+	opts := RotationOptions{Threshold: 1000, Rounds: 5000}
+
+	fresh := rotationKey("FRESH", "FRESH", 0, 10_000)
+	expiring := rotationKey("EXPIRING", "EXPIRING", 0, 5_500)
+	pendingOld := rotationKey("PENDING-OLD", "PENDING", 0, 5_500)
+	pendingNew := rotationKey("PENDING-NEW", "PENDING", 5_000, 10_000)
+	switchedOld := rotationKey("SWITCHED-OLD", "SWITCHED", 0, 5_500)
+	switchedNew := rotationKey("SWITCHED-NEW", "SWITCHED", 5_000, 10_000)
+	remote := rotationKey("REMOTE", "REMOTE", 0, 5_500)
+
+	keys := participation.List{fresh, expiring, pendingOld, pendingNew, switchedOld, switchedNew, remote}
+	accounts := map[string]Account{
+		"FRESH":    onlineAccount("FRESH", fresh),
+		"EXPIRING": onlineAccount("EXPIRING", expiring),
+		"PENDING":  onlineAccount("PENDING", pendingOld),
+		"SWITCHED": onlineAccount("SWITCHED", switchedNew),
+		"REMOTE":   onlineAccount("REMOTE", rotationKey("OTHER", "REMOTE", 0, 5_500)),
+		"OFFLINE":  {Address: "OFFLINE", Status: "Offline"},
+	}
+
+	steps := PlanRotation(accounts, keys, 5_000, opts)
+	actions := make(map[string]RotationStep)
+	for _, step := range steps {
+		actions[step.Address] = step
+	}
+	if len(steps) != len(accounts) {
+		t.Fatalf("expected one step per account, got %d", len(steps))
+	}
+
+	if actions["FRESH"].Action != RotationSkip {
+		t.Errorf("expected FRESH to be skipped, got %s", actions["FRESH"].Action)
+	}
+	if actions["OFFLINE"].Action != RotationSkip || actions["REMOTE"].Action != RotationSkip {
+		t.Error("expected offline and non-resident accounts to be skipped")
+	}
+
+	generate := actions["EXPIRING"]
+	if generate.Action != RotationGenerate || generate.Params == nil {
+		t.Fatalf("expected EXPIRING to generate a key, got %s", generate.Action)
+	}
+	if generate.Params.First != 5_000 || generate.Params.Last != 10_000 {
+		t.Errorf("expected the successor to overlap from the current round, got %d-%d", generate.Params.First, generate.Params.Last)
+	}
+
+	register := actions["PENDING"]
+	if register.Action != RotationRegister || register.Successor == nil || register.Successor.Id != "PENDING-NEW" {
+		t.Errorf("expected PENDING to register the new key, got %+v", register)
+	}
+
+	// Consensus keeps using the old key for KeyRegLookback rounds after the switch
+	pending := actions["SWITCHED"]
+	if pending.Action != RotationPending || pending.Key.Id != "SWITCHED-OLD" {
+		t.Errorf("expected SWITCHED to keep the old key, got %+v", pending)
+	}
+	switched := map[string]Account{"SWITCHED": accounts["SWITCHED"]}
+	steps = PlanRotation(switched, keys, 5_000+KeyRegLookback-1, opts)
+	if len(steps) != 1 || steps[0].Action != RotationPending {
+		t.Errorf("expected the old key to be kept until the lookback passed, got %+v", steps)
+	}
+	steps = PlanRotation(switched, keys, 5_000+KeyRegLookback, opts)
+	if len(steps) != 1 || steps[0].Action != RotationDelete || steps[0].Key.Id != "SWITCHED-OLD" {
+		t.Errorf("expected SWITCHED to delete the old key, got %+v", steps)
+	}
+
+	// The switch is counted from the round the key was registered from
+	effective := 6_000
+	keys[5].EffectiveFirstValid = &effective
+	steps = PlanRotation(switched, keys, 5_000+KeyRegLookback, opts)
+	if len(steps) != 1 || steps[0].Action != RotationPending {
+		t.Errorf("expected the old key to be kept after a late keyreg, got %+v", steps)
+	}
+}
@@ -0,0 +1,104 @@
+// Package samples stores the values a tracker samples from a node over time, in a JSON file per node.
+package samples
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Timed is a value sampled at a point in time.
+type Timed interface {
+	At() time.Time
+}
+
+// Path returns the file of a node in the dirName directory of the home directory.
+// The node is identified by its data directory or connection profile.
+func Path(dirName string, dataDir string, profile string) (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	name := "profile-" + profile
+	if dataDir != "" {
+		name = "datadir" + strings.ReplaceAll(filepath.Clean(dataDir), string(filepath.Separator), "_")
+	}
+	return filepath.Join(home, dirName, name+".json"), nil
+}
+
+// File is where a tracker is stored, it is embedded in the tracker and ignored by its JSON encoding.
+type File struct {
+	path string
+
+	// dirty is true when the tracker changed since it was read or written
+	dirty bool
+}
+
+// NewFile returns the File at path.
+func NewFile(path string) File {
+	return File{path: path}
+}
+
+// Read decodes the file into v, a missing file leaves v unchanged.
+func (f *File) Read(v any) error {
+	content, err := os.ReadFile(f.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	return json.Unmarshal(content, v)
+}
+
+// Write encodes v to the file, nothing is written unless Changed was called since the last Read or Write.
+func (f *File) Write(v any) error {
+	if !f.dirty {
+		return nil
+	}
+	content, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(f.path), 0o755)
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(f.path, content, 0o644)
+	if err == nil {
+		f.dirty = false
+	}
+	return err
+}
+
+// Changed marks the tracker to be written by the next Write.
+func (f *File) Changed() {
+	f.dirty = true
+}
+
+// Append adds the sample when the last sample is at least interval older, dropping the oldest samples past max.
+// It returns the samples and whether the sample was added.
+func Append[S Timed](list []S, sample S, interval time.Duration, max int) ([]S, bool) {
+	if len(list) > 0 && sample.At().Sub(list[len(list)-1].At()) < interval {
+		return list, false
+	}
+	list = append(list, sample)
+	if len(list) > max {
+		list = list[len(list)-max:]
+	}
+	return list, true
+}
+
+// Oldest returns the oldest sample at most window before latest, latest itself without such a sample.
+func Oldest[S Timed](list []S, latest S, window time.Duration) S {
+	first := latest
+	for i := len(list) - 1; i >= 0; i-- {
+		if latest.At().Sub(list[i].At()) > window {
+			break
+		}
+		first = list[i]
+	}
+	return first
+}
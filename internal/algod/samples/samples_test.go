@@ -0,0 +1,80 @@
+package samples
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+type sample time.Time
+
+func (s sample) At() time.Time {
+	return time.Time(s)
+}
+
+func Test_Path(t *testing.T) {
+	local, err := Path(".nodekit-test", "/var/lib/algorand", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	remote, err := Path(".nodekit-test", "", "relay")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if filepath.Base(local) != "datadir_var_lib_algorand.json" || filepath.Base(remote) != "profile-relay.json" {
+		t.Errorf("unexpected paths %s and %s", local, remote)
+	}
+	if filepath.Base(filepath.Dir(local)) != ".nodekit-test" {
+		t.Errorf("unexpected directory %s", local)
+	}
+}
+
+func Test_Append(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	var list []sample
+	var added bool
+	for i := 0; i < 10; i++ {
+		list, added = Append(list, sample(start.Add(time.Duration(i)*time.Second)), 2*time.Second, 3)
+		if added != (i%2 == 0) {
+			t.Errorf("unexpected added %v for sample %d", added, i)
+		}
+	}
+	if len(list) != 3 || list[0].At() != start.Add(4*time.Second) {
+		t.Errorf("unexpected samples %v", list)
+	}
+
+	latest := sample(start.Add(9 * time.Second))
+	if first := Oldest(list, latest, 3*time.Second); first.At() != start.Add(6*time.Second) {
+		t.Errorf("unexpected oldest sample %v", first.At())
+	}
+	if first := Oldest(nil, latest, time.Minute); first != latest {
+		t.Errorf("expected the latest sample without samples, got %v", first.At())
+	}
+}
+
+func Test_File(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "node.json")
+	f := NewFile(path)
+	value := map[string]int{"round": 1}
+	if err := f.Read(&value); err != nil || value["round"] != 1 {
+		t.Fatalf("expected a missing file to keep the value, got %v (%v)", value, err)
+	}
+
+	// Nothing changed
+	if err := f.Write(value); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Error("expected no file without changes")
+	}
+
+	f.Changed()
+	if err := f.Write(value); err != nil {
+		t.Fatal(err)
+	}
+	value = nil
+	if err := f.Read(&value); err != nil || value["round"] != 1 {
+		t.Errorf("unexpected value %v (%v)", value, err)
+	}
+}
@@ -0,0 +1,99 @@
+package algod
+
+import (
+	"sort"
+	"time"
+)
+
+// Snapshot is a serializable view of the StateModel at a point in time,
+// used by the headless commands to print the same data as the TUI.
+type Snapshot struct {
+	// Version is the version of NodeKit that captured the snapshot.
+	Version string `json:"version"`
+
+	// Status is the current status of the algod node, including the consensus upgrade votes.
+	Status Status `json:"status"`
+
+	// Metrics holds the derived runtime statistics of the node.
+	Metrics MetricsSnapshot `json:"metrics"`
+
+	// Accounts lists the accounts with participation keys on the node, sorted by address.
+	Accounts []AccountSnapshot `json:"accounts"`
+
+	// ParticipationKeys is the number of participation keys installed on the node.
+	ParticipationKeys int `json:"participationKeys"`
+}
+
+// MetricsSnapshot is the serializable form of Metrics.
+type MetricsSnapshot struct {
+	Enabled   bool    `json:"enabled"`
+	Window    int     `json:"window"`
+	RoundTime float64 `json:"roundTime"`
+	TPS       float64 `json:"tps"`
+	RX        uint64  `json:"rx"`
+	TX        uint64  `json:"tx"`
+	RXP2P     uint64  `json:"rxP2P"`
+	TXP2P     uint64  `json:"txP2P"`
+	PeersWS   uint64  `json:"peersWS"`
+	PeersP2P  uint64  `json:"peersP2P"`
+}
+
+// AccountSnapshot is the serializable form of Account.
+type AccountSnapshot struct {
+	Address           string     `json:"address"`
+	Nickname          string     `json:"nickname,omitempty"`
+	AuthAddr          string     `json:"authAddr,omitempty"`
+	Multisig          bool       `json:"multisig,omitempty"`
+	Status            string     `json:"status"`
+	Balance           int        `json:"balance"`
+	IncentiveEligible bool       `json:"incentiveEligible"`
+	NonResidentKey    bool       `json:"nonResidentKey"`
+	Keys              int        `json:"keys"`
+	VoteLastValid     int        `json:"voteLastValid,omitempty"`
+	Expires           *time.Time `json:"expires,omitempty"`
+}
+
+// Snapshot captures the current StateModel as a Snapshot.
+func (s *StateModel) Snapshot() Snapshot {
+	accounts := make([]AccountSnapshot, 0, len(s.Accounts))
+	for _, acct := range s.Accounts {
+		snap := AccountSnapshot{
+			Address:           acct.Address,
+			Nickname:          s.Nicknames[acct.Address],
+			AuthAddr:          acct.AuthAddr,
+			Multisig:          acct.IsMultisig(),
+			Status:            acct.Status,
+			Balance:           acct.Balance,
+			IncentiveEligible: acct.IncentiveEligible,
+			NonResidentKey:    acct.NonResidentKey,
+			Keys:              acct.Keys,
+			Expires:           acct.Expires,
+		}
+		if acct.Participation != nil {
+			snap.VoteLastValid = acct.Participation.VoteLastValid
+		}
+		accounts = append(accounts, snap)
+	}
+	sort.Slice(accounts, func(i, j int) bool {
+		return accounts[i].Address < accounts[j].Address
+	})
+
+	return Snapshot{
+		Version: s.Version,
+		Status:  s.Status,
+		Metrics: MetricsSnapshot{
+			Enabled:   s.Metrics.Enabled,
+			Window:    s.Metrics.Window,
+			RoundTime: s.Metrics.RoundTime.Seconds(),
+			TPS:       s.Metrics.TPS,
+			RX:        s.Metrics.RX,
+			TX:        s.Metrics.TX,
+			RXP2P:     s.Metrics.RXP2P,
+			TXP2P:     s.Metrics.TXP2P,
+			PeersWS:   s.Metrics.PeersWS,
+			PeersP2P:  s.Metrics.PeersP2P,
+		},
+		Accounts:          accounts,
+		ParticipationKeys: len(s.ParticipationKeys),
+	}
+}
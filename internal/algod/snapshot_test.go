@@ -0,0 +1,57 @@
+package algod
+
+import (
+	"testing"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/api"
+)
+
+func Test_Snapshot(t *testing.T) {
+	expires := time.Unix(1700000000, 0)
+	state := StateModel{
+		Version: "v1.0.0",
+		Status: Status{
+			State:     StableState,
+			LastRound: 1337,
+		},
+		Metrics: Metrics{
+			RoundTime: 2500 * time.Millisecond,
+			TPS:       4.2,
+			PeersWS:   4,
+		},
+		Accounts: map[string]Account{
+			"BBBB": {Address: "BBBB", Status: "Offline", Keys: 1},
+			"AAAA": {
+				Address:       "AAAA",
+				Status:        "Online",
+				Balance:       100,
+				Keys:          2,
+				Expires:       &expires,
+				Participation: &api.AccountParticipation{VoteLastValid: 2000},
+			},
+		},
+		Nicknames:         map[string]string{"AAAA": "validator"},
+		ParticipationKeys: []api.ParticipationKey{{Address: "AAAA"}, {Address: "AAAA"}, {Address: "BBBB"}},
+	}
+
+	snapshot := state.Snapshot()
+	if snapshot.Version != "v1.0.0" || snapshot.Status.LastRound != 1337 {
+		t.Error("expected the version and status to be copied")
+	}
+	if snapshot.Metrics.RoundTime != 2.5 {
+		t.Errorf("expected round time 2.5 seconds, got %f", snapshot.Metrics.RoundTime)
+	}
+	if snapshot.ParticipationKeys != 3 {
+		t.Errorf("expected 3 participation keys, got %d", snapshot.ParticipationKeys)
+	}
+	if len(snapshot.Accounts) != 2 || snapshot.Accounts[0].Address != "AAAA" {
+		t.Fatal("expected accounts to be sorted by address")
+	}
+	if snapshot.Accounts[0].Nickname != "validator" || snapshot.Accounts[0].VoteLastValid != 2000 {
+		t.Error("expected nickname and vote last valid to be set")
+	}
+	if snapshot.Accounts[1].VoteLastValid != 0 || snapshot.Accounts[1].Expires != nil {
+		t.Error("expected empty participation for offline account")
+	}
+}
@@ -2,7 +2,6 @@ package algod
 
 import (
 	"context"
-	"time"
 
 	"github.com/algorandfoundation/nodekit/api"
 	"github.com/algorandfoundation/nodekit/internal/algod/config"
@@ -42,9 +41,8 @@ type StateModel struct {
 	// admin privileges or capabilities enabled.
 	Admin bool
 
-	// Watching indicates whether the StateModel is actively monitoring
-	// changes or processes in a background loop.
-	// TODO: handle contexts instead of adding it to state (skill-issue zero)
+	// Watching indicates whether a Watcher is actively monitoring
+	// changes in a background loop. It is managed by Watcher.Run.
 	Watching bool
 
 	// Whether user has disabled automatically applying incentive eligibility fees
@@ -59,7 +57,6 @@ type StateModel struct {
 
 	// Context provides a context for managing cancellation,
 	// deadlines, and request-scoped values in StateModel operations.
-	// TODO: implement more of the context
 	Context context.Context
 
 	// Algod Config
@@ -117,91 +114,6 @@ func NewStateModel(ctx context.Context, client api.ClientWithResponsesInterface,
 	}, partkeysResponse, nil
 }
 
-// waitAfterError updates the state to "DOWN", invokes the callback with an error, and pauses for a fixed duration if an error occurs.
-// TODO: handle in context loop
-func (s *StateModel) waitAfterError(err error, cb func(model *StateModel, err error)) {
-	if err != nil {
-		s.Status.State = "DOWN"
-		cb(nil, err)
-		time.Sleep(time.Second * 3)
-	}
-}
-
-// Watch starts monitoring the state in a loop, invoking a callback with updates or errors as they occur.
-// TODO: allow context to handle loop
-func (s *StateModel) Watch(cb func(model *StateModel, err error), ctx context.Context, t system.Time) {
-	var err error
-
-	// Setup Defaults
-	s.Watching = true
-	if s.Metrics.Window == 0 {
-		s.Metrics.Window = 100
-	}
-
-	// Fetch the latest Status
-	s.Status, _, err = s.Status.Get(ctx)
-	if err != nil {
-		// callback immediately on error
-		cb(nil, err)
-	}
-
-	// The main Loop
-	// TODO: Refactor to Context
-	for {
-		if !s.Watching {
-			break
-		}
-
-		// Abort on Fast-Catchup
-		if s.Status.State == FastCatchupState {
-			// Update current render
-			cb(s, nil)
-			// Wait for a while
-			time.Sleep(time.Second * 2)
-			// Check status
-			s.Status, _, err = s.Status.Get(ctx)
-			// Report errors
-			if err != nil {
-				cb(nil, err)
-			}
-			// Update render after status fetch
-			cb(s, nil)
-			continue
-		}
-		// Fetch Keys
-		s.UpdateKeys(ctx, t)
-		cb(s, nil)
-
-		// Wait for the next block
-		s.Status, _, err = s.Status.Wait(ctx)
-		s.waitAfterError(err, cb)
-		if err != nil {
-			continue
-		}
-
-		if s.Status.State == SyncingState {
-			cb(s, nil)
-			continue
-		}
-		// Run Round Averages and RX/TX every 5 rounds
-		if s.Status.LastRound%5 == 0 {
-			s.Metrics, _, err = s.Metrics.Get(ctx, s.Status.LastRound)
-			s.waitAfterError(err, cb)
-			if err != nil {
-				continue
-			}
-		}
-
-		// Callback the current state to the app
-		cb(s, nil)
-	}
-}
-
-// Stop halts the monitoring process by setting the Watching field to false.
-func (s *StateModel) Stop() {
-	s.Watching = false
-}
-
 // UpdateKeys retrieves and updates participation keys, manages admin status, and synchronizes account data with the node.
 func (s *StateModel) UpdateKeys(ctx context.Context, t system.Time) {
 	var err error
@@ -32,17 +32,22 @@ func Test_StateModel(t *testing.T) {
 		Client:  client,
 		Context: context.Background(),
 	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	watcher := NewWatcher(&state, new(mock.Clock))
+	events := watcher.Subscribe(ctx, 1)
+	stopped := make(chan error)
+	go func() { stopped <- watcher.Run(ctx) }()
+
 	count := 0
-	go state.Watch(func(model *StateModel, err error) {
-		if err != nil || model == nil {
+	for event := range events {
+		if event.Type == NodeDownEvent {
 			t.Error("Failed")
-			return
 		}
 		count++
-	}, context.Background(), new(mock.Clock))
-	time.Sleep(5 * time.Second)
-	// Stop the watcher
-	state.Stop()
+	}
+	// Wait until the watcher stops writing to the state
+	<-stopped
 	if count == 0 {
 		t.Fatal("Did not receive any updates")
 	}
@@ -37,6 +37,9 @@ type Status struct {
 	// Network represents the name of the network the status is associated with.
 	Network string `json:"network"`
 
+	// GenesisHash is the base64 genesis hash of the network.
+	GenesisHash string `json:"genesisHash"`
+
 	// Consensus upgrade voting related fields
 	UpgradeVoteRounds    int `json:"upgradeVoteRounds"`
 	UpgradeYesVotes      int `json:"upgradeYesVotes"`
@@ -45,6 +48,22 @@ type Status struct {
 	UpgradeVotesRequired int `json:"upgradeVotesRequired"`
 	NextVersionRound     int `json:"nextVersionRound"`
 
+	// UpgradeVoteBefore is the round the vote on the proposed protocol ends, 0 when no vote is running.
+	UpgradeVoteBefore int `json:"upgradeVoteBefore"`
+
+	// UpgradeDelay is the number of rounds between the end of a successful vote and the protocol switch.
+	UpgradeDelay int `json:"upgradeDelay"`
+
+	// UpgradeNodeVote is the approval flag of the last block, it is the vote of its proposer and not of this node.
+	UpgradeNodeVote bool `json:"upgradeNodeVote"`
+
+	// NextVersion is the protocol voted on or scheduled, read from the upgrade state of the last block header.
+	// It is the current protocol when no upgrade is pending.
+	NextVersion string `json:"nextVersion"`
+
+	// NextVersionSupported indicates whether the installed algod supports NextVersion, checked against its consensus protocols.
+	NextVersionSupported bool `json:"nextVersionSupported"`
+
 	// NeedsUpdate indicates whether the system requires an update based on the current version and available release data.
 	NeedsUpdate bool `json:"needsUpdate"`
 
@@ -85,6 +104,9 @@ func (s Status) Update(status Status) Status {
 	if s.Network != status.Network {
 		s.Network = status.Network
 	}
+	if s.GenesisHash != status.GenesisHash {
+		s.GenesisHash = status.GenesisHash
+	}
 	if s.UpgradeVoteRounds != status.UpgradeVoteRounds {
 		s.UpgradeVoteRounds = status.UpgradeVoteRounds
 	}
@@ -103,6 +125,21 @@ func (s Status) Update(status Status) Status {
 	if s.NextVersionRound != status.NextVersionRound {
 		s.NextVersionRound = status.NextVersionRound
 	}
+	if s.UpgradeVoteBefore != status.UpgradeVoteBefore {
+		s.UpgradeVoteBefore = status.UpgradeVoteBefore
+	}
+	if s.UpgradeDelay != status.UpgradeDelay {
+		s.UpgradeDelay = status.UpgradeDelay
+	}
+	if s.UpgradeNodeVote != status.UpgradeNodeVote {
+		s.UpgradeNodeVote = status.UpgradeNodeVote
+	}
+	if s.NextVersion != status.NextVersion {
+		s.NextVersion = status.NextVersion
+	}
+	if s.NextVersionSupported != status.NextVersionSupported {
+		s.NextVersionSupported = status.NextVersionSupported
+	}
 	if s.NeedsUpdate != status.NeedsUpdate {
 		s.NeedsUpdate = status.NeedsUpdate
 	}
@@ -154,20 +191,31 @@ func (s Status) Merge(res api.StatusLike) Status {
 		s.State = StableState
 	}
 
+	// The next version is the proposed protocol while voting and the scheduled protocol once the vote passed
+	s.NextVersion = res.NextVersion
+	s.NextVersionSupported = res.NextVersionSupported
+	s.NextVersionRound = res.NextVersionRound
 	if res.UpgradeNextProtocolVoteBefore != nil {
+		s.UpgradeVoteBefore = *res.UpgradeNextProtocolVoteBefore
 		s.UpgradeVoteRounds = *res.UpgradeVoteRounds
 		s.UpgradeYesVotes = *res.UpgradeYesVotes
 		s.UpgradeNoVotes = *res.UpgradeNoVotes
 		s.UpgradeVotes = *res.UpgradeVotes
 		s.UpgradeVotesRequired = *res.UpgradeVotesRequired
-		s.NextVersionRound = res.NextVersionRound
+		s.UpgradeDelay = 0
+		if res.UpgradeDelay != nil {
+			s.UpgradeDelay = *res.UpgradeDelay
+		}
+		s.UpgradeNodeVote = res.UpgradeNodeVote != nil && *res.UpgradeNodeVote
 	} else {
+		s.UpgradeVoteBefore = 0
 		s.UpgradeVoteRounds = 0
 		s.UpgradeYesVotes = 0
 		s.UpgradeNoVotes = 0
 		s.UpgradeVotes = 0
 		s.UpgradeVotesRequired = 0
-		s.NextVersionRound = 0
+		s.UpgradeDelay = 0
+		s.UpgradeNodeVote = false
 	}
 
 	return s
@@ -198,6 +246,7 @@ func NewStatus(ctx context.Context, client api.ClientWithResponsesInterface, htt
 		return status, versionResponse.(api.ResponseInterface), err
 	}
 	status.Network = v.Network
+	status.GenesisHash = v.GenesisHash
 	status.Version = v.Version
 	status.NeedsUpdate = false
 
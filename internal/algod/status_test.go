@@ -54,6 +54,30 @@ func Test_StatusModel(t *testing.T) {
 		t.Errorf("expected State: %s, got %s", FastCatchupState, m.State)
 	}
 
+	// A vote reports the tally, a passed vote schedules the next version
+	voteBefore, voteRounds, yes, no, required, delay, nodeVote := 20, 10, 6, 0, 9, 100, true
+	m = m.Merge(api.StatusLike{
+		LastRound:                     16,
+		LastVersion:                   "current",
+		NextVersion:                   "current",
+		NextVersionRound:              17,
+		NextVersionSupported:          true,
+		UpgradeNextProtocolVoteBefore: &voteBefore,
+		UpgradeVoteRounds:             &voteRounds,
+		UpgradeYesVotes:               &yes,
+		UpgradeNoVotes:                &no,
+		UpgradeVotes:                  &yes,
+		UpgradeVotesRequired:          &required,
+		UpgradeDelay:                  &delay,
+		UpgradeNodeVote:               &nodeVote,
+	})
+	if m.UpgradeVoteBefore != 20 || m.UpgradeDelay != 100 || !m.UpgradeNodeVote || m.UpgradeYesVotes != 6 {
+		t.Errorf("expected the vote tally, got %+v", m)
+	}
+	m = m.Merge(api.StatusLike{LastRound: 21, LastVersion: "current", NextVersion: "future", NextVersionRound: 120})
+	if m.UpgradeVoteBefore != 0 || m.NextVersion != "future" || m.NextVersionRound != 120 || m.NextVersionSupported {
+		t.Errorf("expected the scheduled upgrade, got %+v", m)
+	}
 }
 
 func Test_StatusFetch(t *testing.T) {
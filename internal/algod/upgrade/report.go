@@ -0,0 +1,170 @@
+package upgrade
+
+import (
+	"math"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/algod/samples"
+)
+
+// Phase is the stage of a protocol upgrade.
+type Phase string
+
+const (
+	// NoPhase means no upgrade is proposed.
+	NoPhase Phase = "none"
+
+	// VotingPhase means the network is voting on a proposed protocol.
+	VotingPhase Phase = "voting"
+
+	// ScheduledPhase means the vote passed and the network switches protocol at a known round.
+	ScheduledPhase Phase = "scheduled"
+)
+
+// Report is the projection of a protocol upgrade, see Tracker.Report.
+type Report struct {
+	Phase Phase `json:"phase"`
+
+	// Protocol is the current protocol of the network.
+	Protocol string `json:"protocol"`
+
+	// NextProtocol is the proposed or scheduled protocol, from the upgrade state of the last block header.
+	NextProtocol string `json:"nextProtocol,omitempty"`
+
+	// Supported is true when the installed algod supports NextProtocol, nil when algod does not report the proposed protocol.
+	Supported *bool `json:"supported,omitempty"`
+
+	Yes        int `json:"yes"`
+	No         int `json:"no"`
+	Required   int `json:"required"`
+	VoteRounds int `json:"voteRounds"`
+	VoteBefore int `json:"voteBefore,omitempty"`
+	RoundsLeft int `json:"roundsLeft"`
+
+	// YesShare is the share of yes votes over the RateWindow, or since the start of the vote when there are no samples.
+	YesShare float64 `json:"yesShare"`
+
+	// ProjectedYes is the number of yes votes at the end of the vote at the current YesShare.
+	ProjectedYes int `json:"projectedYes"`
+
+	// Passes is true when the vote passed or is projected to pass.
+	Passes bool `json:"passes"`
+
+	// Decided is true when the remaining rounds cannot change the outcome of the vote.
+	Decided bool `json:"decided"`
+
+	// VoteEnd is the estimated end of the vote, nil when the round time is unknown.
+	VoteEnd *time.Time `json:"voteEnd,omitempty"`
+
+	// SwitchRound is the first round of the next protocol, if the vote passes.
+	SwitchRound int `json:"switchRound,omitempty"`
+
+	// Switch is the estimated time of the protocol switch, nil when the round time is unknown.
+	Switch *time.Time `json:"switch,omitempty"`
+
+	// Blocking is true when the node stalls at the switch unless algod is upgraded.
+	Blocking bool `json:"blocking"`
+}
+
+// IsPending is true while an upgrade is voted on or scheduled.
+func (r Report) IsPending() bool {
+	return r.Phase != NoPhase
+}
+
+// IsUnsupported is true when the installed algod is known not to support the next protocol.
+func (r Report) IsUnsupported() bool {
+	return r.Supported != nil && !*r.Supported
+}
+
+// PhaseOf returns the upgrade phase of a status.
+func PhaseOf(status algod.Status) Phase {
+	if status.UpgradeVoteBefore > 0 {
+		return VotingPhase
+	}
+	if status.NextVersion != "" && status.NextVersion != status.LastProtocolVersion && status.NextVersionRound > int(status.LastRound) {
+		return ScheduledPhase
+	}
+	return NoPhase
+}
+
+// Report projects the upgrade of the status, roundTime is the measured round time used for the estimated times.
+func (t *Tracker) Report(now time.Time, status algod.Status, roundTime time.Duration) Report {
+	report := Report{
+		Phase:    PhaseOf(status),
+		Protocol: status.LastProtocolVersion,
+	}
+	estimate := func(round int) *time.Time {
+		if roundTime <= 0 {
+			return nil
+		}
+		at := now.Add(time.Duration(max(0, round-int(status.LastRound))) * roundTime)
+		return &at
+	}
+
+	switch report.Phase {
+	case ScheduledPhase:
+		supported := status.NextVersionSupported
+		report.NextProtocol = status.NextVersion
+		report.Supported = &supported
+		report.Passes = true
+		report.Decided = true
+		report.SwitchRound = status.NextVersionRound
+		report.Switch = estimate(report.SwitchRound)
+	case VotingPhase:
+		// The upgrade state holds the proposed protocol while voting, the vote flags of a block are its proposer's
+		if status.NextVersion != "" && status.NextVersion != status.LastProtocolVersion {
+			supported := status.NextVersionSupported
+			report.NextProtocol = status.NextVersion
+			report.Supported = &supported
+		}
+		report.Yes = status.UpgradeYesVotes
+		report.No = status.UpgradeNoVotes
+		report.Required = status.UpgradeVotesRequired
+		report.VoteRounds = status.UpgradeVoteRounds
+		report.VoteBefore = status.UpgradeVoteBefore
+		report.RoundsLeft = max(0, status.UpgradeVoteBefore-int(status.LastRound))
+		report.YesShare = t.yesShare(status)
+		report.ProjectedYes = report.Yes + int(math.Round(report.YesShare*float64(report.RoundsLeft)))
+		report.VoteEnd = estimate(report.VoteBefore)
+		report.SwitchRound = status.UpgradeVoteBefore + status.UpgradeDelay
+		report.Switch = estimate(report.SwitchRound)
+
+		switch {
+		case report.Yes >= report.Required:
+			report.Passes, report.Decided = true, true
+		case report.No > report.VoteRounds-report.Required:
+			report.Passes, report.Decided = false, true
+		default:
+			report.Passes = report.ProjectedYes >= report.Required
+		}
+	default:
+		supported := true
+		report.Supported = &supported
+	}
+
+	report.Blocking = report.Passes && report.IsUnsupported()
+	return report
+}
+
+// yesShare returns the share of yes votes over the RateWindow, the whole tally is used without samples of the vote.
+func (t *Tracker) yesShare(status algod.Status) float64 {
+	yes, no := status.UpgradeYesVotes, status.UpgradeNoVotes
+	if t.VoteBefore == status.UpgradeVoteBefore && len(t.Samples) > 0 {
+		first := samples.Oldest(t.Samples, t.Latest, RateWindow)
+		if cast := t.Latest.Yes + t.Latest.No - first.Yes - first.No; cast > 0 {
+			yes, no = t.Latest.Yes-first.Yes, t.Latest.No-first.No
+		}
+	}
+	if yes+no == 0 {
+		return 0
+	}
+	return float64(yes) / float64(yes+no)
+}
+
+// Project reports the upgrade of a single status, without the history of the vote.
+func Project(now time.Time, status algod.Status, roundTime time.Duration) Report {
+	t := &Tracker{}
+	t.Record(now, status)
+	return t.Report(now, status, roundTime)
+}
@@ -0,0 +1,105 @@
+package upgrade
+
+import (
+	"time"
+
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/algod/samples"
+)
+
+// DirName is the directory in the home directory holding an upgrade vote file per node.
+const DirName = ".nodekit-upgrade"
+
+// SampleInterval is the minimum time between two stored samples.
+const SampleInterval = time.Minute
+
+// MaxSamples is the number of samples kept, the oldest samples are dropped first.
+// A vote of 10000 rounds lasts about 8 hours, one sample a minute keeps it entirely.
+const MaxSamples = 720
+
+// RateWindow is how far back the samples are used to compute the share of yes votes.
+const RateWindow = time.Hour
+
+// Sample is the vote tally reported by the node at a point in time.
+type Sample struct {
+	Time  time.Time `json:"time"`
+	Round uint64    `json:"round"`
+	Yes   int       `json:"yes"`
+	No    int       `json:"no"`
+}
+
+// NewSample captures the vote tally of a status.
+func NewSample(now time.Time, status algod.Status) Sample {
+	return Sample{
+		Time:  now,
+		Round: status.LastRound,
+		Yes:   status.UpgradeYesVotes,
+		No:    status.UpgradeNoVotes,
+	}
+}
+
+// At returns when the sample was taken.
+func (s Sample) At() time.Time {
+	return s.Time
+}
+
+// Tracker records the tally of a protocol upgrade vote over time.
+type Tracker struct {
+	// VoteBefore identifies the vote being tracked, a different vote starts a new tracker.
+	VoteBefore int `json:"voteBefore"`
+
+	// Protocol is the protocol the vote proposes to upgrade from.
+	Protocol string `json:"protocol"`
+
+	// Started is when the vote was first seen.
+	Started time.Time `json:"started"`
+
+	// Latest is the last recorded sample, it is stored even when it is too close to the previous sample.
+	Latest Sample `json:"latest"`
+
+	// Samples are stored at most every SampleInterval.
+	Samples []Sample `json:"samples"`
+
+	samples.File
+}
+
+// Path returns the upgrade vote file of a node, identified by its data directory or connection profile.
+func Path(dataDir string, profile string) (string, error) {
+	return samples.Path(DirName, dataDir, profile)
+}
+
+// Load reads the tracker stored at path, a missing file returns an empty tracker.
+func Load(path string) (*Tracker, error) {
+	t := &Tracker{File: samples.NewFile(path)}
+	return t, t.Read(t)
+}
+
+// Save writes the tracker to the path it was loaded from.
+// Nothing is written unless a sample was added since it was loaded or saved.
+func (t *Tracker) Save() error {
+	return t.Write(t)
+}
+
+// Record adds the vote tally of the node, it returns false when no vote is running.
+// The samples of a finished vote are kept until the next vote starts.
+func (t *Tracker) Record(now time.Time, status algod.Status) bool {
+	if status.UpgradeVoteBefore == 0 {
+		return false
+	}
+	if status.UpgradeVoteBefore != t.VoteBefore {
+		*t = Tracker{
+			VoteBefore: status.UpgradeVoteBefore,
+			Protocol:   status.LastProtocolVersion,
+			Started:    now,
+			File:       t.File,
+		}
+	}
+	sample := NewSample(now, status)
+	t.Latest = sample
+	var added bool
+	t.Samples, added = samples.Append(t.Samples, sample, SampleInterval, MaxSamples)
+	if added {
+		t.Changed()
+	}
+	return true
+}
@@ -0,0 +1,141 @@
+package upgrade
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/internal/algod"
+)
+
+func votingStatus(round uint64, yes int, no int, supported bool) algod.Status {
+	return algod.Status{
+		LastRound:            round,
+		LastProtocolVersion:  "current",
+		NextVersion:          "future",
+		NextVersionRound:     160_000,
+		NextVersionSupported: supported,
+		UpgradeVoteBefore:    20_000,
+		UpgradeVoteRounds:    10_000,
+		UpgradeVotesRequired: 9_000,
+		UpgradeDelay:         140_000,
+		UpgradeYesVotes:      yes,
+		UpgradeNoVotes:       no,
+		UpgradeVotes:         yes + no,
+		// The approval of the last proposer, which says nothing about this node
+		UpgradeNodeVote: !supported,
+	}
+}
+
+func Test_Tracker(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	path, err := Path("/var/lib/algorand", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tracker, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	roundTime := 3 * time.Second
+
+	// Nothing to track without a vote
+	if tracker.Record(start, algod.Status{LastRound: 100, NextVersionRound: 101}) {
+		t.Error("expected no vote to track")
+	}
+	report := tracker.Report(start, algod.Status{LastRound: 100, NextVersionRound: 101}, roundTime)
+	if report.IsPending() || report.Blocking {
+		t.Errorf("expected no pending upgrade, got %+v", report)
+	}
+
+	// Early votes are all yes, then the network turns against the upgrade
+	tracker.Record(start, votingStatus(10_000, 0, 0, true))
+	tracker.Record(start.Add(10*time.Minute), votingStatus(10_200, 200, 0, true))
+	tracker.Record(start.Add(20*time.Minute), votingStatus(10_400, 250, 150, true))
+	if len(tracker.Samples) != 3 || tracker.Protocol != "current" {
+		t.Errorf("unexpected tracker %+v", tracker)
+	}
+	report = tracker.Report(start.Add(20*time.Minute), votingStatus(10_400, 250, 150, true), roundTime)
+	if report.Phase != VotingPhase || report.RoundsLeft != 9_600 || report.YesShare != 250.0/400 {
+		t.Errorf("unexpected tally %+v", report)
+	}
+	if report.Passes || report.Decided || report.Blocking {
+		t.Errorf("expected the vote to be projected to fail, got %+v", report)
+	}
+	if report.SwitchRound != 160_000 || !report.Switch.Equal(start.Add(20*time.Minute).Add(149_600*roundTime)) {
+		t.Errorf("unexpected switch %d at %v", report.SwitchRound, report.Switch)
+	}
+
+	// Save and load
+	err = tracker.Save()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tracker, err = Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tracker.VoteBefore != 20_000 || len(tracker.Samples) != 3 {
+		t.Errorf("unexpected tracker after loading %+v", tracker)
+	}
+
+	// The last hour is all yes, the vote is projected to pass on a node which does not support it
+	later := start.Add(3 * time.Hour)
+	tracker.Record(later.Add(-time.Hour), votingStatus(12_000, 1_000, 1_000, false))
+	tracker.Record(later, votingStatus(13_200, 2_200, 1_000, false))
+	report = tracker.Report(later, votingStatus(13_200, 2_200, 1_000, false), roundTime)
+	if report.YesShare != 1 || report.ProjectedYes != 9_000 || !report.Passes || report.Decided {
+		t.Errorf("expected the vote to be projected to pass, got %+v", report)
+	}
+	if !report.Blocking || report.VoteEnd == nil {
+		t.Errorf("expected a blocking upgrade, got %+v", report)
+	}
+
+	// Without the proposed protocol the support is unknown and never blocks
+	unknown := votingStatus(13_200, 2_200, 1_000, false)
+	unknown.NextVersion = unknown.LastProtocolVersion
+	report = tracker.Report(later, unknown, roundTime)
+	if report.Supported != nil || report.Blocking || !report.Passes {
+		t.Errorf("expected an unknown support, got %+v", report)
+	}
+
+	// Too many no votes decide the vote
+	report = Project(later, votingStatus(13_200, 2_000, 1_001, false), roundTime)
+	if report.Passes || !report.Decided || report.Blocking {
+		t.Errorf("expected a failed vote, got %+v", report)
+	}
+
+	// A new vote starts over
+	status := votingStatus(30_000, 0, 0, true)
+	status.UpgradeVoteBefore = 40_000
+	tracker.Record(later.Add(time.Hour), status)
+	if tracker.VoteBefore != 40_000 || len(tracker.Samples) != 1 {
+		t.Errorf("expected a new tracker, got %+v", tracker)
+	}
+
+	// A scheduled upgrade relies on the support reported by algod
+	scheduled := algod.Status{LastRound: 50_000, LastProtocolVersion: "current", NextVersion: "future", NextVersionRound: 60_000}
+	report = Project(later, scheduled, 0)
+	if report.Phase != ScheduledPhase || report.NextProtocol != "future" || !report.Blocking || !report.IsUnsupported() || report.Switch != nil {
+		t.Errorf("expected a blocking scheduled upgrade, got %+v", report)
+	}
+	scheduled.NextVersionSupported = true
+	if report = Project(later, scheduled, roundTime); report.Blocking || report.Switch == nil {
+		t.Errorf("expected a supported scheduled upgrade, got %+v", report)
+	}
+}
+
+func Test_Path(t *testing.T) {
+	local, err := Path("/var/lib/algorand", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	remote, err := Path("", "relay")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if filepath.Base(local) != "datadir_var_lib_algorand.json" || filepath.Base(remote) != "profile-relay.json" {
+		t.Errorf("unexpected paths %s and %s", local, remote)
+	}
+}
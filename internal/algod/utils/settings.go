@@ -2,6 +2,7 @@ package utils
 
 import (
 	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
 
@@ -17,6 +18,121 @@ type Settings struct {
 	// AccountNicknames maps an account address to a user-defined local nickname.
 	// These are a display convenience only and never leave the local machine.
 	AccountNicknames map[string]string `json:",omitempty"`
+	// Alerts configures the participation key expiry thresholds and notification sinks.
+	Alerts *AlertSettings `json:",omitempty"`
+	// Profiles are named connections to algod nodes whose data directory is not on this machine.
+	Profiles map[string]ProfileSettings `json:",omitempty"`
+	// Networks are custom or private networks, they take precedence over the built-in networks.
+	Networks []NetworkSettings `json:",omitempty"`
+	// Catchpoint configures the verification of catchpoints against several sources.
+	Catchpoint *CatchpointSettings `json:",omitempty"`
+	// Instances are named algod nodes running side by side on this machine, each with its own data directory and service.
+	Instances map[string]InstanceSettings `json:",omitempty"`
+}
+
+// InstanceSettings is a named algod node on this machine.
+type InstanceSettings struct {
+	// DataDir is the data directory of the instance.
+	DataDir string
+	// Network is the network the instance joins, e.g. testnet.
+	Network string
+	// Port is the port of the REST API, it is unique across the instances.
+	Port int
+}
+
+// CatchpointSettings requires a quorum of sources to agree on a catchpoint before a fast catchup.
+// The catchpoint service of the network is always one of the sources.
+type CatchpointSettings struct {
+	// Verify verifies every fast catchup, otherwise only when requested with --verify.
+	Verify bool `json:",omitempty"`
+	// Quorum is the number of sources that must agree, defaults to a majority of the sources.
+	Quorum int `json:",omitempty"`
+	// Sources are the additional sources of catchpoints.
+	Sources []CatchpointSourceSettings `json:",omitempty"`
+}
+
+// CatchpointSourceSettings is a catchpoint URL or a trusted algod node, set either URL or Profile.
+type CatchpointSourceSettings struct {
+	// Name identifies the source in the logs.
+	Name string `json:",omitempty"`
+	// Network is the genesis ID the source applies to, empty for every network.
+	Network string `json:",omitempty"`
+	// URL returns the label of the latest catchpoint.
+	URL string `json:",omitempty"`
+	// RoundURL returns the label of the catchpoint at a round, %d is replaced by the round.
+	// It lets the source confirm the catchpoint of a source which is one catchpoint behind.
+	RoundURL string `json:",omitempty"`
+	// Profile is a connection profile of a trusted algod node, the last catchpoint of the node is used.
+	// The node must track catchpoints, with Archival or CatchpointTracking set to 1 or 2.
+	Profile string `json:",omitempty"`
+}
+
+// NetworkSettings describes the services of a network, identified by its genesis ID and hash.
+type NetworkSettings struct {
+	// GenesisID is the genesis ID reported by algod, e.g. mynet-v1.
+	GenesisID string
+	// GenesisHash is the base64 genesis hash, when empty every network with the GenesisID matches.
+	GenesisHash string `json:",omitempty"`
+	// CatchpointURL returns the latest catchpoint label of the network, fast catchup is unavailable when empty.
+	CatchpointURL string `json:",omitempty"`
+	// LoraNetwork is the network name used by lora and the short link service, e.g. localnet.
+	LoraNetwork string `json:",omitempty"`
+	// LaggingThreshold is the number of rounds behind the latest catchpoint before the node is lagging.
+	LaggingThreshold int `json:",omitempty"`
+}
+
+// ProfileSettings is the connection to a remote algod node.
+type ProfileSettings struct {
+	// Endpoint is the algod API URL, e.g. https://node.example.com:8080
+	Endpoint string
+	// Token is the algod admin API token.
+	Token string
+	// TokenHeader is the header the token is sent in, defaults to X-Algo-API-Token.
+	TokenHeader string `json:",omitempty"`
+	// CACert is the path to a PEM encoded CA bundle used to verify the endpoint's TLS certificate.
+	CACert string `json:",omitempty"`
+	// Proxy is the URL of an HTTP proxy used to reach the endpoint.
+	Proxy string `json:",omitempty"`
+}
+
+// AlertSettings configures when participation key expiry alerts are raised and where they are sent.
+type AlertSettings struct {
+	// Thresholds overrides the default expiry thresholds when set.
+	Thresholds []AlertThresholdSettings `json:",omitempty"`
+	// Webhooks receive each alert as a JSON POST request.
+	Webhooks []WebhookSettings `json:",omitempty"`
+	// SMTP sends each alert as an email.
+	SMTP *SMTPSettings `json:",omitempty"`
+	// Commands are local programs run for each alert, receiving it as JSON on stdin.
+	Commands []CommandSettings `json:",omitempty"`
+}
+
+// AlertThresholdSettings raises an alert when a key expires within Days, zero means the key has expired.
+type AlertThresholdSettings struct {
+	Name  string `json:",omitempty"`
+	Days  int
+	Level string `json:",omitempty"`
+}
+
+// WebhookSettings is a generic HTTP endpoint with optional request headers.
+type WebhookSettings struct {
+	URL     string
+	Headers map[string]string `json:",omitempty"`
+}
+
+// SMTPSettings is the mail server and recipients for email alerts.
+type SMTPSettings struct {
+	Host     string
+	Port     int
+	Username string `json:",omitempty"`
+	Password string `json:",omitempty"`
+	From     string
+	To       []string
+}
+
+// CommandSettings is a local command hook, the first element is the program to run.
+type CommandSettings struct {
+	Command []string
 }
 
 func GetNodekitSettings() (Settings, error) {
@@ -58,15 +174,33 @@ func WriteNodekitSettings(settings Settings) error {
 	if err != nil {
 		return err
 	}
-	err = os.WriteFile(settingsFile, newSettings, 0o664)
+	// The settings hold the tokens of the profiles and the SMTP password, only the owner may read them
+	err = os.WriteFile(settingsFile, newSettings, 0o600)
 	if err != nil {
 		return err
 	}
 
 	// If we're sudo'ed set permissions/ownership on the config file
 	if system.IsSudo() {
-		return setFilePermissions(settingsFile)
+		return setFilePermissions(settingsFile, 0o600)
 	}
 
-	return nil
+	// WriteFile keeps the mode of an existing file
+	return os.Chmod(settingsFile, 0o600)
+}
+
+// GetProfile returns the named connection profile from the NodeKit settings.
+func GetProfile(name string) (ProfileSettings, error) {
+	settings, err := GetNodekitSettings()
+	if err != nil {
+		return ProfileSettings{}, err
+	}
+	profile, ok := settings.Profiles[name]
+	if !ok {
+		return profile, fmt.Errorf("profile %s not found in ~/%s", name, NodeKitSettingsJSONFile)
+	}
+	if profile.Endpoint == "" {
+		return profile, fmt.Errorf("profile %s has no endpoint", name)
+	}
+	return profile, nil
 }
@@ -1,6 +1,8 @@
 package utils
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -53,3 +55,43 @@ func Test_Nicknames(t *testing.T) {
 		t.Fatalf("expected nickname to be cleared, got %q", names[addr])
 	}
 }
+
+func Test_GetProfile(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	_, err := GetProfile("remote")
+	if err == nil {
+		t.Fatal("expected a missing profile to fail")
+	}
+
+	// A settings file written by an older version is made private
+	err = os.WriteFile(filepath.Join(os.Getenv("HOME"), NodeKitSettingsJSONFile), []byte("{}"), 0o664)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = WriteNodekitSettings(Settings{Profiles: map[string]ProfileSettings{
+		"remote": {Endpoint: "https://node.example.com", Token: "admin"},
+		"broken": {Token: "admin"},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err := os.Stat(filepath.Join(os.Getenv("HOME"), NodeKitSettingsJSONFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Errorf("expected the settings to be private, got %s", info.Mode().Perm())
+	}
+	profile, err := GetProfile("remote")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if profile.Endpoint != "https://node.example.com" || profile.Token != "admin" {
+		t.Errorf("unexpected profile %+v", profile)
+	}
+	_, err = GetProfile("broken")
+	if err == nil {
+		t.Error("expected a profile without endpoint to fail")
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/algorandfoundation/nodekit/internal/algod/backup"
 	"github.com/algorandfoundation/nodekit/internal/algod/config"
 	"github.com/algorandfoundation/nodekit/internal/algod/telemetry"
 	"github.com/algorandfoundation/nodekit/internal/system"
@@ -152,6 +153,34 @@ func GetPidFromDataDir(path string) (int, error) {
 	return pid, nil
 }
 
+// IsPidRunning checks if the process in the algod.pid file of the data directory exists.
+func IsPidRunning(path string) bool {
+	pid, err := GetPidFromDataDir(path)
+	if err != nil || pid == 0 {
+		return false
+	}
+
+	// The syscall.Kill function with signal 0 checks for process existence and permissions.
+	// It doesn't actually kill the process.
+	err = syscall.Kill(pid, syscall.Signal(0))
+	if err != nil {
+		// ESRCH means "No such process".
+		// EPERM means "operation not permitted"
+		// i.e. you're not root, which is fine, since we just want to know if the process exists.
+		// TODO: Probably worth asking anyone seeing something else here to let us know.
+		return err == syscall.EPERM
+	}
+
+	return true
+}
+
+// BackupConfig saves the current configuration files of the data directory before they are changed.
+// The backup is a known good configuration when algod is running with it.
+func BackupConfig(path string, reason string) error {
+	_, err := backup.Create(path, reason, IsPidRunning(path))
+	return err
+}
+
 func GetEndpointFromDataDir(path string) (string, error) {
 	var endpoint string
 	file, err := os.ReadFile(filepath.Join(path, "algod.net"))
@@ -186,6 +215,10 @@ func WriteLogConfigToDataDir(path string, logConfig *telemetry.Config) error {
 	if err != nil {
 		return err
 	}
+	err = BackupConfig(path, "update logging.config")
+	if err != nil {
+		return err
+	}
 	err = os.WriteFile(filepath.Join(path, "logging.config"), file, 0o644)
 	if err != nil {
 		return err
@@ -217,22 +250,6 @@ func GetConfigFromDataDir(path string) (*config.Config, error) {
 // WriteConfigToDataDir writes the provided node configuration to a file in the specified data directory.
 // The configuration is formatted as indented JSON and saved to a file named "config.json".
 func WriteConfigToDataDir(path string, algodConfig *config.Config) error {
-	// Read an existing config and unmarshal it into a map, or make a new map.
-	var currentConfigMap map[string]json.RawMessage
-	configFile := filepath.Join(path, "config.json")
-	file, err := os.ReadFile(configFile)
-	if err == nil {
-		if err := json.Unmarshal(file, &currentConfigMap); err != nil {
-			return err
-		}
-	} else if os.IsPermission(err) {
-		return err
-	} else if !os.IsNotExist(err) {
-		return err
-	} else {
-		currentConfigMap = make(map[string]json.RawMessage)
-	}
-
 	// We only want user-defined values (non-nil), so omitempty removes
 	// everything else when unmarshaling into newConfigMap.
 	tempConfigMap, err := json.Marshal(algodConfig)
@@ -244,33 +261,85 @@ func WriteConfigToDataDir(path string, algodConfig *config.Config) error {
 		return err
 	}
 
-	// Update currentConfigMap with user-defined values.
+	set := make(map[string]any, len(newConfigMap))
 	for key, value := range newConfigMap {
-		currentConfigMap[key] = value
+		set[key] = value
 	}
+	return UpdateConfigInDataDir(path, set, nil)
+}
 
-	// Marshal and save the new config
-	newConfig, err := json.MarshalIndent(currentConfigMap, "", "\t")
+// GetConfigValuesFromDataDir reads every key of the config.json file in the data directory.
+// A missing file returns an empty map.
+func GetConfigValuesFromDataDir(path string) (map[string]json.RawMessage, error) {
+	// Read an existing config and unmarshal it into a map, or make a new map.
+	currentConfigMap := make(map[string]json.RawMessage)
+	file, err := os.ReadFile(filepath.Join(path, "config.json"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return currentConfigMap, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(file, &currentConfigMap); err != nil {
+		return nil, err
+	}
+	return currentConfigMap, nil
+}
+
+// UpdateConfigInDataDir sets and removes keys of the config.json file in the data directory,
+// every other key is preserved as is.
+func UpdateConfigInDataDir(path string, set map[string]any, unset []string) error {
+	newConfig, err := RenderConfig(path, set, unset)
 	if err != nil {
 		return err
 	}
-	err = os.WriteFile(filepath.Join(path, "config.json"), newConfig, 0o664)
+	err = BackupConfig(path, "update config.json")
+	if err != nil {
+		return err
+	}
+
+	configFile := filepath.Join(path, "config.json")
+	err = os.WriteFile(configFile, newConfig, 0o664)
 	if err != nil {
 		return err
 	}
 
 	// If we're sudo'ed set permissions/ownership on the config file
 	if system.IsSudo() {
-		return setFilePermissions(configFile)
+		return setFilePermissions(configFile, 0o664)
 	}
 
 	return nil
 }
 
+// RenderConfig returns the config.json file of the data directory as UpdateConfigInDataDir would write it,
+// without writing anything.
+func RenderConfig(path string, set map[string]any, unset []string) ([]byte, error) {
+	currentConfigMap, err := GetConfigValuesFromDataDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update currentConfigMap with user-defined values.
+	for key, value := range set {
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		currentConfigMap[key] = raw
+	}
+	for _, key := range unset {
+		delete(currentConfigMap, key)
+	}
+
+	// Marshal the new config
+	return json.MarshalIndent(currentConfigMap, "", "\t")
+}
+
 // setFilePermissions matches a file's user and group
-// to its parent directory, and sets perms to 0o664.
+// to its parent directory, and sets perms to mode.
 // Will only succeed if run as root (sudo)
-func setFilePermissions(filePath string) error {
+func setFilePermissions(filePath string, mode os.FileMode) error {
 	// Get the parent directory info
 	dirPath := filepath.Dir(filePath)
 	dirInfo, err := os.Stat(dirPath)
@@ -291,8 +360,7 @@ func setFilePermissions(filePath string) error {
 	if err := os.Chown(filePath, uid, gid); err != nil {
 		return err
 	}
-	// Also make sure group can write in the future
-	if err := os.Chmod(filePath, 0o664); err != nil {
+	if err := os.Chmod(filePath, mode); err != nil {
 		return err
 	}
 
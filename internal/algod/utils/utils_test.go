@@ -0,0 +1,65 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/algorandfoundation/nodekit/internal/algod/backup"
+	"github.com/algorandfoundation/nodekit/internal/algod/config"
+)
+
+func Test_UpdateConfigInDataDir(t *testing.T) {
+	dir := t.TempDir()
+	err := os.WriteFile(filepath.Join(dir, "config.json"), []byte(`{"Version": 34, "Archival": true, "GossipFanout": 4}`), 0o664)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = UpdateConfigInDataDir(dir, map[string]any{"NetAddress": ":4160", "GossipFanout": int64(8)}, []string{"Archival"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	values, err := GetConfigValuesFromDataDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]string{"Version": "34", "NetAddress": `":4160"`, "GossipFanout": "8"}
+	if len(values) != len(expected) {
+		t.Errorf("expected %d keys, got %v", len(expected), values)
+	}
+	for key, value := range expected {
+		if string(values[key]) != value {
+			t.Errorf("expected %s to be %s, got %s", key, value, values[key])
+		}
+	}
+
+	// The typed config keeps using the same merge
+	hybrid := true
+	err = WriteConfigToDataDir(dir, &config.Config{EnableP2PHybridMode: &hybrid})
+	if err != nil {
+		t.Fatal(err)
+	}
+	values, _ = GetConfigValuesFromDataDir(dir)
+	if string(values["EnableP2PHybridMode"]) != "true" || string(values["Version"]) != "34" {
+		t.Errorf("unexpected values %v", values)
+	}
+
+	// Every write is backed up first
+	backups, err := backup.List(dir)
+	if err != nil || len(backups) != 2 {
+		t.Fatalf("expected 2 backups, got %v (%v)", backups, err)
+	}
+	content, _ := backup.Read(dir, backups[1].ID, "config.json")
+	if string(content) != `{"Version": 34, "Archival": true, "GossipFanout": 4}` {
+		t.Errorf("expected the original file to be backed up, got %s", content)
+	}
+	if backups[1].Running {
+		t.Error("expected the backup of a stopped node to not be known good")
+	}
+
+	values, err = GetConfigValuesFromDataDir(t.TempDir())
+	if err != nil || len(values) != 0 {
+		t.Errorf("expected an empty config for a missing file, got %v (%v)", values, err)
+	}
+}
@@ -0,0 +1,290 @@
+package algod
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/internal/algod/utils"
+)
+
+// ErrNoQuorum is returned when not enough sources agree on a catchpoint.
+var ErrNoQuorum = errors.New("no catchpoint is confirmed by a quorum of the sources")
+
+// ErrCatchpointConflict is returned when sources report different catchpoints for the same round,
+// one of the sources is compromised and no catchpoint of that round can be trusted.
+var ErrCatchpointConflict = errors.New("sources report different catchpoints for the same round")
+
+// ErrRoundUnavailable is returned by a CatchpointSource which cannot report the catchpoint of an older round.
+var ErrRoundUnavailable = errors.New("the source only reports its latest catchpoint")
+
+// ErrNoCatchpointTracking is returned by a NodeSource which does not compute catchpoint labels.
+var ErrNoCatchpointTracking = errors.New("the node does not track catchpoints, it needs Archival or CatchpointTracking set to 1 or 2")
+
+// CatchpointSource reports catchpoint labels, e.g. 48670000#AXHC4X4S...
+type CatchpointSource interface {
+	// Name identifies the source in the logs.
+	Name() string
+	// LatestCatchpoint returns the latest catchpoint label.
+	LatestCatchpoint(ctx context.Context) (string, error)
+	// Catchpoint returns the label of the catchpoint at a round older than the latest one,
+	// ErrRoundUnavailable when the source does not keep older labels.
+	Catchpoint(ctx context.Context, round uint64) (string, error)
+}
+
+// URLSource is a catchpoint service returning the label of the latest catchpoint, like the network's catchpoint URL.
+type URLSource struct {
+	Label string
+	URL   api.CatchPointUrl
+	// RoundURL returns the label of a round, %d is replaced by the round.
+	// It is empty for services which only publish the latest catchpoint.
+	RoundURL string
+	HttpPkg  api.HttpPkgInterface
+}
+
+// Name identifies the source.
+func (s URLSource) Name() string { return s.Label }
+
+// LatestCatchpoint fetches the label from the URL.
+func (s URLSource) LatestCatchpoint(_ context.Context) (string, error) {
+	response, err := api.GetCatchpointWithResponse(s.HttpPkg, s.URL)
+	if err != nil {
+		return "", err
+	}
+	return response.JSON200, nil
+}
+
+// Catchpoint fetches the label of the round from the RoundURL.
+func (s URLSource) Catchpoint(_ context.Context, round uint64) (string, error) {
+	if s.RoundURL == "" {
+		return "", ErrRoundUnavailable
+	}
+	response, err := api.GetCatchpointWithResponse(s.HttpPkg, api.CatchPointUrl(fmt.Sprintf(s.RoundURL, round)))
+	if err != nil {
+		return "", err
+	}
+	return response.JSON200, nil
+}
+
+// NodeSource is a trusted algod node, the last catchpoint label it computed is used.
+// algod only computes labels with CatchpointTracking set to 1 or 2, or on an archival node with the default
+// CatchpointTracking of 0, in both cases with a CatchpointInterval above 0. Other nodes report no catchpoint.
+// The status of algod only has the last label, a node cannot report the catchpoint of an older round.
+type NodeSource struct {
+	Label  string
+	Client api.ClientWithResponsesInterface
+}
+
+// Name identifies the source.
+func (s NodeSource) Name() string { return s.Label }
+
+// LatestCatchpoint returns the last catchpoint computed by the node.
+func (s NodeSource) LatestCatchpoint(ctx context.Context) (string, error) {
+	response, err := s.Client.GetStatusWithResponse(ctx)
+	if err != nil {
+		return "", err
+	}
+	if response.StatusCode() >= 300 {
+		return "", errors.New(response.Status())
+	}
+	if response.JSON200.LastCatchpoint == nil || *response.JSON200.LastCatchpoint == "" {
+		return "", ErrNoCatchpointTracking
+	}
+	return *response.JSON200.LastCatchpoint, nil
+}
+
+// Catchpoint is unavailable, algod does not report older labels.
+func (s NodeSource) Catchpoint(context.Context, uint64) (string, error) {
+	return "", ErrRoundUnavailable
+}
+
+// Verification is the outcome of VerifyCatchpoint.
+type Verification struct {
+	// Catchpoint is the confirmed catchpoint label.
+	Catchpoint string `json:"catchpoint"`
+
+	// Round is the round of the Catchpoint.
+	Round uint64 `json:"round"`
+
+	// Quorum is the number of sources that had to agree.
+	Quorum int `json:"quorum"`
+
+	// Agreed lists the sources which reported the Catchpoint.
+	Agreed []string `json:"agreed"`
+
+	// Others maps the remaining sources to the label they reported or the error they returned.
+	Others map[string]string `json:"others"`
+}
+
+// VerifyCatchpoint returns the catchpoint of the highest round reported by at least quorum sources.
+// The latest catchpoint of every source is fetched first, their rounds are the candidates from the newest to the oldest.
+// For a candidate round, a source counts with its latest label when it is at that round, sources at a newer round are
+// asked for the label of the round and sources at an older round do not count. Sources are rarely at the same catchpoint,
+// the oldest candidate lets sources one catchpoint behind agree with the others on that round.
+// It fails with ErrCatchpointConflict when two sources report a different catchpoint for the same round.
+func VerifyCatchpoint(ctx context.Context, sources []CatchpointSource, quorum int) (Verification, error) {
+	verification := Verification{Quorum: quorum, Agreed: []string{}, Others: make(map[string]string)}
+	if quorum <= 0 || quorum > len(sources) {
+		return verification, fmt.Errorf("invalid quorum %d for %d sources", quorum, len(sources))
+	}
+
+	latest := fetchCatchpoints(len(sources), func(i int) (string, error) { return sources[i].LatestCatchpoint(ctx) })
+	latestRounds := make([]uint64, len(sources))
+	byRound := make(map[uint64]string)
+	for i, source := range sources {
+		round, err := latest[i].parse()
+		if err != nil {
+			verification.Others[source.Name()] = err.Error()
+			latest[i].err = err
+			continue
+		}
+		latestRounds[i] = round
+		verification.Others[source.Name()] = latest[i].label
+		if other, ok := byRound[round]; ok && other != latest[i].label {
+			return verification, fmt.Errorf("%w: %s and %s", ErrCatchpointConflict, other, latest[i].label)
+		}
+		byRound[round] = latest[i].label
+	}
+
+	rounds := make([]uint64, 0, len(byRound))
+	for round := range byRound {
+		rounds = append(rounds, round)
+	}
+	sort.Slice(rounds, func(i, j int) bool { return rounds[i] > rounds[j] })
+	for _, round := range rounds {
+		labels := fetchCatchpoints(len(sources), func(i int) (string, error) {
+			switch {
+			case latest[i].err != nil || latestRounds[i] < round:
+				return "", ErrRoundUnavailable
+			case latestRounds[i] == round:
+				return latest[i].label, nil
+			}
+			return sources[i].Catchpoint(ctx, round)
+		})
+		label := byRound[round]
+		var agreed []string
+		for i, source := range sources {
+			r, err := labels[i].parse()
+			if err != nil || r != round {
+				continue
+			}
+			if labels[i].label != label {
+				return verification, fmt.Errorf("%w: %s and %s", ErrCatchpointConflict, label, labels[i].label)
+			}
+			agreed = append(agreed, source.Name())
+		}
+		if len(agreed) >= quorum {
+			verification.Catchpoint, verification.Round, verification.Agreed = label, round, agreed
+			for _, name := range agreed {
+				delete(verification.Others, name)
+			}
+			break
+		}
+	}
+	if verification.Catchpoint == "" {
+		return verification, ErrNoQuorum
+	}
+	return verification, nil
+}
+
+// fetchedCatchpoint is the label returned by a source, or its error.
+type fetchedCatchpoint struct {
+	label string
+	err   error
+}
+
+// parse returns the round of the label.
+func (f fetchedCatchpoint) parse() (uint64, error) {
+	if f.err != nil {
+		return 0, f.err
+	}
+	round, err := ParseCatchpointRound(f.label)
+	if f.label == "" || err != nil {
+		return 0, fmt.Errorf("invalid catchpoint %q", f.label)
+	}
+	return round, nil
+}
+
+// fetchCatchpoints calls fetch for every source concurrently.
+func fetchCatchpoints(n int, fetch func(i int) (string, error)) []fetchedCatchpoint {
+	fetched := make([]fetchedCatchpoint, n)
+	var wg sync.WaitGroup
+	for i := range fetched {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			label, err := fetch(i)
+			fetched[i] = fetchedCatchpoint{label: strings.TrimSpace(label), err: err}
+		}()
+	}
+	wg.Wait()
+	return fetched
+}
+
+// GetCatchpointSources returns the catchpoint service of the network and the sources of the NodeKit settings
+// for the network, with the quorum of the settings or a majority of the sources.
+func GetCatchpointSources(httpPkg api.HttpPkgInterface, network string, genesisHash string) ([]CatchpointSource, int, error) {
+	settings, err := utils.GetNodekitSettings()
+	if err != nil {
+		return nil, 0, err
+	}
+	var sources []CatchpointSource
+	if n, ok := api.Registry.Find(network, genesisHash); ok && n.CatchpointURL != "" {
+		sources = append(sources, URLSource{Label: "catchpoint service", URL: n.CatchpointURL, HttpPkg: httpPkg})
+	}
+	quorum := 0
+	if settings.Catchpoint != nil {
+		quorum = settings.Catchpoint.Quorum
+		for _, s := range settings.Catchpoint.Sources {
+			if s.Network != "" && s.Network != network && s.Network != api.ShortNetworkName(network) {
+				continue
+			}
+			switch {
+			case s.URL != "":
+				sources = append(sources, URLSource{Label: sourceName(s, s.URL), URL: api.CatchPointUrl(s.URL), RoundURL: s.RoundURL, HttpPkg: httpPkg})
+			case s.Profile != "":
+				client, err := GetProfileClient(s.Profile)
+				if err != nil {
+					return nil, 0, err
+				}
+				sources = append(sources, NodeSource{Label: sourceName(s, "profile "+s.Profile), Client: client})
+			default:
+				return nil, 0, fmt.Errorf("catchpoint source %s has no URL or Profile", s.Name)
+			}
+		}
+	}
+	if len(sources) == 0 {
+		return nil, 0, api.ErrInvalidNetwork
+	}
+	if quorum == 0 {
+		quorum = len(sources)/2 + 1
+	}
+	return sources, quorum, nil
+}
+
+// GetVerifiedCatchpoint verifies the latest catchpoint of the network against the sources of the NodeKit settings.
+func GetVerifiedCatchpoint(ctx context.Context, httpPkg api.HttpPkgInterface, network string, genesisHash string) (Verification, error) {
+	sources, quorum, err := GetCatchpointSources(httpPkg, network, genesisHash)
+	if err != nil {
+		return Verification{}, err
+	}
+	return VerifyCatchpoint(ctx, sources, quorum)
+}
+
+// IsCatchpointVerificationEnabled is true when the NodeKit settings require every catchpoint to be verified.
+func IsCatchpointVerificationEnabled() bool {
+	settings, err := utils.GetNodekitSettings()
+	return err == nil && settings.Catchpoint != nil && settings.Catchpoint.Verify
+}
+
+// sourceName returns the name of a source, or the fallback when it has none.
+func sourceName(s utils.CatchpointSourceSettings, fallback string) string {
+	if s.Name != "" {
+		return s.Name
+	}
+	return fallback
+}
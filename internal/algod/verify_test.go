@@ -0,0 +1,166 @@
+package algod
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/algorandfoundation/nodekit/internal/algod/utils"
+)
+
+type fakeSource struct {
+	name    string
+	label   string
+	err     error
+	history map[uint64]string
+}
+
+func (s fakeSource) Name() string { return s.name }
+
+func (s fakeSource) LatestCatchpoint(_ context.Context) (string, error) {
+	return s.label, s.err
+}
+
+func (s fakeSource) Catchpoint(_ context.Context, round uint64) (string, error) {
+	if label, ok := s.history[round]; ok {
+		return label, nil
+	}
+	return "", ErrRoundUnavailable
+}
+
+func Test_VerifyCatchpoint(t *testing.T) {
+	ctx := context.Background()
+	sources := []CatchpointSource{
+		fakeSource{name: "s3", label: "40000#AAA\n"},
+		fakeSource{name: "node-a", label: "40000#AAA"},
+		fakeSource{name: "node-b", label: "30000#BBB"},
+		fakeSource{name: "offline", err: errors.New("connection refused")},
+	}
+	verification, err := VerifyCatchpoint(ctx, sources, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if verification.Catchpoint != "40000#AAA" || len(verification.Agreed) != 2 {
+		t.Errorf("unexpected verification %+v", verification)
+	}
+	if verification.Others["node-b"] != "30000#BBB" || verification.Others["offline"] != "connection refused" {
+		t.Errorf("unexpected others %+v", verification.Others)
+	}
+
+	// An older catchpoint with a quorum is used when the newest has none
+	verification, err = VerifyCatchpoint(ctx, []CatchpointSource{
+		fakeSource{name: "s3", label: "50000#CCC"},
+		fakeSource{name: "node-a", label: "40000#AAA"},
+		fakeSource{name: "node-b", label: "40000#AAA"},
+	}, 2)
+	if err != nil || verification.Catchpoint != "40000#AAA" || verification.Round != 40000 {
+		t.Errorf("expected the older catchpoint, got %+v: %v", verification, err)
+	}
+
+	// A source one catchpoint ahead confirms the round of the others
+	verification, err = VerifyCatchpoint(ctx, []CatchpointSource{
+		fakeSource{name: "s3", label: "50000#CCC", history: map[uint64]string{40000: "40000#AAA"}},
+		fakeSource{name: "node-a", label: "40000#AAA"},
+		fakeSource{name: "untracked", err: ErrNoCatchpointTracking},
+	}, 2)
+	if err != nil || verification.Catchpoint != "40000#AAA" || len(verification.Agreed) != 2 {
+		t.Errorf("expected the round of the node to be confirmed, got %+v: %v", verification, err)
+	}
+	if verification.Others["untracked"] != ErrNoCatchpointTracking.Error() {
+		t.Errorf("expected the untracked node in the others, got %+v", verification.Others)
+	}
+
+	// A source ahead reporting another label for the round is a conflict
+	_, err = VerifyCatchpoint(ctx, []CatchpointSource{
+		fakeSource{name: "s3", label: "50000#CCC", history: map[uint64]string{40000: "40000#EVIL"}},
+		fakeSource{name: "node-a", label: "40000#AAA"},
+	}, 2)
+	if !errors.Is(err, ErrCatchpointConflict) {
+		t.Errorf("expected a conflict with the older label, got %v", err)
+	}
+
+	_, err = VerifyCatchpoint(ctx, sources, 3)
+	if !errors.Is(err, ErrNoQuorum) {
+		t.Errorf("expected no quorum, got %v", err)
+	}
+
+	_, err = VerifyCatchpoint(ctx, []CatchpointSource{
+		fakeSource{name: "s3", label: "40000#AAA"},
+		fakeSource{name: "node-a", label: "40000#AAA"},
+		fakeSource{name: "tampered", label: "40000#EVIL"},
+	}, 2)
+	if !errors.Is(err, ErrCatchpointConflict) {
+		t.Errorf("expected a conflict, got %v", err)
+	}
+
+	_, err = VerifyCatchpoint(ctx, sources, 5)
+	if err == nil {
+		t.Error("expected a quorum above the number of sources to fail")
+	}
+}
+
+func Test_GetCatchpointSources(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	sources, quorum, err := GetCatchpointSources(nil, "mainnet-v1.0", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sources) != 1 || quorum != 1 || IsCatchpointVerificationEnabled() {
+		t.Errorf("expected only the catchpoint service, got %d sources and quorum %d", len(sources), quorum)
+	}
+
+	err = utils.WriteNodekitSettings(utils.Settings{
+		Profiles: map[string]utils.ProfileSettings{"trusted": {Endpoint: "https://node.example.com", Token: "admin"}},
+		Catchpoint: &utils.CatchpointSettings{Verify: true, Sources: []utils.CatchpointSourceSettings{
+			{Name: "mirror", URL: "https://mirror.example.com/mainnet/latest.catchpoint"},
+			{Profile: "trusted", Network: "mainnet"},
+			{Name: "testnet mirror", Network: "testnet-v1.0", URL: "https://mirror.example.com/testnet/latest.catchpoint"},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sources, quorum, err = GetCatchpointSources(nil, "mainnet-v1.0", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sources) != 3 || quorum != 2 || !IsCatchpointVerificationEnabled() {
+		t.Errorf("expected three sources with a quorum of 2, got %d sources and quorum %d", len(sources), quorum)
+	}
+	if sources[1].Name() != "mirror" || sources[2].Name() != "profile trusted" {
+		t.Errorf("unexpected source names %s and %s", sources[1].Name(), sources[2].Name())
+	}
+
+	_, _, err = GetCatchpointSources(nil, "privnet-v1", "")
+	if err != nil {
+		t.Errorf("expected the sources for every network, got %v", err)
+	}
+}
+
+// labelHttp answers every catchpoint URL with a label made of the URL
+type labelHttp struct{}
+
+func (labelHttp) Get(url string) (*http.Response, error) {
+	return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader("40000#" + url))}, nil
+}
+
+func (labelHttp) Post(string, string, io.Reader) (*http.Response, error) {
+	return nil, errors.New("unexpected post")
+}
+
+func Test_URLSource(t *testing.T) {
+	ctx := context.Background()
+	source := URLSource{Label: "mirror", URL: "https://mirror.example.com/latest", HttpPkg: labelHttp{}}
+	if _, err := source.Catchpoint(ctx, 40000); !errors.Is(err, ErrRoundUnavailable) {
+		t.Errorf("expected no older catchpoints without a round URL, got %v", err)
+	}
+	source.RoundURL = "https://mirror.example.com/%d.catchpoint"
+	label, err := source.Catchpoint(ctx, 40000)
+	if err != nil || label != "40000#https://mirror.example.com/40000.catchpoint" {
+		t.Errorf("unexpected label %s (%v)", label, err)
+	}
+}
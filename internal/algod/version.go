@@ -2,9 +2,13 @@ package algod
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
+	"regexp"
+
 	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/internal/system"
 )
 
 // VersionResponse represents information about the system version, including network, version, and channel details.
@@ -18,6 +22,9 @@ type VersionResponse struct {
 
 	// Channel is a string representing the release channel of the system, such as stable, beta, or nightly.
 	Channel string
+
+	// GenesisHash is the base64 genesis hash of the network.
+	GenesisHash string
 }
 
 // GetVersion retrieves system version information from the API client and processes it into a formatted VersionResponse.
@@ -41,6 +48,46 @@ func GetVersion(ctx context.Context, client api.ClientWithResponsesInterface) (V
 	)
 	release.Network = v.JSON200.GenesisId
 	release.Channel = v.JSON200.Build.Channel
+	release.GenesisHash = base64.StdEncoding.EncodeToString(v.JSON200.GenesisHashB64)
 
 	return release, v, nil
 }
+
+// installedVersionRegex matches the release line of `algod -v`, e.g. 3.26.0.stable [rel/stable]
+var installedVersionRegex = regexp.MustCompile(`(?m)^(\d+\.\d+\.\d+)\.(\w+) \[`)
+
+// GetInstalledVersion returns the version of the algod binary on the PATH, e.g. 3.26.0.
+func GetInstalledVersion() (string, error) {
+	output, err := system.Run([]string{"algod", "-v"})
+	if err != nil {
+		return "", err
+	}
+	return ParseInstalledVersion(output)
+}
+
+// ParseInstalledVersion extracts the version from the output of `algod -v`.
+func ParseInstalledVersion(output string) (string, error) {
+	match := installedVersionRegex.FindStringSubmatch(output)
+	if match == nil {
+		return "", errors.New("unable to find the algod version")
+	}
+	return match[1], nil
+}
+
+// GetInstalledChannel returns the release channel of the algod binary on the PATH, e.g. stable.
+func GetInstalledChannel() (string, error) {
+	output, err := system.Run([]string{"algod", "-v"})
+	if err != nil {
+		return "", err
+	}
+	return ParseInstalledChannel(output)
+}
+
+// ParseInstalledChannel extracts the release channel from the output of `algod -v`.
+func ParseInstalledChannel(output string) (string, error) {
+	match := installedVersionRegex.FindStringSubmatch(output)
+	if match == nil {
+		return "", errors.New("unable to find the algod channel")
+	}
+	return match[2], nil
+}
@@ -0,0 +1,23 @@
+package algod
+
+import "testing"
+
+func Test_ParseInstalledVersion(t *testing.T) {
+	output := "12885098499\n3.26.0.stable [rel/stable] (commit #a5bc3cb)\ngo-algorand is licensed with AGPLv3.0\n"
+	version, err := ParseInstalledVersion(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != "3.26.0" {
+		t.Errorf("expected 3.26.0, got %s", version)
+	}
+	channel, err := ParseInstalledChannel(output)
+	if err != nil || channel != "stable" {
+		t.Errorf("expected the stable channel, got %s: %v", channel, err)
+	}
+
+	_, err = ParseInstalledVersion("command not found")
+	if err == nil {
+		t.Error("expected an error for invalid output")
+	}
+}
@@ -0,0 +1,291 @@
+package algod
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/internal/system"
+)
+
+// EventType identifies the kind of change published by a Watcher.
+type EventType string
+
+const (
+	// StatusChangedEvent is published when the operational State of the node changes,
+	// or on every poll while the node is performing a fast catchup.
+	StatusChangedEvent EventType = "status-changed"
+
+	// NewRoundEvent is published when the node reports a new LastRound.
+	NewRoundEvent EventType = "new-round"
+
+	// MetricsRefreshedEvent is published after the Metrics have been recalculated.
+	MetricsRefreshedEvent EventType = "metrics-refreshed"
+
+	// KeysChangedEvent is published when the list of participation keys on the node changes.
+	KeysChangedEvent EventType = "keys-changed"
+
+	// AccountChangedEvent is published for each account whose data changed, the Address is set on the Event.
+	AccountChangedEvent EventType = "account-changed"
+
+	// NodeDownEvent is published when the node cannot be reached, the Err is set on the Event.
+	NodeDownEvent EventType = "node-down"
+
+	// NodeUpEvent is published when the node is reachable again after a NodeDownEvent.
+	NodeUpEvent EventType = "node-up"
+)
+
+// DownState is the State reported when the node cannot be reached.
+const DownState State = "DOWN"
+
+// Event is a single change published by a Watcher to its subscribers.
+type Event struct {
+	// Type is the kind of change this Event describes.
+	Type EventType
+
+	// Round is the LastRound of the node when the Event was published.
+	Round uint64
+
+	// Address is the account address for an AccountChangedEvent.
+	Address string
+
+	// Err is the failure for a NodeDownEvent.
+	Err error
+
+	// Snapshot is a copy of the StateModel taken when the Event was published.
+	// The Watcher keeps writing to the StateModel, subscribers read the Snapshot instead.
+	Snapshot Snapshot
+}
+
+// Watcher polls an algod node and publishes typed Events to any number of subscribers.
+// It is driven by the context passed to Run and stops cleanly once that context is cancelled.
+type Watcher struct {
+	// State is the StateModel that is updated on every poll.
+	State *StateModel
+
+	// Time provides the clock used to calculate key expiration times.
+	Time system.Time
+
+	// CatchupInterval is the delay between status checks while the node is in fast catchup.
+	CatchupInterval time.Duration
+
+	// ErrorInterval is the delay before polling again after the node could not be reached.
+	ErrorInterval time.Duration
+
+	mu          sync.Mutex
+	subscribers map[chan Event]context.Context
+	done        bool
+	down        bool
+}
+
+// NewWatcher creates a Watcher for the provided StateModel using the default polling intervals.
+func NewWatcher(state *StateModel, t system.Time) *Watcher {
+	return &Watcher{
+		State:           state,
+		Time:            t,
+		CatchupInterval: time.Second * 2,
+		ErrorInterval:   time.Second * 3,
+		subscribers:     make(map[chan Event]context.Context),
+	}
+}
+
+// Subscribe registers a new subscriber and returns a channel with the provided buffer size.
+// The channel is closed when the context is cancelled or when the Watcher stops running.
+// Events are delivered in order, a subscriber that stops reading holds back the Watcher until its context is cancelled.
+func (w *Watcher) Subscribe(ctx context.Context, size int) <-chan Event {
+	ch := make(chan Event, size)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.done {
+		close(ch)
+		return ch
+	}
+	w.subscribers[ch] = ctx
+
+	go func() {
+		<-ctx.Done()
+		w.unsubscribe(ch)
+	}()
+	return ch
+}
+
+// unsubscribe removes the subscriber and closes its channel if it is still registered.
+func (w *Watcher) unsubscribe(ch chan Event) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if _, ok := w.subscribers[ch]; ok {
+		delete(w.subscribers, ch)
+		close(ch)
+	}
+}
+
+// publish sends the Event to every subscriber, waiting for slow subscribers until the context is cancelled.
+func (w *Watcher) publish(ctx context.Context, event Event) {
+	if event.Round == 0 {
+		event.Round = w.State.Status.LastRound
+	}
+	event.Snapshot = w.State.Snapshot()
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for ch, sub := range w.subscribers {
+		select {
+		case ch <- event:
+		case <-sub.Done():
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// close stops accepting subscribers and closes every subscriber channel.
+func (w *Watcher) close() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.done = true
+	for ch := range w.subscribers {
+		delete(w.subscribers, ch)
+		close(ch)
+	}
+}
+
+// sleep pauses for the duration or until the context is cancelled, returning the context error if cancelled.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// nodeDown marks the node as unreachable, publishes a NodeDownEvent and waits before the next poll.
+func (w *Watcher) nodeDown(ctx context.Context, err error) error {
+	w.down = true
+	w.State.Status.State = DownState
+	w.publish(ctx, Event{Type: NodeDownEvent, Err: err})
+	return sleep(ctx, w.ErrorInterval)
+}
+
+// nodeUp publishes a NodeUpEvent when the node was previously unreachable.
+func (w *Watcher) nodeUp(ctx context.Context) {
+	if w.down {
+		w.down = false
+		w.publish(ctx, Event{Type: NodeUpEvent})
+	}
+}
+
+// updateKeys refreshes the participation keys and accounts, publishing an Event for everything that changed.
+func (w *Watcher) updateKeys(ctx context.Context) {
+	prevKeys := w.State.ParticipationKeys
+	prevAccounts := w.State.Accounts
+
+	w.State.UpdateKeys(ctx, w.Time)
+
+	if !reflect.DeepEqual(prevKeys, w.State.ParticipationKeys) {
+		w.publish(ctx, Event{Type: KeysChangedEvent})
+	}
+	for address, acct := range w.State.Accounts {
+		if prev, ok := prevAccounts[address]; !ok || !reflect.DeepEqual(prev, acct) {
+			w.publish(ctx, Event{Type: AccountChangedEvent, Address: address})
+		}
+	}
+	for address := range prevAccounts {
+		if _, ok := w.State.Accounts[address]; !ok {
+			w.publish(ctx, Event{Type: AccountChangedEvent, Address: address})
+		}
+	}
+}
+
+// update applies a new Status and publishes the round and state changes.
+func (w *Watcher) update(ctx context.Context, status Status) {
+	prev := w.State.Status
+	w.State.Status = status
+	w.nodeUp(ctx)
+	if prev.State != status.State {
+		w.publish(ctx, Event{Type: StatusChangedEvent})
+	}
+	if prev.LastRound != status.LastRound {
+		w.publish(ctx, Event{Type: NewRoundEvent})
+	}
+}
+
+// Run polls the node until the context is cancelled and then closes every subscriber channel.
+// It always returns the context error.
+func (w *Watcher) Run(ctx context.Context) error {
+	defer w.close()
+
+	s := w.State
+	s.Watching = true
+	defer func() { s.Watching = false }()
+
+	// Setup Defaults
+	if s.Metrics.Window == 0 {
+		s.Metrics.Window = 100
+	}
+
+	// Fetch the latest Status
+	status, _, err := s.Status.Get(ctx)
+	if err != nil {
+		if w.nodeDown(ctx, err) != nil {
+			return ctx.Err()
+		}
+	} else {
+		w.update(ctx, status)
+	}
+
+	for ctx.Err() == nil {
+		// Poll the status while in Fast-Catchup
+		if s.Status.State == FastCatchupState {
+			w.publish(ctx, Event{Type: StatusChangedEvent})
+			if sleep(ctx, w.CatchupInterval) != nil {
+				break
+			}
+			status, _, err = s.Status.Get(ctx)
+			if err != nil {
+				_ = w.nodeDown(ctx, err)
+				continue
+			}
+			w.update(ctx, status)
+			continue
+		}
+
+		// Fetch Keys
+		w.updateKeys(ctx)
+
+		// Wait for the next block
+		status, _, err = s.Status.Wait(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				break
+			}
+			_ = w.nodeDown(ctx, err)
+			continue
+		}
+		w.update(ctx, status)
+
+		if s.Status.State == SyncingState {
+			continue
+		}
+
+		// Run Round Averages and RX/TX every 5 rounds
+		if s.Status.LastRound%5 == 0 {
+			metrics, _, err := s.Metrics.Get(ctx, s.Status.LastRound)
+			if err != nil {
+				if ctx.Err() != nil {
+					break
+				}
+				_ = w.nodeDown(ctx, err)
+				continue
+			}
+			s.Metrics = metrics
+			w.publish(ctx, Event{Type: MetricsRefreshedEvent})
+		}
+	}
+
+	return ctx.Err()
+}
@@ -0,0 +1,133 @@
+package algod
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/internal/test"
+	"github.com/algorandfoundation/nodekit/internal/test/mock"
+)
+
+// downClient simulates an unreachable node
+type downClient struct {
+	test.Client
+}
+
+func (c *downClient) GetStatusWithResponse(ctx context.Context, reqEditors ...api.RequestEditorFn) (*api.GetStatusResponse, error) {
+	return nil, errors.New("connection refused")
+}
+
+func getWatcherState(client api.ClientWithResponsesInterface) *StateModel {
+	httpPkg := new(api.HttpPkg)
+	return &StateModel{
+		Status: Status{
+			LastRound: 1337,
+			State:     SyncingState,
+			Client:    client,
+			HttpPkg:   httpPkg,
+		},
+		Metrics: Metrics{
+			Client:  client,
+			HttpPkg: httpPkg,
+		},
+		Client:  client,
+		Context: context.Background(),
+	}
+}
+
+func Test_WatcherSubscribers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	w := NewWatcher(getWatcherState(test.GetClient(false)), new(mock.Clock))
+
+	first := w.Subscribe(ctx, 10)
+	second := w.Subscribe(ctx, 10)
+
+	done := make(chan error)
+	go func() {
+		done <- w.Run(ctx)
+	}()
+
+	seen := make(map[EventType]bool)
+	for i := 0; i < 5; i++ {
+		a := <-first
+		b := <-second
+		if a.Type != b.Type || a.Round != b.Round {
+			t.Fatalf("subscribers received different events: %v %v", a.Type, b.Type)
+		}
+		if a.Snapshot.Status.LastRound != a.Round {
+			t.Errorf("expected a snapshot of round %d, got %d", a.Round, a.Snapshot.Status.LastRound)
+		}
+		seen[a.Type] = true
+	}
+	if !seen[NewRoundEvent] {
+		t.Error("expected a new round event")
+	}
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != context.Canceled {
+			t.Errorf("expected context.Canceled, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("watcher did not stop after the context was cancelled")
+	}
+
+	// Drain and ensure the channels are closed
+	for range first {
+	}
+	for range second {
+	}
+
+	// Late subscribers receive a closed channel
+	if _, ok := <-w.Subscribe(context.Background(), 0); ok {
+		t.Error("expected a closed channel after the watcher stopped")
+	}
+}
+
+func Test_WatcherUnsubscribe(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	w := NewWatcher(getWatcherState(test.GetClient(false)), new(mock.Clock))
+
+	subCtx, unsubscribe := context.WithCancel(ctx)
+	events := w.Subscribe(subCtx, 0)
+	go w.Run(ctx)
+
+	<-events
+	unsubscribe()
+
+	select {
+	case <-time.After(time.Second):
+		t.Fatal("subscriber channel was not closed")
+	case _, ok := <-events:
+		for ok {
+			_, ok = <-events
+		}
+	}
+}
+
+func Test_WatcherNodeDown(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	state := getWatcherState(new(downClient))
+	w := NewWatcher(state, new(mock.Clock))
+	w.ErrorInterval = time.Millisecond
+
+	events := w.Subscribe(ctx, 1)
+	go w.Run(ctx)
+
+	event := <-events
+	if event.Type != NodeDownEvent {
+		t.Fatalf("expected %s, got %s", NodeDownEvent, event.Type)
+	}
+	if event.Err == nil {
+		t.Error("expected an error on the node down event")
+	}
+	if state.Status.State != DownState {
+		t.Errorf("expected state %s, got %s", DownState, state.Status.State)
+	}
+}
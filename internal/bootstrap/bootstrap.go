@@ -0,0 +1,127 @@
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/internal/system"
+	"github.com/charmbracelet/log"
+)
+
+// Step is a single idempotent action of the bootstrap.
+type Step interface {
+	// Name identifies the step in the state file and the plan.
+	Name() string
+	// Describe explains what the step does.
+	Describe(env *Env) string
+	// Skip returns why the step does not need to run, empty when it does. It must not change the machine.
+	Skip(ctx context.Context, env *Env) string
+	// Run performs the step.
+	Run(ctx context.Context, env *Env) error
+}
+
+// Env is everything a Step can use.
+type Env struct {
+	// DataDir is the algod data directory flag, empty for the default data directory.
+	DataDir string
+
+	// Answers are the answers to the bootstrap questions.
+	Answers Answers
+
+	// Service manages algod on the machine.
+	Service system.Interface
+
+	// HttpPkg is used for the requests to GitHub and the catchpoint service.
+	HttpPkg api.HttpPkgInterface
+
+	// Time is the local clock.
+	Time system.Time
+
+	// Interval and Timeout bound the wait for the node to respond.
+	Interval time.Duration
+	Timeout  time.Duration
+
+	// client is set once the node responds.
+	client api.ClientWithResponsesInterface
+}
+
+// DefaultSteps are the steps of the bootstrap command, in order.
+func DefaultSteps() []Step {
+	return []Step{
+		InstallStep{},
+		StartStep{},
+		ConnectStep{},
+		CatchupStep{},
+	}
+}
+
+// Action is what a bootstrap does with a step.
+type Action string
+
+const (
+	// RunAction runs the step.
+	RunAction Action = "run"
+
+	// SkipAction skips a step which does not need to run.
+	SkipAction Action = "skip"
+
+	// CompletedAction skips a step completed by the bootstrap being resumed.
+	CompletedAction Action = "completed"
+)
+
+// PlanItem is what a bootstrap would do with a step.
+type PlanItem struct {
+	Step        string `json:"step"`
+	Description string `json:"description"`
+	Action      Action `json:"action"`
+	Reason      string `json:"reason,omitempty"`
+}
+
+// Plan returns what Run would do without changing the machine.
+func Plan(ctx context.Context, steps []Step, env *Env, state *State) []PlanItem {
+	items := make([]PlanItem, 0, len(steps))
+	for _, step := range steps {
+		item := PlanItem{Step: step.Name(), Description: step.Describe(env), Action: RunAction}
+		if state.IsCompleted(step.Name()) {
+			item.Action = CompletedAction
+		} else if reason := step.Skip(ctx, env); reason != "" {
+			item.Action, item.Reason = SkipAction, reason
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// Run runs the steps which are not completed in the state, the state is saved after every step.
+// It stops at the first failed step, which is recorded so the bootstrap can be resumed.
+func Run(ctx context.Context, steps []Step, env *Env, state *State) error {
+	for _, step := range steps {
+		name := step.Name()
+		if state.IsCompleted(name) {
+			log.Info(fmt.Sprintf("Step %s: completed in a previous run", name))
+			continue
+		}
+		if reason := step.Skip(ctx, env); reason != "" {
+			log.Info(fmt.Sprintf("Step %s: skipped, %s", name, reason))
+		} else {
+			log.Info(fmt.Sprintf("Step %s: %s", name, step.Describe(env)))
+			err := step.Run(ctx, env)
+			if err != nil {
+				state.Failed, state.Error = name, err.Error()
+				saveErr := state.Save(env.Time.Now())
+				if saveErr != nil {
+					log.Warn(fmt.Sprintf("unable to save the bootstrap progress: %s", saveErr))
+				}
+				return fmt.Errorf("bootstrap step %s failed: %w", name, err)
+			}
+		}
+		state.complete(name)
+		err := state.Save(env.Time.Now())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
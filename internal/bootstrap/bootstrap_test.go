@@ -0,0 +1,166 @@
+package bootstrap
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/internal/test/mock"
+)
+
+type fixedClock struct{}
+
+func (fixedClock) Now() time.Time { return time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC) }
+
+// fakeStep records its runs, it is done after a successful run
+type fakeStep struct {
+	name string
+	done *bool
+	err  *error
+	runs *[]string
+}
+
+func (s fakeStep) Name() string             { return s.name }
+func (s fakeStep) Describe(env *Env) string { return "run " + s.name }
+func (s fakeStep) Skip(context.Context, *Env) string {
+	if *s.done {
+		return s.name + " is done"
+	}
+	return ""
+}
+func (s fakeStep) Run(context.Context, *Env) error {
+	*s.runs = append(*s.runs, s.name)
+	if *s.err != nil {
+		return *s.err
+	}
+	*s.done = true
+	return nil
+}
+
+func newFakeStep(name string, done bool, runs *[]string) (fakeStep, *error) {
+	var err error
+	return fakeStep{name: name, done: &done, err: &err, runs: runs}, &err
+}
+
+func Test_Run(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	path, err := Path()
+	if err != nil {
+		t.Fatal(err)
+	}
+	state, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	state.Reset(DefaultAnswers)
+
+	var runs []string
+	install, _ := newFakeStep("install", true, &runs)
+	start, startErr := newFakeStep("start", false, &runs)
+	catchup, _ := newFakeStep("catchup", false, &runs)
+	steps := []Step{install, start, catchup}
+	env := &Env{Time: fixedClock{}}
+
+	plan := Plan(context.Background(), steps, env, state)
+	if plan[0].Action != SkipAction || plan[1].Action != RunAction || plan[2].Action != RunAction || len(runs) != 0 {
+		t.Errorf("unexpected plan %+v", plan)
+	}
+
+	// A failed step stops the bootstrap and is recorded
+	*startErr = errors.New("access denied")
+	err = Run(context.Background(), steps, env, state)
+	if !errors.Is(err, *startErr) {
+		t.Fatalf("expected the start to fail, got %v", err)
+	}
+	state, err = Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(state.Completed, ",") != "install" || state.Failed != "start" || state.Error != "access denied" || state.Answers == nil {
+		t.Errorf("unexpected state %+v", state)
+	}
+
+	// Resuming skips the completed steps and retries the failed one
+	*startErr = nil
+	plan = Plan(context.Background(), steps, env, state)
+	if plan[0].Action != CompletedAction || plan[1].Action != RunAction {
+		t.Errorf("unexpected resumed plan %+v", plan)
+	}
+	err = Run(context.Background(), steps, env, state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !state.IsFinished(steps) || state.Failed != "" || strings.Join(runs, ",") != "start,start,catchup" {
+		t.Errorf("unexpected state %+v after %v", state, runs)
+	}
+
+	// A new bootstrap only runs what is not done
+	state.Reset(DefaultAnswers)
+	err = Run(context.Background(), steps, env, state)
+	if err != nil || len(runs) != 3 || !state.IsFinished(steps) {
+		t.Errorf("expected every step to be skipped, got %v: %v", runs, err)
+	}
+}
+
+func Test_LoadAnswers(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "answers.yaml")
+	err := os.WriteFile(path, []byte("catchup: false\n"), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	answers, err := LoadAnswers(path)
+	if err != nil || !answers.Install || answers.Catchup {
+		t.Errorf("expected to install without catchup, got %+v: %v", answers, err)
+	}
+	err = os.WriteFile(path, []byte(`{"install": false}`), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	answers, err = LoadAnswers(path)
+	if err != nil || answers.Install || !answers.Catchup {
+		t.Errorf("expected a catchup without install, got %+v: %v", answers, err)
+	}
+	_, err = LoadAnswers(filepath.Join(t.TempDir(), "missing.yaml"))
+	if err == nil {
+		t.Error("expected a missing answers file to fail")
+	}
+}
+
+func Test_Steps(t *testing.T) {
+	service := &mock.Service{}
+	ctx := context.Background()
+	env := &Env{DataDir: t.TempDir(), Answers: Answers{Install: false, Catchup: true}, Service: service}
+	if (InstallStep{}).Skip(ctx, env) != "" {
+		t.Error("expected algod to be missing")
+	}
+	if err := (InstallStep{}).Run(ctx, env); !errors.Is(err, ErrInstallDeclined) {
+		t.Errorf("expected the declined install to fail, got %v", err)
+	}
+	env.Answers.Install = true
+	if err := (InstallStep{}).Run(ctx, env); err != nil || (InstallStep{}).Skip(ctx, env) == "" {
+		t.Errorf("expected algod to be installed: %v", err)
+	}
+
+	service.Service = false
+	if err := (StartStep{}).Run(ctx, env); !errors.Is(err, ErrNotService) {
+		t.Errorf("expected algod without service to fail, got %v", err)
+	}
+	service.Service = true
+	if err := (StartStep{}).Run(ctx, env); err != nil || (StartStep{}).Skip(ctx, env) == "" {
+		t.Errorf("expected algod to be running: %v", err)
+	}
+	if reason := (CatchupStep{}).Skip(ctx, env); reason != "" {
+		t.Errorf("expected the catchup to run, got %s", reason)
+	}
+	env.Answers.Catchup = false
+	if (CatchupStep{}).Skip(ctx, env) == "" {
+		t.Error("expected the declined catchup to be skipped")
+	}
+	if strings.Join(service.Calls, ",") != "Install,Start" {
+		t.Errorf("unexpected calls %v", service.Calls)
+	}
+}
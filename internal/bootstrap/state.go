@@ -0,0 +1,117 @@
+package bootstrap
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FileName is the file in the home directory recording the progress of the last bootstrap.
+const FileName = ".nodekit-bootstrap.json"
+
+// Answers replace the questions of the bootstrap TUI, e.g. for unattended installs.
+type Answers struct {
+	// Install installs algod when it is missing.
+	Install bool `json:"install" yaml:"install"`
+
+	// Catchup starts a fast catchup once the node responds.
+	Catchup bool `json:"catchup" yaml:"catchup"`
+}
+
+// DefaultAnswers accepts every question.
+var DefaultAnswers = Answers{Install: true, Catchup: true}
+
+// LoadAnswers reads an answers file in YAML or JSON, a missing answer defaults to yes.
+func LoadAnswers(path string) (Answers, error) {
+	answers := DefaultAnswers
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return answers, err
+	}
+	err = yaml.Unmarshal(content, &answers)
+	return answers, err
+}
+
+// State is the progress of a bootstrap, it is saved after every step so a failed bootstrap can be resumed.
+type State struct {
+	// Answers are the answers of the bootstrap, a resumed bootstrap does not ask again.
+	Answers *Answers `json:"answers,omitempty"`
+
+	// Completed lists the steps which ran or did not need to, in order.
+	Completed []string `json:"completed"`
+
+	// Failed is the step which failed, empty when the last bootstrap succeeded or is running.
+	Failed string `json:"failed,omitempty"`
+
+	// Error is the error of the failed step.
+	Error string `json:"error,omitempty"`
+
+	// Updated is when the state was last saved.
+	Updated time.Time `json:"updated"`
+
+	path string
+}
+
+// Path returns the state file in the home directory.
+func Path() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, FileName), nil
+}
+
+// Load reads the state stored at path, a missing file returns an empty state.
+func Load(path string) (*State, error) {
+	s := &State{path: path}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return s, nil
+		}
+		return s, err
+	}
+	return s, json.Unmarshal(content, s)
+}
+
+// Save writes the state to the path it was loaded from.
+func (s *State) Save(now time.Time) error {
+	s.Updated = now
+	content, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(s.path, content, 0o644)
+}
+
+// Reset starts a new bootstrap with the answers.
+func (s *State) Reset(answers Answers) {
+	*s = State{Answers: &answers, path: s.path}
+}
+
+// IsCompleted is true when the step completed in this or a previous run.
+func (s *State) IsCompleted(name string) bool {
+	return slices.Contains(s.Completed, name)
+}
+
+// IsFinished is true when every step completed.
+func (s *State) IsFinished(steps []Step) bool {
+	for _, step := range steps {
+		if !s.IsCompleted(step.Name()) {
+			return false
+		}
+	}
+	return true
+}
+
+// complete records a completed step and clears the previous failure.
+func (s *State) complete(name string) {
+	if !s.IsCompleted(name) {
+		s.Completed = append(s.Completed, name)
+	}
+	s.Failed, s.Error = "", ""
+}
@@ -0,0 +1,199 @@
+package bootstrap
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/system"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/log"
+)
+
+// ErrInstallDeclined is returned when algod is missing and the install question was answered no.
+var ErrInstallDeclined = errors.New("algod is not installed and the install was declined")
+
+// ErrNotService is returned when algod is installed without a service, the bootstrap cannot manage it.
+var ErrNotService = errors.New("algod is installed, but not running as a service, connect to the node with nodekit -d <data directory>")
+
+// Client returns a client of the node, waiting up to the Timeout for it to respond when wait is set.
+func (e *Env) Client(ctx context.Context, wait bool) (api.ClientWithResponsesInterface, error) {
+	if e.client != nil {
+		return e.client, nil
+	}
+	if wait {
+		client, err := algod.WaitForClient(ctx, e.DataDir, e.Interval, e.Timeout)
+		if err != nil {
+			return nil, err
+		}
+		e.client = client
+		return client, nil
+	}
+	dataDir, err := algod.GetDataDir(e.DataDir)
+	if err != nil {
+		return nil, err
+	}
+	client, err := algod.GetClient(dataDir)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.GetStatusWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode() != 200 {
+		return nil, errors.New(algod.InvalidStatus)
+	}
+	e.client = client
+	return client, nil
+}
+
+// InstallStep installs algod with the package manager.
+type InstallStep struct{}
+
+// Name identifies the step.
+func (InstallStep) Name() string { return "install" }
+
+// Describe explains the step.
+func (InstallStep) Describe(*Env) string { return "install algod with the package manager" }
+
+// Skip is set when algod is installed.
+func (InstallStep) Skip(_ context.Context, env *Env) string {
+	if env.Service.IsInstalled() {
+		return "algod is installed"
+	}
+	return ""
+}
+
+// Run installs the latest stable release, unless the install was declined.
+func (InstallStep) Run(_ context.Context, env *Env) error {
+	if !env.Answers.Install {
+		return ErrInstallDeclined
+	}
+	return env.Service.Install(system.Release{})
+}
+
+// StartStep starts the algod service.
+type StartStep struct{}
+
+// Name identifies the step.
+func (StartStep) Name() string { return "start" }
+
+// Describe explains the step.
+func (StartStep) Describe(*Env) string { return "start the algod service" }
+
+// Skip is set when the algod service is running.
+func (StartStep) Skip(_ context.Context, env *Env) string {
+	if env.Service.IsService() && algod.IsRunning(env.Service, env.DataDir) {
+		return "algod is running"
+	}
+	return ""
+}
+
+// Run starts the service, algod installed without a service is left alone.
+func (StartStep) Run(_ context.Context, env *Env) error {
+	if !env.Service.IsService() {
+		return ErrNotService
+	}
+	return env.Service.Start()
+}
+
+// ConnectStep waits for the node to respond.
+type ConnectStep struct{}
+
+// Name identifies the step.
+func (ConnectStep) Name() string { return "connect" }
+
+// Describe explains the step.
+func (ConnectStep) Describe(env *Env) string {
+	return "wait up to " + env.Timeout.String() + " for the node to respond"
+}
+
+// Skip is set when the node responds.
+func (ConnectStep) Skip(ctx context.Context, env *Env) string {
+	if _, err := env.Client(ctx, false); err == nil {
+		return "the node is responding"
+	}
+	return ""
+}
+
+// Run waits for the node.
+func (ConnectStep) Run(ctx context.Context, env *Env) error {
+	_, err := env.Client(ctx, true)
+	return err
+}
+
+// CatchupStep starts a fast catchup to the latest catchpoint of the network.
+type CatchupStep struct{}
+
+// Name identifies the step.
+func (CatchupStep) Name() string { return "catchup" }
+
+// Describe explains the step.
+func (CatchupStep) Describe(*Env) string { return "start a fast catchup to the latest catchpoint" }
+
+// Skip is set when the catchup was declined, is running or is slower than syncing.
+// The sync rate of a node that just started is not representative so the default is used.
+func (CatchupStep) Skip(ctx context.Context, env *Env) string {
+	if !env.Answers.Catchup {
+		return "the fast catchup was declined"
+	}
+	client, err := env.Client(ctx, false)
+	if err != nil {
+		return ""
+	}
+	status, _, err := algod.NewStatus(ctx, client, env.HttpPkg)
+	if err != nil {
+		return ""
+	}
+	if status.State == algod.FastCatchupState {
+		return "a fast catchup is running"
+	}
+	dataDir, _ := algod.GetDataDir(env.DataDir)
+	estimate, err := algod.GetCatchupEstimate(ctx, status, dataDir, 0)
+	if err == nil && !estimate.Recommended {
+		return estimate.Reason
+	}
+	return ""
+}
+
+// Run starts the fast catchup, the catchpoint is verified by the catchpoint sources when the settings require it.
+func (CatchupStep) Run(ctx context.Context, env *Env) error {
+	client, err := env.Client(ctx, true)
+	if err != nil {
+		return err
+	}
+	// The genesis hash of the node tells apart networks sharing a genesis ID
+	version, _, err := algod.GetVersion(ctx, client)
+	if err != nil {
+		return err
+	}
+	network, genesisHash := version.Network, version.GenesisHash
+	var catchpoint string
+	if algod.IsCatchpointVerificationEnabled() {
+		var verification algod.Verification
+		verification, err = algod.GetVerifiedCatchpoint(ctx, env.HttpPkg, network, genesisHash)
+		if err == nil {
+			log.Info(style.Green.Render("Catchpoint confirmed by " + strings.Join(verification.Agreed, ", ")))
+		}
+		catchpoint = verification.Catchpoint
+	} else {
+		catchpoint, _, err = algod.GetLatestCatchpoint(env.HttpPkg, network, genesisHash)
+	}
+	if errors.Is(err, api.ErrInvalidNetwork) {
+		return errors.New("this network does not support fast-catchup")
+	}
+	if err != nil {
+		return err
+	}
+	log.Info(style.Green.Render("Latest Catchpoint: " + catchpoint))
+
+	res, _, err := algod.StartCatchup(ctx, client, catchpoint, nil)
+	if err != nil {
+		return err
+	}
+	log.Info(style.Green.Render(res))
+	return nil
+}
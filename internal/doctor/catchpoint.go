@@ -0,0 +1,52 @@
+package doctor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+)
+
+// CatchpointCheck fails when the node is further behind the latest catchpoint than Threshold rounds,
+// a fast catchup is quicker than syncing that many blocks.
+// A zero Threshold uses the lagging threshold of the network.
+type CatchpointCheck struct {
+	Threshold uint64
+}
+
+// Name identifies the check.
+func (CatchpointCheck) Name() string { return "catchpoint" }
+
+// Run reports the lag against the latest catchpoint of the network.
+func (c CatchpointCheck) Run(_ context.Context, env Env) Report {
+	if env.State == nil {
+		return skipUnreachable
+	}
+	status := env.State.Status
+	if status.State == algod.FastCatchupState {
+		return pass("fast catchup is in progress")
+	}
+	catchpoint, _, err := algod.GetLatestCatchpoint(env.HttpPkg, status.Network, status.GenesisHash)
+	if errors.Is(err, api.ErrInvalidNetwork) {
+		return skip(fmt.Sprintf("no catchpoints are published for %s", status.Network))
+	}
+	if err != nil {
+		return warn(fmt.Sprintf("unable to fetch the latest catchpoint: %s", err), "Check the internet connection of the machine")
+	}
+	round, err := algod.ParseCatchpointRound(catchpoint)
+	if err != nil {
+		return warn(fmt.Sprintf("invalid catchpoint %q", catchpoint), "Try again later")
+	}
+
+	threshold := c.Threshold
+	if threshold == 0 {
+		threshold = uint64(algod.GetLaggingThreshold(status.Network, status.GenesisHash))
+	}
+	if round > status.LastRound && round-status.LastRound > threshold {
+		return fail(fmt.Sprintf("round %d is %d rounds behind the latest catchpoint %d", status.LastRound, round-status.LastRound, round),
+			"Fast catchup with *nodekit catchup*")
+	}
+	return pass(fmt.Sprintf("round %d is within %d rounds of the latest catchpoint %d", status.LastRound, threshold, round))
+}
@@ -0,0 +1,29 @@
+package doctor
+
+import (
+	"testing"
+
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+)
+
+func Test_CatchpointCheck(t *testing.T) {
+	check := CatchpointCheck{Threshold: algod.CATCHPOINT_THRESHOLD}
+	catchpoint := func(label string) fakeHttp {
+		return fakeHttp{bodies: map[string]string{string(api.MainNet): label}}
+	}
+	state := runningState()
+
+	expectResult(t, check, Env{State: state, HttpPkg: catchpoint("1010000#ABC")}, PassResult)
+	expectResult(t, check, Env{State: state, HttpPkg: catchpoint("1100000#ABC")}, FailResult)
+	expectResult(t, check, Env{State: state, HttpPkg: catchpoint("invalid")}, WarnResult)
+	expectResult(t, check, Env{State: state, HttpPkg: fakeHttp{}}, WarnResult)
+
+	state.Status.State = algod.FastCatchupState
+	expectResult(t, check, Env{State: state, HttpPkg: fakeHttp{}}, PassResult)
+
+	state.Status.State = algod.StableState
+	state.Status.Network = "devnet-v1"
+	expectResult(t, check, Env{State: state, HttpPkg: fakeHttp{}}, SkipResult)
+	expectResult(t, check, Env{}, SkipResult)
+}
@@ -0,0 +1,58 @@
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/internal/algod"
+)
+
+// DefaultClockWarn is the clock skew that is reported as a warning, a few rounds.
+const DefaultClockWarn = 10 * time.Second
+
+// DefaultClockFail is the clock skew that is reported as a failure.
+const DefaultClockFail = time.Minute
+
+// ClockCheck compares the local clock with the timestamp of the latest block.
+// A synced node sees a new block every few seconds, a larger difference means the clock is off.
+type ClockCheck struct {
+	Warn, Fail time.Duration
+}
+
+// Name identifies the check.
+func (ClockCheck) Name() string { return "clock" }
+
+// Run reports the skew between the local clock and the latest block.
+func (c ClockCheck) Run(ctx context.Context, env Env) Report {
+	if env.State == nil {
+		return skipUnreachable
+	}
+	if env.State.Status.State != algod.StableState {
+		return skip("the node is not synced")
+	}
+	blockTime, _, err := algod.GetBlockTime(ctx, env.State.Client, env.State.Status.LastRound)
+	if err != nil {
+		return warn(fmt.Sprintf("unable to get block %d: %s", env.State.Status.LastRound, err), "Check the algod logs")
+	}
+
+	skew := env.Time.Now().Sub(blockTime)
+	abs := skew
+	if abs < 0 {
+		abs = -abs
+	}
+	direction := "behind"
+	if skew > 0 {
+		direction = "ahead of"
+	}
+	message := fmt.Sprintf("the local clock is %s %s block %d", abs.Round(time.Second), direction, env.State.Status.LastRound)
+	fix := "Synchronize the clock with NTP, e.g. *sudo timedatectl set-ntp true*"
+	switch {
+	case abs >= c.Fail:
+		return fail(message, fix)
+	case abs >= c.Warn:
+		return warn(message, fix)
+	default:
+		return pass(message)
+	}
+}
@@ -0,0 +1,30 @@
+package doctor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/internal/algod"
+)
+
+func Test_ClockCheck(t *testing.T) {
+	check := ClockCheck{Warn: DefaultClockWarn, Fail: DefaultClockFail}
+	withBlock := func(timestamp time.Time) Env {
+		state := runningState()
+		state.Client = blockClient{timestamp: timestamp}
+		return Env{State: state, Time: fixedClock{}}
+	}
+
+	expectResult(t, check, withBlock(now.Add(-3*time.Second)), PassResult)
+	expectResult(t, check, withBlock(now.Add(-20*time.Second)), WarnResult)
+	expectResult(t, check, withBlock(now.Add(20*time.Second)), WarnResult)
+	report := expectResult(t, check, withBlock(now.Add(2*time.Minute)), FailResult)
+	if report.Message != "the local clock is 2m0s behind block 1000000" {
+		t.Errorf("unexpected message %s", report.Message)
+	}
+
+	syncing := withBlock(now)
+	syncing.State.Status.State = algod.SyncingState
+	expectResult(t, check, syncing, SkipResult)
+	expectResult(t, check, Env{Time: fixedClock{}}, SkipResult)
+}
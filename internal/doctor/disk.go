@@ -0,0 +1,63 @@
+package doctor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/algorandfoundation/nodekit/internal/algod/utils"
+)
+
+// MiB is a mebibyte, used by the ledger growth estimates.
+const MiB = 1024 * 1024
+
+// DefaultGrowthPerDay is a rough estimate of the daily ledger growth of a non-archival mainnet node.
+const DefaultGrowthPerDay = 100 * MiB
+
+// DefaultArchivalGrowthPerDay is a rough estimate of the daily ledger growth of an archival mainnet node,
+// which keeps every block.
+const DefaultArchivalGrowthPerDay = 4 * 1024 * MiB
+
+// DiskCheck compares the free space of the data directory with the expected growth of the ledger.
+type DiskCheck struct {
+	// GrowthPerDay is the expected daily growth of a non-archival ledger in bytes.
+	GrowthPerDay uint64
+	// ArchivalGrowthPerDay is the expected daily growth of an archival ledger in bytes.
+	ArchivalGrowthPerDay uint64
+	// WarnDays and FailDays are the number of days of growth the free space must cover.
+	WarnDays, FailDays uint64
+}
+
+// Name identifies the check.
+func (DiskCheck) Name() string { return "disk" }
+
+// Run reports how many days of ledger growth fit on the disk.
+func (c DiskCheck) Run(_ context.Context, env Env) Report {
+	if env.DataDir == "" || env.DiskFree == nil {
+		return skipRemote
+	}
+	free, err := env.DiskFree(env.DataDir)
+	if err != nil {
+		return warn(fmt.Sprintf("unable to get the free space of %s: %s", env.DataDir, err), "Check that the data directory exists")
+	}
+
+	growth, kind := c.GrowthPerDay, "ledger"
+	values, err := utils.GetConfigValuesFromDataDir(env.DataDir)
+	if err == nil && string(values["Archival"]) == "true" {
+		growth, kind = c.ArchivalGrowthPerDay, "archival ledger"
+	}
+	if growth == 0 {
+		return pass(fmt.Sprintf("%d MB free", free/MiB))
+	}
+
+	days := free / growth
+	message := fmt.Sprintf("%d MB free, about %d days of %s growth", free/MiB, days, kind)
+	fix := fmt.Sprintf("Free up or add space to %s", env.DataDir)
+	switch {
+	case days < c.FailDays:
+		return fail(message, fix)
+	case days < c.WarnDays:
+		return warn(message, fix)
+	default:
+		return pass(message)
+	}
+}
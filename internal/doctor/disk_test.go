@@ -0,0 +1,29 @@
+package doctor
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func Test_DiskCheck(t *testing.T) {
+	dir := t.TempDir()
+	check := DiskCheck{GrowthPerDay: 10 * MiB, ArchivalGrowthPerDay: 100 * MiB, WarnDays: 30, FailDays: 7}
+	free := func(bytes uint64) func(string) (uint64, error) {
+		return func(string) (uint64, error) { return bytes, nil }
+	}
+
+	expectResult(t, check, Env{DataDir: dir, DiskFree: free(1000 * MiB)}, PassResult)
+	expectResult(t, check, Env{DataDir: dir, DiskFree: free(100 * MiB)}, WarnResult)
+	expectResult(t, check, Env{DataDir: dir, DiskFree: free(50 * MiB)}, FailResult)
+	expectResult(t, check, Env{DataDir: dir, DiskFree: func(string) (uint64, error) { return 0, errors.New("no such file") }}, WarnResult)
+	expectResult(t, check, Env{DiskFree: free(0)}, SkipResult)
+
+	// An archival node grows faster
+	err := os.WriteFile(filepath.Join(dir, "config.json"), []byte(`{"Archival": true}`), 0o664)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectResult(t, check, Env{DataDir: dir, DiskFree: free(1000 * MiB)}, WarnResult)
+}
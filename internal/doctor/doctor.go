@@ -0,0 +1,143 @@
+package doctor
+
+import (
+	"context"
+	"errors"
+
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/internal/alerts"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/system"
+)
+
+// Result is the outcome of a Check.
+type Result string
+
+const (
+	// PassResult means nothing needs to be done.
+	PassResult Result = "pass"
+
+	// WarnResult means the node works but should be looked at.
+	WarnResult Result = "warn"
+
+	// FailResult means the node is or will soon be unable to do its job.
+	FailResult Result = "fail"
+
+	// SkipResult means the check does not apply, e.g. a file check against a remote node.
+	SkipResult Result = "skip"
+)
+
+// ErrFailed is returned when at least one check failed.
+var ErrFailed = errors.New("node health checks failed")
+
+// Report is the outcome of a single Check with a suggestion to fix it.
+type Report struct {
+	Check   string `json:"check"`
+	Result  Result `json:"result"`
+	Message string `json:"message"`
+	Fix     string `json:"fix,omitempty"`
+}
+
+// Check is a single diagnostic of the node.
+type Check interface {
+	// Name identifies the check in the reports.
+	Name() string
+	// Run inspects the node and reports the outcome, the Check field is set by Run.
+	Run(ctx context.Context, env Env) Report
+}
+
+// Env is everything a Check can inspect.
+// The system functions are fields so the checks can be tested without a node.
+type Env struct {
+	// DataDir is the algod data directory, empty for a node on another machine.
+	DataDir string
+
+	// State is the current state of the node, nil when it could not be reached.
+	State *algod.StateModel
+
+	// Err is the error returned while connecting to the node.
+	Err error
+
+	// HttpPkg is used for the requests to GitHub and the catchpoint service.
+	HttpPkg api.HttpPkgInterface
+
+	// Time is the local clock.
+	Time system.Time
+
+	// DiskFree returns the bytes available to the user at a path.
+	DiskFree func(path string) (uint64, error)
+
+	// ServiceEnabled reports whether the algod service starts with the system.
+	ServiceEnabled func() bool
+
+	// ServiceStatus returns the health of the algod service, it may be nil when the service manager is unsupported.
+	ServiceStatus func() (system.ServiceStatus, error)
+}
+
+// DefaultChecks are the checks run by the doctor command, in order.
+func DefaultChecks() []Check {
+	return []Check{
+		NodeCheck{},
+		ServiceCheck{},
+		VersionCheck{},
+		DiskCheck{GrowthPerDay: DefaultGrowthPerDay, ArchivalGrowthPerDay: DefaultArchivalGrowthPerDay, WarnDays: 30, FailDays: 7},
+		TokenCheck{},
+		ClockCheck{Warn: DefaultClockWarn, Fail: DefaultClockFail},
+		PeerCheck{Warn: 4},
+		NetworkCheck{},
+		CatchpointCheck{},
+		UpgradeCheck{},
+		KeysCheck{Thresholds: alerts.DefaultThresholds},
+	}
+}
+
+// Run runs every check in order and returns their reports.
+func Run(ctx context.Context, checks []Check, env Env) []Report {
+	reports := make([]Report, 0, len(checks))
+	for _, check := range checks {
+		report := check.Run(ctx, env)
+		report.Check = check.Name()
+		reports = append(reports, report)
+	}
+	return reports
+}
+
+// Worst returns the most severe result of the reports, a skipped check counts as passed.
+func Worst(reports []Report) Result {
+	worst := PassResult
+	for _, report := range reports {
+		switch report.Result {
+		case FailResult:
+			return FailResult
+		case WarnResult:
+			worst = WarnResult
+		}
+	}
+	return worst
+}
+
+// pass reports a check without findings.
+func pass(message string) Report {
+	return Report{Result: PassResult, Message: message}
+}
+
+// warn reports a finding that should be looked at.
+func warn(message string, fix string) Report {
+	return Report{Result: WarnResult, Message: message, Fix: fix}
+}
+
+// fail reports a finding that needs to be fixed.
+func fail(message string, fix string) Report {
+	return Report{Result: FailResult, Message: message, Fix: fix}
+}
+
+// skip reports a check that does not apply.
+func skip(message string) Report {
+	return Report{Result: SkipResult, Message: message}
+}
+
+// skipUnreachable is reported by the checks that need a running node.
+var skipUnreachable = skip("algod is not reachable")
+
+// skipRemote is reported by the checks that need the local data directory.
+var skipRemote = skip("not available for a node on another machine")
@@ -0,0 +1,114 @@
+package doctor
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+)
+
+// now is the time of the fixed test clock
+var now = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+type fixedClock struct{}
+
+func (fixedClock) Now() time.Time { return now }
+
+// fakeHttp returns the body of the matching url, or an error for unknown urls
+type fakeHttp struct {
+	api.HttpPkgInterface
+	bodies map[string]string
+}
+
+func (h fakeHttp) Get(url string) (*http.Response, error) {
+	body, ok := h.bodies[url]
+	if !ok {
+		return nil, errors.New("no route to host")
+	}
+	return &http.Response{StatusCode: 200, Status: "200 OK", Body: io.NopCloser(bytes.NewReader([]byte(body)))}, nil
+}
+
+// blockClient returns a block with the given timestamp
+type blockClient struct {
+	api.ClientWithResponsesInterface
+	timestamp time.Time
+}
+
+func (c blockClient) GetBlockWithResponse(ctx context.Context, round int, params *api.GetBlockParams, reqEditors ...api.RequestEditorFn) (*api.GetBlockResponse, error) {
+	response := &api.GetBlockResponse{HTTPResponse: &http.Response{StatusCode: 200}}
+	response.JSON200 = &struct {
+		Block map[string]interface{}  `json:"block"`
+		Cert  *map[string]interface{} `json:"cert,omitempty"`
+	}{Block: map[string]interface{}{"ts": float64(c.timestamp.Unix())}}
+	return response, nil
+}
+
+// runningState is a synced mainnet node
+func runningState() *algod.StateModel {
+	return &algod.StateModel{
+		Status: algod.Status{
+			State:     algod.StableState,
+			Version:   "v3.26.0-stable",
+			Network:   "mainnet-v1.0",
+			LastRound: 1_000_000,
+		},
+		Metrics:  algod.Metrics{Enabled: true, PeersWS: 8},
+		Accounts: map[string]algod.Account{},
+	}
+}
+
+// namedCheck always reports the same result
+type namedCheck struct {
+	name   string
+	result Result
+}
+
+func (c namedCheck) Name() string { return c.name }
+func (c namedCheck) Run(context.Context, Env) Report {
+	return Report{Result: c.result, Message: c.name}
+}
+
+func Test_Run(t *testing.T) {
+	checks := []Check{namedCheck{"a", PassResult}, namedCheck{"b", SkipResult}, namedCheck{"c", WarnResult}}
+	reports := Run(context.Background(), checks, Env{})
+	if len(reports) != 3 || reports[0].Check != "a" || reports[2].Check != "c" {
+		t.Errorf("expected a report per check in order, got %+v", reports)
+	}
+	if Worst(reports) != WarnResult {
+		t.Errorf("expected a warning, got %s", Worst(reports))
+	}
+	reports = Run(context.Background(), append(checks, namedCheck{"d", FailResult}), Env{})
+	if Worst(reports) != FailResult {
+		t.Errorf("expected a failure, got %s", Worst(reports))
+	}
+	if Worst(Run(context.Background(), checks[:2], Env{})) != PassResult {
+		t.Error("expected a skipped check to count as passed")
+	}
+
+	names := make(map[string]bool)
+	for _, check := range DefaultChecks() {
+		if names[check.Name()] {
+			t.Errorf("check %s is listed twice", check.Name())
+		}
+		names[check.Name()] = true
+	}
+}
+
+// expectResult runs a check and compares its result
+func expectResult(t *testing.T, check Check, env Env, expected Result) Report {
+	t.Helper()
+	report := check.Run(context.Background(), env)
+	if report.Result != expected {
+		t.Errorf("expected %s, got %s: %s", expected, report.Result, report.Message)
+	}
+	if (report.Result == WarnResult || report.Result == FailResult) && report.Fix == "" {
+		t.Errorf("expected a fix for %s", report.Message)
+	}
+	return report
+}
@@ -0,0 +1,52 @@
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/algorandfoundation/nodekit/internal/alerts"
+)
+
+// KeysCheck fails for accounts registered online with a key that is not on the node,
+// and uses the alert Thresholds for keys that expire soon.
+type KeysCheck struct {
+	Thresholds []alerts.Threshold
+}
+
+// Name identifies the check.
+func (KeysCheck) Name() string { return "keys" }
+
+// Run reports non-resident and expiring participation keys.
+func (c KeysCheck) Run(_ context.Context, env Env) Report {
+	if env.State == nil {
+		return skipUnreachable
+	}
+	snapshot := env.State.Snapshot()
+	if len(snapshot.Accounts) == 0 {
+		return skip("no participation keys are installed")
+	}
+
+	result := PassResult
+	var problems, fixes []string
+	for _, acct := range snapshot.Accounts {
+		if acct.NonResidentKey {
+			result = FailResult
+			problems = append(problems, fmt.Sprintf("%s is online with a key that is not on this node", acct.Address))
+			fixes = append(fixes, fmt.Sprintf("Install its key or register the account offline with *nodekit keys keyreg --offline -a %s*", acct.Address))
+		}
+	}
+	for _, alert := range alerts.Evaluate(snapshot, c.Thresholds, env.Time) {
+		if alert.Level == alerts.CriticalLevel {
+			result = FailResult
+		} else if result == PassResult {
+			result = WarnResult
+		}
+		problems = append(problems, alert.Message)
+		fixes = append(fixes, fmt.Sprintf("Rotate the key with *nodekit keys rotate -a %s --apply*", alert.Address))
+	}
+	if result == PassResult {
+		return pass(fmt.Sprintf("%d accounts have resident keys that are not expiring", len(snapshot.Accounts)))
+	}
+	return Report{Result: result, Message: strings.Join(problems, "; "), Fix: strings.Join(fixes, "; ")}
+}
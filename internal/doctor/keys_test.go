@@ -0,0 +1,40 @@
+package doctor
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/internal/alerts"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+)
+
+func Test_KeysCheck(t *testing.T) {
+	check := KeysCheck{Thresholds: alerts.DefaultThresholds}
+	state := runningState()
+	env := Env{State: state, Time: fixedClock{}}
+	expectResult(t, check, env, SkipResult)
+
+	later := now.Add(60 * alerts.Day)
+	soon := now.Add(7 * alerts.Day)
+	participation := func(last int) *api.AccountParticipation {
+		return &api.AccountParticipation{VoteLastValid: last}
+	}
+	state.Accounts["A"] = algod.Account{Address: "A", Participation: participation(2_000_000), Expires: &later}
+	expectResult(t, check, env, PassResult)
+
+	state.Accounts["B"] = algod.Account{Address: "B", Participation: participation(1_200_000), Expires: &soon}
+	expectResult(t, check, env, WarnResult)
+
+	state.Accounts["C"] = algod.Account{Address: "C", NonResidentKey: true}
+	report := expectResult(t, check, env, FailResult)
+	if !strings.Contains(report.Fix, "keyreg --offline -a C") || !strings.Contains(report.Fix, "rotate -a B") {
+		t.Errorf("expected a fix for both accounts, got %s", report.Fix)
+	}
+
+	delete(state.Accounts, "C")
+	expired := now.Add(-time.Hour)
+	state.Accounts["B"] = algod.Account{Address: "B", Participation: participation(900_000), Expires: &expired}
+	expectResult(t, check, env, FailResult)
+}
@@ -0,0 +1,28 @@
+package doctor
+
+import (
+	"context"
+
+	"github.com/algorandfoundation/nodekit/internal/algod"
+)
+
+// NetworkCheck compares the P2P mode of the config with the observed WS and P2P traffic.
+type NetworkCheck struct{}
+
+// Name identifies the check.
+func (NetworkCheck) Name() string { return "network" }
+
+// Run reports a mismatch between the config and the traffic.
+func (NetworkCheck) Run(_ context.Context, env Env) Report {
+	if env.State == nil {
+		return skipUnreachable
+	}
+	if !env.State.Metrics.Enabled {
+		return skip("the node does not report metrics")
+	}
+	if algod.IsNetworkMismatch(env.State.Config, env.State.Metrics) {
+		return warn("the WS and P2P traffic does not match EnableP2P and EnableP2PHybridMode in config.json",
+			"Review the P2P options with *nodekit configure algod* and restart the node")
+	}
+	return pass("the traffic matches the P2P configuration")
+}
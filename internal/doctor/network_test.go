@@ -0,0 +1,32 @@
+package doctor
+
+import (
+	"testing"
+
+	"github.com/algorandfoundation/nodekit/internal/algod/config"
+)
+
+func Test_NetworkCheck(t *testing.T) {
+	hybrid := true
+	state := runningState()
+
+	// No traffic yet
+	expectResult(t, NetworkCheck{}, Env{State: state}, PassResult)
+
+	state.Metrics.RX, state.Metrics.TX = 100, 100
+	expectResult(t, NetworkCheck{}, Env{State: state}, PassResult)
+
+	// Hybrid mode without P2P traffic
+	state.Config = &config.Config{EnableP2PHybridMode: &hybrid}
+	expectResult(t, NetworkCheck{}, Env{State: state}, WarnResult)
+
+	state.Metrics.RXP2P = 100
+	expectResult(t, NetworkCheck{}, Env{State: state}, PassResult)
+
+	// WS only with P2P traffic
+	state.Config = nil
+	expectResult(t, NetworkCheck{}, Env{State: state}, WarnResult)
+
+	state.Metrics.Enabled = false
+	expectResult(t, NetworkCheck{}, Env{State: state}, SkipResult)
+}
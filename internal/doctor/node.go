@@ -0,0 +1,35 @@
+package doctor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/algorandfoundation/nodekit/internal/algod"
+)
+
+// NodeCheck fails when algod could not be reached, most other checks are skipped then.
+type NodeCheck struct{}
+
+// Name identifies the check.
+func (NodeCheck) Name() string { return "node" }
+
+// Run reports the state of the node.
+func (NodeCheck) Run(_ context.Context, env Env) Report {
+	if env.State == nil {
+		message := "algod is not reachable"
+		if env.Err != nil {
+			message = fmt.Sprintf("algod is not reachable: %s", env.Err)
+		}
+		fix := "Start the node with *nodekit start*"
+		if env.DataDir == "" {
+			fix = "Check the endpoint and token of the connection profile"
+		}
+		return fail(message, fix)
+	}
+	switch env.State.Status.State {
+	case algod.StableState:
+		return pass(fmt.Sprintf("algod %s is running on %s at round %d", env.State.Status.Version, env.State.Status.Network, env.State.Status.LastRound))
+	default:
+		return pass(fmt.Sprintf("algod %s is %s on %s at round %d", env.State.Status.Version, env.State.Status.State, env.State.Status.Network, env.State.Status.LastRound))
+	}
+}
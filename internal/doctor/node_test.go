@@ -0,0 +1,19 @@
+package doctor
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func Test_NodeCheck(t *testing.T) {
+	report := expectResult(t, NodeCheck{}, Env{DataDir: "/data", Err: errors.New("connection refused")}, FailResult)
+	if !strings.Contains(report.Message, "connection refused") || !strings.Contains(report.Fix, "nodekit start") {
+		t.Errorf("unexpected report %+v", report)
+	}
+	report = expectResult(t, NodeCheck{}, Env{Err: errors.New("connection refused")}, FailResult)
+	if !strings.Contains(report.Fix, "profile") {
+		t.Errorf("expected a remote node to point to the profile, got %s", report.Fix)
+	}
+	expectResult(t, NodeCheck{}, Env{State: runningState()}, PassResult)
+}
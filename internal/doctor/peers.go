@@ -0,0 +1,36 @@
+package doctor
+
+import (
+	"context"
+	"fmt"
+)
+
+// PeerCheck fails without peers and warns when the node has fewer than Warn peers.
+type PeerCheck struct {
+	Warn uint64
+}
+
+// Name identifies the check.
+func (PeerCheck) Name() string { return "peers" }
+
+// Run reports the number of WS and P2P peers.
+func (c PeerCheck) Run(_ context.Context, env Env) Report {
+	if env.State == nil {
+		return skipUnreachable
+	}
+	metrics := env.State.Metrics
+	if !metrics.Enabled {
+		return skip("the node does not report metrics")
+	}
+	peers := metrics.PeersWS + metrics.PeersP2P
+	message := fmt.Sprintf("%d peers (%d WS, %d P2P)", peers, metrics.PeersWS, metrics.PeersP2P)
+	fix := "Check the network connection and the firewall of the machine"
+	switch {
+	case peers == 0:
+		return fail(message, fix)
+	case peers < c.Warn:
+		return warn(message, fix)
+	default:
+		return pass(message)
+	}
+}
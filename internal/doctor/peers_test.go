@@ -0,0 +1,19 @@
+package doctor
+
+import "testing"
+
+func Test_PeerCheck(t *testing.T) {
+	check := PeerCheck{Warn: 4}
+	state := runningState()
+	expectResult(t, check, Env{State: state}, PassResult)
+
+	state.Metrics.PeersWS, state.Metrics.PeersP2P = 1, 2
+	expectResult(t, check, Env{State: state}, WarnResult)
+
+	state.Metrics.PeersWS, state.Metrics.PeersP2P = 0, 0
+	expectResult(t, check, Env{State: state}, FailResult)
+
+	state.Metrics.Enabled = false
+	expectResult(t, check, Env{State: state}, SkipResult)
+	expectResult(t, check, Env{}, SkipResult)
+}
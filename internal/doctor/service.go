@@ -0,0 +1,40 @@
+package doctor
+
+import (
+	"context"
+	"fmt"
+)
+
+// ServiceCheck fails when the algod service failed and warns when it restarted or does not start with the system.
+type ServiceCheck struct{}
+
+// Name identifies the check.
+func (ServiceCheck) Name() string { return "service" }
+
+// Run reports the health of the service and whether it is enabled.
+func (ServiceCheck) Run(_ context.Context, env Env) Report {
+	if env.DataDir == "" {
+		return skipRemote
+	}
+	if env.ServiceStatus != nil {
+		status, err := env.ServiceStatus()
+		if err == nil {
+			message := fmt.Sprintf("the algod service is %s (%s) with exit code %d", status.ActiveState, status.SubState, status.ExitCode)
+			if len(status.Journal) > 0 {
+				message = fmt.Sprintf("%s, last log: %s", message, status.Journal[len(status.Journal)-1])
+			}
+			if status.IsFailed() {
+				return fail(message, "Check the logs with *journalctl -u algorand* and start the node with *nodekit start*")
+			}
+			if status.Restarts > 0 {
+				return warn(fmt.Sprintf("the algod service restarted %d times, %s", status.Restarts, message),
+					"Check the logs with *journalctl -u algorand*")
+			}
+		}
+	}
+	if env.ServiceEnabled == nil || !env.ServiceEnabled() {
+		return warn("the algod service is not enabled, the node will not start after a reboot",
+			"Enable the service with *sudo systemctl enable algorand*, or reinstall it with *nodekit install*")
+	}
+	return pass("the algod service is enabled")
+}
@@ -0,0 +1,27 @@
+package doctor
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/algorandfoundation/nodekit/internal/system"
+)
+
+func Test_ServiceCheck(t *testing.T) {
+	enabled := func() bool { return true }
+	disabled := func() bool { return false }
+	expectResult(t, ServiceCheck{}, Env{DataDir: "/data", ServiceEnabled: enabled}, PassResult)
+	expectResult(t, ServiceCheck{}, Env{DataDir: "/data", ServiceEnabled: disabled}, WarnResult)
+	expectResult(t, ServiceCheck{}, Env{ServiceEnabled: disabled}, SkipResult)
+
+	status := func(s system.ServiceStatus, err error) func() (system.ServiceStatus, error) {
+		return func() (system.ServiceStatus, error) { return s, err }
+	}
+	running := system.ServiceStatus{ActiveState: "active", SubState: "running"}
+	failed := system.ServiceStatus{ActiveState: "failed", SubState: "failed", ExitCode: 1, Journal: []string{"unable to open ledger"}}
+	restarted := system.ServiceStatus{ActiveState: "active", SubState: "running", Restarts: 2}
+	expectResult(t, ServiceCheck{}, Env{DataDir: "/data", ServiceEnabled: enabled, ServiceStatus: status(running, nil)}, PassResult)
+	expectResult(t, ServiceCheck{}, Env{DataDir: "/data", ServiceEnabled: enabled, ServiceStatus: status(failed, nil)}, FailResult)
+	expectResult(t, ServiceCheck{}, Env{DataDir: "/data", ServiceEnabled: enabled, ServiceStatus: status(restarted, nil)}, WarnResult)
+	expectResult(t, ServiceCheck{}, Env{DataDir: "/data", ServiceEnabled: enabled, ServiceStatus: status(failed, errors.New("no bus"))}, PassResult)
+}
@@ -0,0 +1,57 @@
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// TokenCheck verifies the permissions of the algod API token files.
+// The admin token grants full control of the node and must not be readable by other users.
+type TokenCheck struct{}
+
+// Name identifies the check.
+func (TokenCheck) Name() string { return "tokens" }
+
+// Run reports token files that are missing or too permissive.
+func (TokenCheck) Run(_ context.Context, env Env) Report {
+	if env.DataDir == "" {
+		return skipRemote
+	}
+	result := PassResult
+	var problems, fixes []string
+	missing := false
+	for _, name := range []string{"algod.token", "algod.admin.token"} {
+		path := filepath.Join(env.DataDir, name)
+		info, err := os.Stat(path)
+		if err != nil {
+			result = FailResult
+			problems = append(problems, fmt.Sprintf("unable to read %s", name))
+			if !missing {
+				fixes = append(fixes, "Start the node once so algod creates the token files")
+				missing = true
+			}
+			continue
+		}
+		mode := info.Mode().Perm()
+		admin := name == "algod.admin.token"
+		switch {
+		case mode&0o002 != 0 || (admin && mode&0o004 != 0):
+			result = FailResult
+		case admin && mode&0o040 != 0:
+			if result == PassResult {
+				result = WarnResult
+			}
+		default:
+			continue
+		}
+		problems = append(problems, fmt.Sprintf("%s has mode %s", name, mode))
+		fixes = append(fixes, fmt.Sprintf("chmod 600 %s", path))
+	}
+	if result == PassResult {
+		return pass("the token files are private")
+	}
+	return Report{Result: result, Message: strings.Join(problems, ", "), Fix: strings.Join(fixes, "; ")}
+}
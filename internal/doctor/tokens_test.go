@@ -0,0 +1,38 @@
+package doctor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func Test_TokenCheck(t *testing.T) {
+	dir := t.TempDir()
+	expectResult(t, TokenCheck{}, Env{DataDir: dir}, FailResult)
+
+	write := func(name string, mode os.FileMode) {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte("token"), mode); err != nil {
+			t.Fatal(err)
+		}
+		// Ignore the umask
+		if err := os.Chmod(path, mode); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("algod.token", 0o644)
+	write("algod.admin.token", 0o600)
+	expectResult(t, TokenCheck{}, Env{DataDir: dir}, PassResult)
+
+	write("algod.admin.token", 0o640)
+	expectResult(t, TokenCheck{}, Env{DataDir: dir}, WarnResult)
+
+	write("algod.admin.token", 0o644)
+	expectResult(t, TokenCheck{}, Env{DataDir: dir}, FailResult)
+
+	write("algod.admin.token", 0o600)
+	write("algod.token", 0o666)
+	expectResult(t, TokenCheck{}, Env{DataDir: dir}, FailResult)
+
+	expectResult(t, TokenCheck{}, Env{}, SkipResult)
+}
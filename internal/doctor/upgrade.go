@@ -0,0 +1,55 @@
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/internal/algod/upgrade"
+)
+
+// UpgradeCheck fails when the network is going to switch to a protocol the installed algod does not support,
+// the node stalls at the switch until algod is upgraded.
+type UpgradeCheck struct{}
+
+// Name identifies the check.
+func (UpgradeCheck) Name() string { return "upgrade" }
+
+// Run projects the pending protocol upgrade of the network.
+func (UpgradeCheck) Run(_ context.Context, env Env) Report {
+	if env.State == nil {
+		return skipUnreachable
+	}
+	version := env.State.Status.Version
+	report := upgrade.Project(env.Time.Now(), env.State.Status, env.State.Metrics.RoundTime)
+	switchAt := fmt.Sprintf("round %d%s", report.SwitchRound, around(report.Switch))
+	fix := fmt.Sprintf("Upgrade the node with *nodekit upgrade* before %s", switchAt)
+
+	switch {
+	case !report.IsPending():
+		return pass("no protocol upgrade is pending")
+	case report.Phase == upgrade.ScheduledPhase && report.Blocking:
+		return fail(fmt.Sprintf("algod %s does not support protocol %s scheduled at %s", version, report.NextProtocol, switchAt), fix)
+	case report.Phase == upgrade.ScheduledPhase:
+		return pass(fmt.Sprintf("protocol %s is scheduled at %s", report.NextProtocol, switchAt))
+	case report.Blocking:
+		return fail(fmt.Sprintf("algod %s does not support the proposed protocol %s, the vote is projected to pass with %d of %d required votes and switch at %s", version, report.NextProtocol, report.ProjectedYes, report.Required, switchAt), fix)
+	case report.IsUnsupported():
+		return warn(fmt.Sprintf("algod %s does not support the proposed protocol %s, the vote is projected to fail with %d of %d required votes", version, report.NextProtocol, report.ProjectedYes, report.Required), "Upgrade the node with *nodekit upgrade* in case the vote passes")
+	case report.Supported == nil && report.Passes:
+		return warn(fmt.Sprintf("algod %s does not report the proposed protocol, the vote is projected to pass and switch at %s", version, switchAt), "Check the release notes of algod for the proposed protocol")
+	case report.Supported == nil:
+		return pass("algod does not report the proposed protocol, the vote is projected to fail")
+	case report.Passes:
+		return pass(fmt.Sprintf("the proposed protocol %s is supported, the vote is projected to pass and switch at %s", report.NextProtocol, switchAt))
+	}
+	return pass(fmt.Sprintf("the proposed protocol %s is supported, the vote is projected to fail", report.NextProtocol))
+}
+
+// around formats an estimated time as a suffix, it is empty when the time is unknown.
+func around(at *time.Time) string {
+	if at == nil {
+		return ""
+	}
+	return fmt.Sprintf(" (around %s)", at.Local().Format("2006-01-02 15:04"))
+}
@@ -0,0 +1,51 @@
+package doctor
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_UpgradeCheck(t *testing.T) {
+	state := runningState()
+	state.Metrics.RoundTime = 3 * time.Second
+	state.Status.LastProtocolVersion = "current"
+	state.Status.NextVersion = "current"
+	state.Status.NextVersionRound = 1_000_001
+	expectResult(t, UpgradeCheck{}, Env{State: state, Time: fixedClock{}}, PassResult)
+	expectResult(t, UpgradeCheck{}, Env{Time: fixedClock{}}, SkipResult)
+
+	// A vote projected to pass for a protocol the node does not support
+	state.Status.UpgradeVoteBefore = 1_005_000
+	state.Status.UpgradeVoteRounds = 10_000
+	state.Status.UpgradeVotesRequired = 9_000
+	state.Status.UpgradeDelay = 140_000
+	state.Status.UpgradeYesVotes = 5_000
+	state.Status.NextVersion = "future"
+	state.Status.NextVersionRound = 1_145_000
+	state.Status.UpgradeNodeVote = true
+	report := expectResult(t, UpgradeCheck{}, Env{State: state, Time: fixedClock{}}, FailResult)
+	if !strings.Contains(report.Fix, "round 1145000") {
+		t.Errorf("expected the switch round in the fix, got %s", report.Fix)
+	}
+	state.Status.NextVersionSupported = true
+	expectResult(t, UpgradeCheck{}, Env{State: state, Time: fixedClock{}}, PassResult)
+
+	// An algod which does not report the proposed protocol
+	state.Status.NextVersion = "current"
+	expectResult(t, UpgradeCheck{}, Env{State: state, Time: fixedClock{}}, WarnResult)
+
+	// A vote projected to fail
+	state.Status.NextVersion = "future"
+	state.Status.NextVersionSupported = false
+	state.Status.UpgradeYesVotes = 0
+	state.Status.UpgradeNoVotes = 5_000
+	expectResult(t, UpgradeCheck{}, Env{State: state, Time: fixedClock{}}, WarnResult)
+
+	// A scheduled upgrade
+	state.Status.UpgradeVoteBefore = 0
+	state.Status.NextVersionRound = 1_100_000
+	expectResult(t, UpgradeCheck{}, Env{State: state, Time: fixedClock{}}, FailResult)
+	state.Status.NextVersionSupported = true
+	expectResult(t, UpgradeCheck{}, Env{State: state, Time: fixedClock{}}, PassResult)
+}
@@ -0,0 +1,39 @@
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/algorandfoundation/nodekit/api"
+)
+
+// VersionCheck warns when algod is behind the latest GitHub release of its channel.
+type VersionCheck struct{}
+
+// Name identifies the check.
+func (VersionCheck) Name() string { return "version" }
+
+// Run compares the running version with the latest release.
+func (VersionCheck) Run(_ context.Context, env Env) Report {
+	if env.State == nil {
+		return skipUnreachable
+	}
+	version := env.State.Status.Version
+	// Versions are formatted as v3.26.0-stable
+	_, channel, _ := strings.Cut(version, "-")
+	if channel != "stable" && channel != "beta" {
+		return skip(fmt.Sprintf("no releases are published for the %s channel", channel))
+	}
+	response, err := api.GetGoAlgorandReleaseWithResponse(env.HttpPkg, channel)
+	if err != nil {
+		return warn(fmt.Sprintf("unable to fetch the latest release: %s", err), "Check the internet connection of the machine")
+	}
+	if response == nil || response.StatusCode() != 200 {
+		return warn("unable to fetch the latest release", "Check the internet connection of the machine")
+	}
+	if response.JSON200 != version {
+		return warn(fmt.Sprintf("algod %s is behind the latest release %s", version, response.JSON200), "Upgrade the node with *nodekit upgrade*")
+	}
+	return pass(fmt.Sprintf("algod %s is the latest release", version))
+}
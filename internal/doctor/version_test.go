@@ -0,0 +1,21 @@
+package doctor
+
+import (
+	"testing"
+)
+
+const releasesUrl = "https://api.github.com/repos/algorand/go-algorand/releases"
+
+func Test_VersionCheck(t *testing.T) {
+	latest := fakeHttp{bodies: map[string]string{releasesUrl: `[{"tag_name": "v3.27.0-beta"}, {"tag_name": "v3.26.0-stable"}]`}}
+	newer := fakeHttp{bodies: map[string]string{releasesUrl: `[{"tag_name": "v3.27.0-stable"}]`}}
+
+	state := runningState()
+	expectResult(t, VersionCheck{}, Env{State: state, HttpPkg: latest}, PassResult)
+	expectResult(t, VersionCheck{}, Env{State: state, HttpPkg: newer}, WarnResult)
+	expectResult(t, VersionCheck{}, Env{State: state, HttpPkg: fakeHttp{}}, WarnResult)
+	expectResult(t, VersionCheck{}, Env{HttpPkg: latest}, SkipResult)
+
+	state.Status.Version = "v3.26.0-dev"
+	expectResult(t, VersionCheck{}, Env{State: state, HttpPkg: latest}, SkipResult)
+}
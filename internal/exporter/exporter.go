@@ -0,0 +1,187 @@
+package exporter
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/algorandfoundation/nodekit/internal/algod"
+)
+
+// Namespace is the prefix of every metric exported by NodeKit.
+const Namespace = "nodekit"
+
+// ContentType is the Prometheus text exposition format content type.
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// Exporter serves the latest algod.Snapshot as Prometheus gauges.
+// It implements http.Handler and is safe for concurrent use.
+type Exporter struct {
+	mu       sync.RWMutex
+	snapshot *algod.Snapshot
+}
+
+// New creates an Exporter without a snapshot, it serves an empty body until Update is called.
+func New() *Exporter {
+	return &Exporter{}
+}
+
+// Update replaces the snapshot that is served on the next scrape.
+func (e *Exporter) Update(snapshot algod.Snapshot) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.snapshot = &snapshot
+}
+
+// ServeHTTP writes the current snapshot in the Prometheus text format.
+func (e *Exporter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	w.Header().Set("Content-Type", ContentType)
+	if e.snapshot == nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	_ = Write(w, *e.snapshot)
+}
+
+// metric is a single gauge family with its samples.
+type metric struct {
+	name    string
+	help    string
+	samples []sample
+}
+
+// sample is a labeled value of a metric.
+type sample struct {
+	labels [][2]string
+	value  float64
+}
+
+// add appends a sample with the label name and value pairs.
+func (m *metric) add(value float64, labels ...string) {
+	var pairs [][2]string
+	for i := 0; i+1 < len(labels); i += 2 {
+		pairs = append(pairs, [2]string{labels[i], labels[i+1]})
+	}
+	m.samples = append(m.samples, sample{labels: pairs, value: value})
+}
+
+// gauge creates a metric with a single unlabeled sample.
+func gauge(name string, help string, value float64) metric {
+	m := metric{name: name, help: help}
+	m.add(value)
+	return m
+}
+
+// boolToFloat converts a boolean to a gauge value.
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// escape escapes a label value for the text exposition format.
+func escape(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value)
+}
+
+// collect converts the snapshot into the list of exported gauge families.
+func collect(snapshot algod.Snapshot) []metric {
+	info := metric{name: "info", help: "Information about NodeKit and the algod node."}
+	info.add(1,
+		"version", snapshot.Version,
+		"algod_version", snapshot.Status.Version,
+		"network", snapshot.Status.Network,
+		"protocol", snapshot.Status.LastProtocolVersion,
+	)
+
+	state := metric{name: "state", help: "Operational state of the algod node."}
+	for _, s := range []algod.State{algod.StableState, algod.SyncingState, algod.FastCatchupState, algod.DownState} {
+		state.add(boolToFloat(snapshot.Status.State == s), "state", string(s))
+	}
+
+	peers := metric{name: "peers", help: "Number of connected peers by transport."}
+	peers.add(float64(snapshot.Metrics.PeersWS), "transport", "ws")
+	peers.add(float64(snapshot.Metrics.PeersP2P), "transport", "p2p")
+
+	rx := metric{name: "network_rx_bytes_per_second", help: "Bytes received per second by transport."}
+	rx.add(float64(snapshot.Metrics.RX), "transport", "ws")
+	rx.add(float64(snapshot.Metrics.RXP2P), "transport", "p2p")
+
+	tx := metric{name: "network_tx_bytes_per_second", help: "Bytes sent per second by transport."}
+	tx.add(float64(snapshot.Metrics.TX), "transport", "ws")
+	tx.add(float64(snapshot.Metrics.TXP2P), "transport", "p2p")
+
+	online := metric{name: "account_online", help: "Whether the account is online."}
+	balance := metric{name: "account_balance_algos", help: "Balance of the account in ALGO."}
+	eligible := metric{name: "account_incentive_eligible", help: "Whether the account is eligible for incentives."}
+	nonResident := metric{name: "account_non_resident_key", help: "Whether the account is online with a key that is not on this node."}
+	keys := metric{name: "account_participation_keys", help: "Number of participation keys on this node for the account."}
+	expiry := metric{name: "account_key_expiry_rounds", help: "Rounds until the registered participation key of the account expires."}
+	expiryTime := metric{name: "account_key_expiry_timestamp_seconds", help: "Estimated unix time the registered participation key of the account expires."}
+	for _, acct := range snapshot.Accounts {
+		online.add(boolToFloat(acct.Status == "Online"), "address", acct.Address)
+		balance.add(float64(acct.Balance), "address", acct.Address)
+		eligible.add(boolToFloat(acct.IncentiveEligible), "address", acct.Address)
+		nonResident.add(boolToFloat(acct.NonResidentKey), "address", acct.Address)
+		keys.add(float64(acct.Keys), "address", acct.Address)
+		if acct.VoteLastValid > 0 {
+			expiry.add(float64(acct.VoteLastValid)-float64(snapshot.Status.LastRound), "address", acct.Address)
+		}
+		if acct.Expires != nil {
+			expiryTime.add(float64(acct.Expires.Unix()), "address", acct.Address)
+		}
+	}
+
+	return []metric{
+		info,
+		state,
+		gauge("last_round", "Latest round reported by the node.", float64(snapshot.Status.LastRound)),
+		gauge("needs_update", "Whether a newer algod release is available.", boolToFloat(snapshot.Status.NeedsUpdate)),
+		gauge("metrics_enabled", "Whether the algod metrics endpoint is enabled.", boolToFloat(snapshot.Metrics.Enabled)),
+		gauge("round_time_seconds", "Average round time over the metrics window.", snapshot.Metrics.RoundTime),
+		gauge("transactions_per_second", "Average transactions per second over the metrics window.", snapshot.Metrics.TPS),
+		peers,
+		rx,
+		tx,
+		gauge("participation_keys", "Number of participation keys on the node.", float64(snapshot.ParticipationKeys)),
+		online,
+		balance,
+		eligible,
+		nonResident,
+		keys,
+		expiry,
+		expiryTime,
+	}
+}
+
+// Write encodes the snapshot in the Prometheus text exposition format.
+func Write(w io.Writer, snapshot algod.Snapshot) error {
+	var sb strings.Builder
+	for _, m := range collect(snapshot) {
+		if len(m.samples) == 0 {
+			continue
+		}
+		name := fmt.Sprintf("%s_%s", Namespace, m.name)
+		fmt.Fprintf(&sb, "# HELP %s %s\n", name, m.help)
+		fmt.Fprintf(&sb, "# TYPE %s gauge\n", name)
+		for _, s := range m.samples {
+			sb.WriteString(name)
+			if len(s.labels) > 0 {
+				labels := make([]string, len(s.labels))
+				for i, l := range s.labels {
+					labels[i] = fmt.Sprintf(`%s="%s"`, l[0], escape(l[1]))
+				}
+				sb.WriteString("{" + strings.Join(labels, ",") + "}")
+			}
+			sb.WriteString(" " + strconv.FormatFloat(s.value, 'f', -1, 64) + "\n")
+		}
+	}
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
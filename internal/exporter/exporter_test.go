@@ -0,0 +1,92 @@
+package exporter
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/algorandfoundation/nodekit/internal/algod"
+)
+
+func getSnapshot() algod.Snapshot {
+	return algod.Snapshot{
+		Version: "v1.0.0",
+		Status: algod.Status{
+			State:     algod.StableState,
+			Network:   "testnet-v1.0",
+			LastRound: 1000,
+		},
+		Metrics: algod.MetricsSnapshot{
+			RoundTime: 2.8,
+			TPS:       12.5,
+			PeersWS:   4,
+			RX:        2048,
+		},
+		Accounts: []algod.AccountSnapshot{
+			{Address: "ABC", Status: "Online", Balance: 30000, IncentiveEligible: true, Keys: 1, VoteLastValid: 1500},
+			{Address: "DEF", Status: "Offline", Keys: 2},
+		},
+		ParticipationKeys: 3,
+	}
+}
+
+func Test_Write(t *testing.T) {
+	var buf bytes.Buffer
+	err := Write(&buf, getSnapshot())
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := buf.String()
+	for _, line := range []string{
+		"# TYPE nodekit_last_round gauge",
+		"nodekit_last_round 1000",
+		`nodekit_state{state="RUNNING"} 1`,
+		`nodekit_state{state="SYNCING"} 0`,
+		"nodekit_round_time_seconds 2.8",
+		"nodekit_transactions_per_second 12.5",
+		`nodekit_peers{transport="ws"} 4`,
+		`nodekit_network_rx_bytes_per_second{transport="ws"} 2048`,
+		"nodekit_participation_keys 3",
+		`nodekit_account_online{address="ABC"} 1`,
+		`nodekit_account_online{address="DEF"} 0`,
+		`nodekit_account_balance_algos{address="ABC"} 30000`,
+		`nodekit_account_incentive_eligible{address="ABC"} 1`,
+		`nodekit_account_key_expiry_rounds{address="ABC"} 500`,
+		`nodekit_account_participation_keys{address="DEF"} 2`,
+	} {
+		if !strings.Contains(body, line+"\n") {
+			t.Errorf("expected %q in output", line)
+		}
+	}
+	if strings.Contains(body, `nodekit_account_key_expiry_rounds{address="DEF"}`) {
+		t.Error("offline account should not report an expiry")
+	}
+	if strings.Contains(body, "nodekit_account_key_expiry_timestamp_seconds") {
+		t.Error("metrics without samples should be omitted")
+	}
+}
+
+func Test_ServeHTTP(t *testing.T) {
+	e := New()
+
+	res := httptest.NewRecorder()
+	e.ServeHTTP(res, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if res.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected %d before the first update, got %d", http.StatusServiceUnavailable, res.Code)
+	}
+
+	e.Update(getSnapshot())
+	res = httptest.NewRecorder()
+	e.ServeHTTP(res, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if res.Code != http.StatusOK {
+		t.Errorf("expected %d, got %d", http.StatusOK, res.Code)
+	}
+	if res.Header().Get("Content-Type") != ContentType {
+		t.Errorf("unexpected content type %s", res.Header().Get("Content-Type"))
+	}
+	if !strings.Contains(res.Body.String(), `nodekit_info{version="v1.0.0",algod_version="",network="testnet-v1.0",protocol=""} 1`) {
+		t.Error("expected the info metric")
+	}
+}
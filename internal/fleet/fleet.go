@@ -0,0 +1,107 @@
+package fleet
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+)
+
+// FleetJSONFile is the default fleet configuration file in the home directory.
+const FleetJSONFile = ".nodekit.fleet.json"
+
+// Node is a single algod node in the fleet.
+// A node is either local, identified by its DataDir, or remote, identified by its Endpoint and admin Token
+// or by a connection Profile from the NodeKit settings.
+type Node struct {
+	// Name is the unique label shown for the node.
+	Name string `json:"name"`
+
+	// DataDir is the algod data directory of a local node.
+	DataDir string `json:"dataDir,omitempty"`
+
+	// Endpoint is the algod API address of a remote node, e.g. http://10.0.0.2:8080.
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// Token is the algod admin API token of a remote node.
+	Token string `json:"token,omitempty"`
+
+	// Profile is the name of a connection profile in the NodeKit settings.
+	Profile string `json:"profile,omitempty"`
+}
+
+// Client returns the API client for the node.
+func (n Node) Client() (*api.ClientWithResponses, error) {
+	if n.Profile != "" {
+		return algod.GetProfileClient(n.Profile)
+	}
+	if n.Endpoint != "" {
+		return algod.NewClient(n.Endpoint, n.Token)
+	}
+	return algod.GetClient(n.DataDir)
+}
+
+// Config lists the nodes of the fleet.
+type Config struct {
+	Nodes []Node `json:"nodes"`
+}
+
+// Validate checks that every node has a unique name and exactly one way to connect.
+func (c Config) Validate() error {
+	if len(c.Nodes) == 0 {
+		return errors.New("fleet has no nodes")
+	}
+	names := make(map[string]bool)
+	for i, node := range c.Nodes {
+		if node.Name == "" {
+			return fmt.Errorf("node %d has no name", i+1)
+		}
+		if names[node.Name] {
+			return fmt.Errorf("node %s is listed more than once", node.Name)
+		}
+		names[node.Name] = true
+
+		sources := 0
+		for _, source := range []string{node.DataDir, node.Endpoint, node.Profile} {
+			if source != "" {
+				sources++
+			}
+		}
+		switch {
+		case sources > 1:
+			return fmt.Errorf("node %s must set only one of a data directory, an endpoint or a profile", node.Name)
+		case sources == 0:
+			return fmt.Errorf("node %s needs a data directory, an endpoint or a profile", node.Name)
+		case node.Endpoint != "" && node.Token == "":
+			return fmt.Errorf("node %s needs an admin token for its endpoint", node.Name)
+		}
+	}
+	return nil
+}
+
+// DefaultPath returns the location of the fleet file in the home directory.
+func DefaultPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, FleetJSONFile), nil
+}
+
+// Load reads and validates a fleet file.
+func Load(path string) (Config, error) {
+	var config Config
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return config, err
+	}
+	err = json.Unmarshal(file, &config)
+	if err != nil {
+		return config, fmt.Errorf("invalid fleet file %s: %w", path, err)
+	}
+	return config, config.Validate()
+}
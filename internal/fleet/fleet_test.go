@@ -0,0 +1,99 @@
+package fleet
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/internal/algod"
+)
+
+func Test_Load(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, FleetJSONFile)
+	err := os.WriteFile(path, []byte(`{"nodes":[
+		{"name":"local","dataDir":"/var/lib/algorand"},
+		{"name":"relay","endpoint":"http://10.0.0.2:8080","token":"admin"}
+	]}`), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(config.Nodes) != 2 || config.Nodes[1].Endpoint != "http://10.0.0.2:8080" {
+		t.Errorf("unexpected nodes %+v", config.Nodes)
+	}
+
+	_, err = Load(filepath.Join(dir, "missing.json"))
+	if !os.IsNotExist(err) {
+		t.Errorf("expected a not exist error, got %v", err)
+	}
+}
+
+func Test_Validate(t *testing.T) {
+	invalid := []Config{
+		{},
+		{Nodes: []Node{{DataDir: "/data"}}},
+		{Nodes: []Node{{Name: "a", DataDir: "/data"}, {Name: "a", DataDir: "/other"}}},
+		{Nodes: []Node{{Name: "a"}}},
+		{Nodes: []Node{{Name: "a", DataDir: "/data", Endpoint: "http://localhost:8080", Token: "t"}}},
+		{Nodes: []Node{{Name: "a", Endpoint: "http://localhost:8080"}}},
+		{Nodes: []Node{{Name: "a", DataDir: "/data", Profile: "remote"}}},
+	}
+	for i, config := range invalid {
+		if config.Validate() == nil {
+			t.Errorf("expected config %d to be invalid", i)
+		}
+	}
+}
+
+func Test_Summarize(t *testing.T) {
+	soon := time.Now().Add(time.Hour)
+	later := time.Now().Add(24 * time.Hour)
+	nodes := []Node{{Name: "a"}, {Name: "b"}, {Name: "c"}, {Name: "d"}, {Name: "e"}}
+	snapshots := map[string]algod.Snapshot{
+		"a": {
+			Status:            algod.Status{State: algod.StableState, Network: "mainnet-v1.0", LastRound: 100},
+			Metrics:           algod.MetricsSnapshot{PeersWS: 2, PeersP2P: 3},
+			ParticipationKeys: 2,
+			Accounts: []algod.AccountSnapshot{
+				{Address: "X", Expires: &later},
+				{Address: "Y", Expires: &soon},
+				{Address: "Z"},
+			},
+		},
+		"b": {Status: algod.Status{State: algod.SyncingState, Network: "mainnet-v1.0", LastRound: 90}},
+		"c": {Status: algod.Status{State: algod.StableState, Network: "testnet-v1.0", LastRound: 50}},
+		// A down node does not raise the lag of the others
+		"d": {Status: algod.Status{State: algod.DownState, Network: "mainnet-v1.0", LastRound: 500}},
+	}
+	errs := map[string]error{"e": errors.New("connection refused")}
+
+	summaries := Summarize(nodes, snapshots, errs)
+	if len(summaries) != len(nodes) {
+		t.Fatalf("expected %d summaries, got %d", len(nodes), len(summaries))
+	}
+	a, b, c, d, e := summaries[0], summaries[1], summaries[2], summaries[3], summaries[4]
+	if a.Lag != 0 || b.Lag != 10 || c.Lag != 0 {
+		t.Errorf("unexpected lag a=%d b=%d c=%d", a.Lag, b.Lag, c.Lag)
+	}
+	if a.Peers != 5 || a.Keys != 2 {
+		t.Errorf("unexpected peers %d or keys %d", a.Peers, a.Keys)
+	}
+	if a.Expires == nil || !a.Expires.Equal(soon) {
+		t.Errorf("expected the soonest expiry, got %v", a.Expires)
+	}
+	if b.Expires != nil {
+		t.Errorf("expected no expiry, got %v", b.Expires)
+	}
+	if d.State != algod.DownState || d.LastRound != 500 {
+		t.Errorf("unexpected down node %+v", d)
+	}
+	if e.State != UnreachableState || e.Err == nil {
+		t.Errorf("expected an unreachable node, got %+v", e)
+	}
+}
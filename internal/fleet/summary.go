@@ -0,0 +1,81 @@
+package fleet
+
+import (
+	"time"
+
+	"github.com/algorandfoundation/nodekit/internal/algod"
+)
+
+// ConnectingState is reported for a node that has not responded yet.
+const ConnectingState algod.State = "CONNECTING"
+
+// UnreachableState is reported for a node that could never be reached.
+const UnreachableState algod.State = "UNREACHABLE"
+
+// Summary is the overview of a single node shown on the fleet page.
+type Summary struct {
+	Name      string
+	State     algod.State
+	Network   string
+	LastRound uint64
+
+	// Lag is the number of rounds behind the most advanced node on the same network.
+	Lag uint64
+
+	// Peers is the number of connected WebSocket and P2P peers.
+	Peers uint64
+
+	// Keys is the number of participation keys installed on the node.
+	Keys int
+
+	// Expires is the soonest expiry of a registered participation key, nil when no key is registered.
+	Expires *time.Time
+
+	// Err is the last connection error of the node.
+	Err error
+}
+
+// Summarize builds a Summary for every node in order, using the latest snapshot and error of each node.
+// A node without a snapshot has not responded yet.
+func Summarize(nodes []Node, snapshots map[string]algod.Snapshot, errs map[string]error) []Summary {
+	// The highest round per network, only reachable nodes count
+	rounds := make(map[string]uint64)
+	for _, snapshot := range snapshots {
+		if snapshot.Status.State == algod.DownState {
+			continue
+		}
+		if snapshot.Status.LastRound > rounds[snapshot.Status.Network] {
+			rounds[snapshot.Status.Network] = snapshot.Status.LastRound
+		}
+	}
+
+	summaries := make([]Summary, 0, len(nodes))
+	for _, node := range nodes {
+		summary := Summary{Name: node.Name, State: ConnectingState, Err: errs[node.Name]}
+		snapshot, ok := snapshots[node.Name]
+		if !ok {
+			if summary.Err != nil {
+				summary.State = UnreachableState
+			}
+			summaries = append(summaries, summary)
+			continue
+		}
+
+		status := snapshot.Status
+		summary.State = status.State
+		summary.Network = status.Network
+		summary.LastRound = status.LastRound
+		if top := rounds[status.Network]; top > status.LastRound {
+			summary.Lag = top - status.LastRound
+		}
+		summary.Peers = snapshot.Metrics.PeersWS + snapshot.Metrics.PeersP2P
+		summary.Keys = snapshot.ParticipationKeys
+		for _, acct := range snapshot.Accounts {
+			if acct.Expires != nil && (summary.Expires == nil || acct.Expires.Before(*summary.Expires)) {
+				summary.Expires = acct.Expires
+			}
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries
+}
@@ -0,0 +1,83 @@
+package fleet
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/system"
+)
+
+// RetryInterval is the delay before connecting to an unreachable node again.
+var RetryInterval = 10 * time.Second
+
+// Update is published for every change of a node in the fleet.
+type Update struct {
+	// Name is the node the Update belongs to.
+	Name string
+
+	// State is the node's StateModel, it is nil until the first connection succeeds.
+	// The node's Watcher keeps writing to it, read the Snapshot instead.
+	State *algod.StateModel
+
+	// Snapshot is a copy of the State taken when the Update was sent, it is set along with the State.
+	Snapshot algod.Snapshot
+
+	// Err is set when the node could not be reached.
+	Err error
+}
+
+// Options are the shared dependencies used to watch every node.
+type Options struct {
+	HttpPkg            api.HttpPkgInterface
+	Time               system.Time
+	IncentivesDisabled bool
+	Version            string
+}
+
+// Watch connects to every node and runs a Watcher for each of them, calling send for every change.
+// Nodes that cannot be reached are retried until the context is cancelled. Watch returns once all nodes stopped.
+func Watch(ctx context.Context, nodes []Node, opts Options, send func(Update)) {
+	var wg sync.WaitGroup
+	for _, node := range nodes {
+		wg.Add(1)
+		go func(node Node) {
+			defer wg.Done()
+			watchNode(ctx, node, opts, send)
+		}(node)
+	}
+	wg.Wait()
+}
+
+// watchNode connects to a single node and forwards its Watcher events.
+func watchNode(ctx context.Context, node Node, opts Options, send func(Update)) {
+	client, err := node.Client()
+	if err != nil {
+		// The configuration is wrong, retrying does not help
+		send(Update{Name: node.Name, Err: err})
+		return
+	}
+
+	var state *algod.StateModel
+	for state == nil {
+		state, _, err = algod.NewStateModel(ctx, client, opts.HttpPkg, opts.IncentivesDisabled, opts.Version, node.DataDir)
+		if err != nil {
+			send(Update{Name: node.Name, Err: err})
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(RetryInterval):
+			}
+		}
+	}
+	send(Update{Name: node.Name, State: state, Snapshot: state.Snapshot()})
+
+	watcher := algod.NewWatcher(state, opts.Time)
+	events := watcher.Subscribe(ctx, 1)
+	go watcher.Run(ctx)
+	for event := range events {
+		send(Update{Name: node.Name, State: state, Snapshot: event.Snapshot, Err: event.Err})
+	}
+}
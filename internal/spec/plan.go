@@ -0,0 +1,503 @@
+package spec
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/algod/participation"
+	"github.com/algorandfoundation/nodekit/internal/algod/telemetry"
+	"github.com/algorandfoundation/nodekit/internal/algod/utils"
+	"github.com/algorandfoundation/nodekit/internal/system"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/log"
+)
+
+// ErrNetworkMismatch is returned when the data directory has the genesis of another network.
+var ErrNetworkMismatch = errors.New("the data directory is on another network")
+
+// ErrNotSynced is returned when a participation key cannot be generated because the node is not synced.
+var ErrNotSynced = errors.New("the node is not synced")
+
+// Env is everything the plan and Apply use.
+type Env struct {
+	// Service manages algod on the machine.
+	Service system.Interface
+
+	// HttpPkg is used for the requests to GitHub and the genesis files.
+	HttpPkg api.HttpPkgInterface
+
+	// Interval and Timeout bound the wait for the node to respond before generating keys.
+	Interval time.Duration
+	Timeout  time.Duration
+
+	// client is set once the node responds.
+	client api.ClientWithResponsesInterface
+}
+
+// Change is a difference between the spec and the machine, in the order it is applied.
+type Change struct {
+	// Resource is what changes, e.g. algod, config.json or the key of an account.
+	Resource string `json:"resource"`
+
+	// Action is what Apply does with the resource.
+	Action string `json:"action"`
+
+	Detail string `json:"detail,omitempty"`
+
+	// Before and After are the contents of a file which changes, for a diff.
+	Before []byte `json:"-"`
+	After  []byte `json:"-"`
+
+	apply func(ctx context.Context, env *Env) error
+}
+
+// IsFile is true when the change rewrites a file which can be diffed.
+func (c Change) IsFile() bool {
+	return c.After != nil
+}
+
+// Plan compares the spec with the machine and returns the changes Apply makes, without changing anything.
+// An empty plan means the machine matches the spec.
+func Plan(ctx context.Context, spec *Spec, env *Env) ([]Change, error) {
+	dataDir, err := algod.GetDataDir(spec.DataDir)
+	if err != nil {
+		return nil, err
+	}
+	dataDir, err = filepath.Abs(dataDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []Change
+	planners := []func() ([]Change, error){
+		func() ([]Change, error) { return planAlgod(spec, env) },
+		func() ([]Change, error) { return planDataDir(spec, env, dataDir) },
+		func() ([]Change, error) { return planService(spec, env, dataDir) },
+		func() ([]Change, error) { return planServiceSettings(spec, env) },
+		func() ([]Change, error) { return planConfig(spec, dataDir) },
+		func() ([]Change, error) { return planTelemetry(spec, dataDir) },
+	}
+	for _, planner := range planners {
+		planned, err := planner()
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, planned...)
+	}
+	changes = append(changes, planNode(env, dataDir, len(changes) > 0)...)
+
+	keys, err := planKeys(ctx, spec, env, dataDir)
+	if err != nil {
+		return nil, err
+	}
+	return append(changes, keys...), nil
+}
+
+// Apply makes the changes in order and stops at the first one which fails.
+// The keys which wait for the node to sync are pending, Apply returns ErrNotSynced with them once the other changes are made.
+func Apply(ctx context.Context, changes []Change, env *Env) error {
+	var pending []string
+	for _, change := range changes {
+		log.Info(style.Green.Render(fmt.Sprintf("%s: %s %s", change.Resource, change.Action, change.Detail)))
+		err := change.apply(ctx, env)
+		if errors.Is(err, ErrNotSynced) {
+			pending = append(pending, change.Resource)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("%s %s failed: %w", change.Action, change.Resource, err)
+		}
+	}
+	if len(pending) > 0 {
+		return fmt.Errorf("%w, apply the spec again once it is synced, pending: %s", ErrNotSynced, strings.Join(pending, ", "))
+	}
+	return nil
+}
+
+// planAlgod installs algod when it is missing and moves it to the release of the spec.
+func planAlgod(spec *Spec, env *Env) ([]Change, error) {
+	installed := env.Service.IsInstalled()
+	if installed && spec.Algod == nil {
+		return nil, nil
+	}
+	var wanted AlgodSpec
+	if spec.Algod != nil {
+		wanted = *spec.Algod
+	}
+	// Each channel is its own package, the installed channel is followed unless one is given
+	installedChannel := ""
+	if installed {
+		channel, err := algod.GetInstalledChannel()
+		if err == nil && slices.Contains(algod.Channels, channel) {
+			installedChannel = channel
+		}
+	}
+	if wanted.Channel == "" {
+		wanted.Channel = installedChannel
+	}
+	release, err := algod.NewRelease(wanted.Channel, wanted.Version, wanted.Downgrade)
+	if err != nil {
+		return nil, err
+	}
+	if installedChannel != "" {
+		err = algod.CheckChannel(installedChannel, release)
+		if err != nil {
+			return nil, err
+		}
+	}
+	tag, err := algod.ResolveRelease(env.HttpPkg, release)
+	if err != nil {
+		if release.Version != "" {
+			return nil, err
+		}
+		log.Warn(style.Yellow.Render("Unable to resolve the latest release: " + err.Error()))
+		tag = ""
+	}
+
+	if !installed {
+		detail := tag
+		if detail == "" {
+			detail = "latest " + release.GetChannel() + " release"
+		}
+		return []Change{{
+			Resource: "algod",
+			Action:   "install",
+			Detail:   detail,
+			apply:    func(_ context.Context, env *Env) error { return env.Service.Install(release) },
+		}}, nil
+	}
+	if tag == "" {
+		return nil, nil
+	}
+	version, err := algod.GetInstalledVersion()
+	if err != nil {
+		return nil, err
+	}
+	current, err := algod.CheckRelease(tag, version, release)
+	if err != nil || current {
+		return nil, err
+	}
+	return []Change{{
+		Resource: "algod",
+		Action:   "upgrade",
+		Detail:   fmt.Sprintf("%s to %s", version, tag),
+		apply:    func(_ context.Context, env *Env) error { return env.Service.Update(release) },
+	}}, nil
+}
+
+// planDataDir creates the data directory and writes the genesis of the network.
+// The network is checked again once applied, as installing algod can write the genesis of another network.
+func planDataDir(spec *Spec, env *Env, dataDir string) ([]Change, error) {
+	var changes []Change
+	if _, err := os.Stat(dataDir); os.IsNotExist(err) {
+		changes = append(changes, Change{
+			Resource: "data directory",
+			Action:   "create",
+			Detail:   dataDir,
+			apply:    func(context.Context, *Env) error { return os.MkdirAll(dataDir, 0755) },
+		})
+	}
+	if spec.Network == "" {
+		return changes, nil
+	}
+	err := checkNetwork(dataDir, spec.Network)
+	if err == nil {
+		return changes, nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, err
+	}
+	return append(changes, Change{
+		Resource: "genesis.json",
+		Action:   "write",
+		Detail:   spec.Network,
+		apply: func(_ context.Context, env *Env) error {
+			err := os.MkdirAll(dataDir, 0755)
+			if err != nil {
+				return err
+			}
+			err = algod.WriteGenesis(env.HttpPkg, dataDir, spec.Network)
+			if err != nil {
+				return err
+			}
+			return checkNetwork(dataDir, spec.Network)
+		},
+	}), nil
+}
+
+// checkNetwork returns ErrNetworkMismatch when the genesis of the data directory is not the network.
+func checkNetwork(dataDir string, network string) error {
+	current, err := utils.GetNetworkFromDataDir(dataDir)
+	if err != nil {
+		return err
+	}
+	if api.ShortNetworkName(current) != network {
+		return fmt.Errorf("%w: %s is on %s, not %s", ErrNetworkMismatch, dataDir, current, network)
+	}
+	return nil
+}
+
+// planService overrides the Algorand service to run with the data directory of the spec.
+// When the service does not report its data directory, it is compared with the default data directory.
+func planService(spec *Spec, env *Env, dataDir string) ([]Change, error) {
+	if spec.DataDir == "" {
+		return nil, nil
+	}
+	current, err := algod.GetServiceDataDir(env.Service)
+	if errors.Is(err, system.ErrUnsupported) {
+		current, err = algod.GetDataDir("")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if filepath.Clean(current) == dataDir {
+		return nil, nil
+	}
+	return []Change{{
+		Resource: "service",
+		Action:   "override",
+		Detail:   fmt.Sprintf("run with %s instead of %s", dataDir, current),
+		apply:    func(_ context.Context, env *Env) error { return env.Service.UpdateService(dataDir) },
+	}}, nil
+}
+
+// planServiceSettings writes the [Service] settings of the spec to a drop-in of the Algorand service.
+func planServiceSettings(spec *Spec, env *Env) ([]Change, error) {
+	if spec.Service == nil {
+		return nil, nil
+	}
+	settings := make(map[string]string, len(spec.Service))
+	for name, value := range spec.Service {
+		settings[name] = fmt.Sprint(value)
+	}
+	before, after, err := algod.PreviewServiceSettings(env.Service, settings)
+	if errors.Is(err, system.ErrUnsupported) {
+		return nil, fmt.Errorf("the service settings are only supported with systemd: %w", err)
+	}
+	if err != nil || bytes.Equal(before, after) {
+		return nil, err
+	}
+	names := make([]string, 0, len(settings))
+	for name := range settings {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	detail := strings.Join(names, ", ")
+	if detail == "" {
+		detail = "remove the settings"
+	}
+	return []Change{{
+		Resource: "algorand.service",
+		Action:   "update",
+		Detail:   detail,
+		Before:   before,
+		After:    after,
+		apply:    func(_ context.Context, env *Env) error { return algod.UpdateServiceSettings(env.Service, settings) },
+	}}, nil
+}
+
+// planConfig sets the values of config.json, validated against the algod version installed when it is applied.
+func planConfig(spec *Spec, dataDir string) ([]Change, error) {
+	if len(spec.Config) == 0 {
+		return nil, nil
+	}
+	set := make(map[string]string, len(spec.Config))
+	for name, value := range spec.Config {
+		set[name] = fmt.Sprint(value)
+	}
+	version, err := algod.GetInstalledVersion()
+	if err != nil {
+		log.Debugf("Unable to get the algod version: %s", err)
+	}
+	before, after, err := algod.PreviewConfig(dataDir, version, set, nil)
+	if err != nil || bytes.Equal(before, after) {
+		return nil, err
+	}
+	names := make([]string, 0, len(set))
+	for name := range set {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return []Change{{
+		Resource: "config.json",
+		Action:   "update",
+		Detail:   strings.Join(names, ", "),
+		Before:   before,
+		After:    after,
+		apply: func(context.Context, *Env) error {
+			version, err := algod.GetInstalledVersion()
+			if err != nil {
+				log.Debugf("Unable to get the algod version: %s", err)
+			}
+			_, err = algod.UpdateConfig(dataDir, version, set, nil)
+			return err
+		},
+	}}, nil
+}
+
+// planTelemetry merges the telemetry of the spec into logging.config.
+func planTelemetry(spec *Spec, dataDir string) ([]Change, error) {
+	if spec.Telemetry == nil {
+		return nil, nil
+	}
+	current, err := utils.GetLogConfigFromDataDir(dataDir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	wanted := *current
+	wanted.Enable = spec.Telemetry.Enable
+	if spec.Telemetry.Name != "" {
+		wanted.Name = spec.Telemetry.Name
+	}
+	if spec.Telemetry.URI != "" {
+		wanted.URI = spec.Telemetry.URI
+	}
+	merged := telemetry.MergeLogConfigs(*current, wanted)
+	if current.IsEqual(merged) {
+		return nil, nil
+	}
+
+	before, err := os.ReadFile(filepath.Join(dataDir, "logging.config"))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	after, err := json.MarshalIndent(merged, "", " ")
+	if err != nil {
+		return nil, err
+	}
+	detail := "disable telemetry"
+	if merged.Enable {
+		detail = fmt.Sprintf("enable telemetry to %s as %s", merged.URI, merged.Name)
+	}
+	return []Change{{
+		Resource: "logging.config",
+		Action:   "update",
+		Detail:   detail,
+		Before:   before,
+		After:    after,
+		apply:    func(context.Context, *Env) error { return utils.WriteLogConfigToDataDir(dataDir, &merged) },
+	}}, nil
+}
+
+// planNode restarts a running node so it picks up the changes, or starts a stopped one.
+func planNode(env *Env, dataDir string, changed bool) []Change {
+	if !algod.IsRunning(env.Service, dataDir) {
+		return []Change{{
+			Resource: "node",
+			Action:   "start",
+			apply:    func(_ context.Context, env *Env) error { return env.Service.Start() },
+		}}
+	}
+	if !changed {
+		return nil
+	}
+	return []Change{{
+		Resource: "node",
+		Action:   "restart",
+		Detail:   "to apply the changes",
+		apply:    func(_ context.Context, env *Env) error { return env.Service.Restart() },
+	}}
+}
+
+// planKeys generates the keys the accounts are missing.
+// A node which does not respond yet is checked again when the change is applied.
+func planKeys(ctx context.Context, spec *Spec, env *Env, dataDir string) ([]Change, error) {
+	if len(spec.Accounts) == 0 {
+		return nil, nil
+	}
+	var keys participation.List
+	var lastRound int
+	client, err := algod.GetClient(dataDir)
+	if err == nil {
+		keys, _, err = participation.GetList(ctx, client)
+	}
+	if err == nil {
+		var status algod.Status
+		status, _, err = algod.NewStatus(ctx, client, env.HttpPkg)
+		lastRound = int(status.LastRound)
+	}
+	known := err == nil
+
+	var changes []Change
+	for _, account := range spec.Accounts {
+		detail := fmt.Sprintf("valid for %d rounds", account.Rounds)
+		if known {
+			if HasKey(keys, lastRound, account) {
+				continue
+			}
+		} else {
+			detail += ", once the node responds"
+		}
+		changes = append(changes, Change{
+			Resource: "key " + account.Address,
+			Action:   "generate",
+			Detail:   detail,
+			apply: func(ctx context.Context, env *Env) error {
+				return generateKey(ctx, env, dataDir, account)
+			},
+		})
+	}
+	return changes, nil
+}
+
+// HasKey is true when the account has a key valid for the round and at least as long as the spec.
+func HasKey(keys participation.List, round int, account AccountSpec) bool {
+	for _, key := range keys {
+		if key.Address == account.Address &&
+			key.Key.VoteFirstValid <= round && key.Key.VoteLastValid > round &&
+			key.Key.VoteLastValid-key.Key.VoteFirstValid >= account.Rounds {
+			return true
+		}
+	}
+	return false
+}
+
+// generateKey waits for the node and generates the key from the latest round, unless the account has one.
+// A syncing node does not know the latest round of the network, it returns ErrNotSynced and the key is left for the next apply.
+func generateKey(ctx context.Context, env *Env, dataDir string, account AccountSpec) error {
+	if env.client == nil {
+		client, err := algod.WaitForClient(ctx, dataDir, env.Interval, env.Timeout)
+		if err != nil {
+			return err
+		}
+		env.client = client
+	}
+	status, _, err := algod.NewStatus(ctx, env.client, env.HttpPkg)
+	if err != nil {
+		return err
+	}
+	if status.State != algod.StableState {
+		return ErrNotSynced
+	}
+	keys, _, err := participation.GetList(ctx, env.client)
+	if err != nil {
+		return err
+	}
+	if HasKey(keys, int(status.LastRound), account) {
+		return nil
+	}
+
+	params := api.GenerateParticipationKeysParams{
+		First: int(status.LastRound),
+		Last:  int(status.LastRound) + account.Rounds,
+	}
+	if account.Dilution > 0 {
+		params.Dilution = &account.Dilution
+	}
+	key, err := participation.GenerateKeys(ctx, env.client, account.Address, &params)
+	if err != nil {
+		return err
+	}
+	log.Info(style.Green.Render("Participation key generated: " + key.Id))
+	return nil
+}
@@ -0,0 +1,152 @@
+package spec
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/algod/config"
+	"gopkg.in/yaml.v3"
+)
+
+// Spec describes a node, Apply converges the machine to it.
+// Everything left out of the spec is kept as it is.
+type Spec struct {
+	// Network is the network of the node, e.g. mainnet. Its genesis file is written to an empty data directory.
+	Network string `json:"network,omitempty" yaml:"network"`
+
+	// DataDir is the algod data directory, empty for the default data directory.
+	// The Algorand service is overridden to run with it.
+	DataDir string `json:"dataDir,omitempty" yaml:"dataDir"`
+
+	// Algod is the release of algod, it is installed when missing and upgraded or downgraded to the release.
+	// Without it, algod is only installed when missing.
+	Algod *AlgodSpec `json:"algod,omitempty" yaml:"algod"`
+
+	// Service are extra [Service] settings of the systemd unit, e.g. LimitNOFILE or Nice.
+	// They replace the settings of a previous spec, only systemd is supported.
+	Service map[string]any `json:"service,omitempty" yaml:"service"`
+
+	// Config are the values of config.json, other keys of the file are kept.
+	Config map[string]any `json:"config,omitempty" yaml:"config"`
+
+	// Telemetry is the telemetry of logging.config.
+	Telemetry *TelemetrySpec `json:"telemetry,omitempty" yaml:"telemetry"`
+
+	// Accounts should have a participation key on the node.
+	Accounts []AccountSpec `json:"accounts,omitempty" yaml:"accounts"`
+}
+
+// AlgodSpec is a release of algod, see algod.NewRelease.
+type AlgodSpec struct {
+	// Channel is stable or beta, empty keeps the installed channel or installs stable.
+	Channel string `json:"channel,omitempty" yaml:"channel"`
+
+	// Version pins algod to a version, e.g. 3.26.0. Empty follows the latest release of the Channel.
+	Version string `json:"version,omitempty" yaml:"version"`
+
+	// Downgrade allows a Version older than the installed one.
+	Downgrade bool `json:"downgrade,omitempty" yaml:"downgrade"`
+}
+
+// TelemetrySpec are the telemetry settings of logging.config.
+type TelemetrySpec struct {
+	Enable bool `json:"enable" yaml:"enable"`
+
+	// Name is the node name reported to the telemetry, empty keeps the current name.
+	Name string `json:"name,omitempty" yaml:"name"`
+
+	// URI is the telemetry endpoint, empty keeps the current endpoint.
+	URI string `json:"uri,omitempty" yaml:"uri"`
+}
+
+// AccountSpec is a participation key an account should have.
+type AccountSpec struct {
+	Address string `json:"address" yaml:"address"`
+
+	// Rounds is the validity of the key. A key valid for the current round and at least as long satisfies it.
+	Rounds int `json:"rounds" yaml:"rounds"`
+
+	// Dilution is the key dilution, zero uses the node default.
+	Dilution int `json:"dilution,omitempty" yaml:"dilution"`
+}
+
+// Load reads and validates a spec file in YAML or JSON, unknown fields are rejected.
+func Load(path string) (*Spec, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var spec Spec
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+	err = decoder.Decode(&spec)
+	if err != nil {
+		return nil, fmt.Errorf("invalid spec %s: %w", path, err)
+	}
+	return &spec, spec.Validate()
+}
+
+// Validate checks the spec without the machine, the config values are validated again against the installed algod.
+func (s *Spec) Validate() error {
+	if s.Network != "" {
+		s.Network = api.ShortNetworkName(s.Network)
+	}
+	if s.Algod != nil {
+		_, err := algod.NewRelease(s.Algod.Channel, s.Algod.Version, s.Algod.Downgrade)
+		if err != nil {
+			return err
+		}
+	}
+	for name, value := range s.Service {
+		if name == "" || strings.ContainsAny(name, "=[]# \t\n") {
+			return fmt.Errorf("invalid service setting %q", name)
+		}
+		if name == "ExecStart" {
+			return fmt.Errorf("the service ExecStart is set from the dataDir")
+		}
+		switch value.(type) {
+		case bool, int, int64, uint64, float64, string:
+		default:
+			return fmt.Errorf("the service setting %s must be a single value", name)
+		}
+		if strings.Contains(fmt.Sprint(value), "\n") {
+			return fmt.Errorf("the service setting %s must be a single line", name)
+		}
+	}
+	for name, value := range s.Config {
+		option, ok := config.Lookup(name)
+		if !ok {
+			return fmt.Errorf("unknown option %s", name)
+		}
+		switch value.(type) {
+		case bool, int, int64, uint64, float64, string:
+		default:
+			return fmt.Errorf("%s must be a single value", name)
+		}
+		_, err := option.Parse(fmt.Sprint(value))
+		if err != nil {
+			return err
+		}
+	}
+	seen := make(map[string]bool, len(s.Accounts))
+	for _, account := range s.Accounts {
+		if !algod.ValidateAddress(account.Address) {
+			return fmt.Errorf("invalid address %s", account.Address)
+		}
+		if seen[account.Address] {
+			return fmt.Errorf("account %s is listed twice", account.Address)
+		}
+		seen[account.Address] = true
+		if account.Rounds <= 0 {
+			return fmt.Errorf("the key of %s needs a positive number of rounds", account.Address)
+		}
+		if account.Dilution < 0 {
+			return fmt.Errorf("the key dilution of %s cannot be negative", account.Address)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package spec
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/internal/algod/participation"
+	"github.com/algorandfoundation/nodekit/internal/system"
+	"github.com/algorandfoundation/nodekit/internal/test/mock"
+)
+
+const zeroAddress = "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAY5HFKQ"
+
+func writeSpec(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "node.yaml")
+	err := os.WriteFile(path, []byte(content), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func Test_Load(t *testing.T) {
+	spec, err := Load(writeSpec(t, `
+network: testnet-v1.0
+dataDir: /srv/algorand
+algod:
+  version: 3.26.0
+service:
+  LimitNOFILE: 65536
+  Nice: -5
+config:
+  Archival: true
+  GossipFanout: 8
+telemetry:
+  enable: true
+  name: relay
+accounts:
+  - address: `+zeroAddress+`
+    rounds: 3000000
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if spec.Network != "testnet" || spec.Algod.Version != "3.26.0" || spec.Config["GossipFanout"] != 8 || spec.Accounts[0].Rounds != 3_000_000 || spec.Service["LimitNOFILE"] != 65536 {
+		t.Errorf("unexpected spec %+v", spec)
+	}
+
+	invalid := []string{
+		"networks: mainnet",
+		"algod:\n  channel: nightly",
+		"service:\n  ExecStart: /bin/sh",
+		"service:\n  Limit NOFILE: 1",
+		"service:\n  Environment: [A=1]",
+		"config:\n  NotAnOption: 1",
+		"config:\n  GossipFanout: zero",
+		"config:\n  GossipFanout: [1, 2]",
+		"accounts:\n  - address: ABC\n    rounds: 10",
+		"accounts:\n  - address: " + zeroAddress + "\n    rounds: 0",
+		"accounts:\n  - address: " + zeroAddress + "\n    rounds: 10\n  - address: " + zeroAddress + "\n    rounds: 20",
+	}
+	for _, content := range invalid {
+		if _, err := Load(writeSpec(t, content)); err == nil {
+			t.Errorf("expected an error for %q", content)
+		}
+	}
+}
+
+func Test_Plan(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	service := &mock.Service{Installed: true, Service: true}
+
+	dataDir := t.TempDir()
+	t.Setenv("ALGORAND_DATA", dataDir)
+	err := os.WriteFile(filepath.Join(dataDir, "genesis.json"), []byte(`{"network": "testnet", "id": "v1.0"}`), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec := &Spec{
+		Network:   "testnet",
+		Config:    map[string]any{"GossipFanout": 8},
+		Telemetry: &TelemetrySpec{Enable: true, Name: "relay", URI: "https://telemetry.example.com"},
+	}
+	env := &Env{Service: service, HttpPkg: new(api.HttpPkg)}
+	ctx := context.Background()
+
+	changes, err := Plan(ctx, spec, env)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var planned []string
+	for _, change := range changes {
+		planned = append(planned, change.Resource+" "+change.Action)
+	}
+	if strings.Join(planned, ", ") != "config.json update, logging.config update, node start" {
+		t.Fatalf("unexpected plan %v", planned)
+	}
+	if !changes[0].IsFile() || !strings.Contains(string(changes[0].After), `"GossipFanout": 8`) {
+		t.Errorf("unexpected config.json diff %s", changes[0].After)
+	}
+
+	err = Apply(ctx, changes, env)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(service.Calls, ",") != "Start" {
+		t.Errorf("unexpected service calls %v", service.Calls)
+	}
+
+	// The machine matches the spec
+	changes, err = Plan(ctx, spec, env)
+	if err != nil || len(changes) != 0 {
+		t.Errorf("expected no changes, got %+v (%v)", changes, err)
+	}
+
+	// A new data directory is created and the service pointed at it
+	spec.DataDir = filepath.Join(t.TempDir(), "node")
+	changes, err = Plan(ctx, spec, env)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) < 3 || changes[0].Resource != "data directory" || changes[1].Resource != "genesis.json" || changes[2].Action != "override" {
+		t.Errorf("unexpected plan for a new data directory %+v", changes)
+	}
+
+	// The service settings need systemd
+	spec.DataDir, spec.Service = "", map[string]any{"LimitNOFILE": 65536}
+	_, err = Plan(ctx, spec, env)
+	if !errors.Is(err, system.ErrUnsupported) {
+		t.Errorf("expected unsupported service settings, got %v", err)
+	}
+	spec.Service = nil
+
+	// Another network is not overwritten
+	spec.DataDir, spec.Network = "", "mainnet"
+	_, err = Plan(ctx, spec, env)
+	if !errors.Is(err, ErrNetworkMismatch) {
+		t.Errorf("expected a network mismatch, got %v", err)
+	}
+}
+
+func Test_ApplyPending(t *testing.T) {
+	var applied []string
+	change := func(resource string, err error) Change {
+		return Change{Resource: resource, apply: func(context.Context, *Env) error {
+			applied = append(applied, resource)
+			return err
+		}}
+	}
+	changes := []Change{
+		change("config.json", nil),
+		change("key A", ErrNotSynced),
+		change("key B", ErrNotSynced),
+	}
+	err := Apply(context.Background(), changes, &Env{})
+	if !errors.Is(err, ErrNotSynced) || !strings.Contains(err.Error(), "key A, key B") {
+		t.Errorf("expected the keys to be pending, got %v", err)
+	}
+	if len(applied) != 3 {
+		t.Errorf("expected every change to be applied, got %v", applied)
+	}
+
+	// Other failures stop the apply
+	applied = nil
+	changes[0] = change("config.json", errors.New("permission denied"))
+	err = Apply(context.Background(), changes, &Env{})
+	if err == nil || errors.Is(err, ErrNotSynced) || len(applied) != 1 {
+		t.Errorf("expected the apply to stop, got %v after %v", err, applied)
+	}
+}
+
+func Test_HasKey(t *testing.T) {
+	account := AccountSpec{Address: zeroAddress, Rounds: 1_000}
+	keys := participation.List{
+		{Address: zeroAddress, Key: api.AccountParticipation{VoteFirstValid: 100, VoteLastValid: 600}},
+		{Address: "OTHER", Key: api.AccountParticipation{VoteFirstValid: 100, VoteLastValid: 5_000}},
+	}
+	if HasKey(keys, 200, account) {
+		t.Error("expected a short key not to satisfy the spec")
+	}
+	keys = append(keys, api.ParticipationKey{Address: zeroAddress, Key: api.AccountParticipation{VoteFirstValid: 100, VoteLastValid: 2_000}})
+	if !HasKey(keys, 200, account) {
+		t.Error("expected the long key to satisfy the spec")
+	}
+	if HasKey(keys, 2_000, account) {
+		t.Error("expected an expired key not to satisfy the spec")
+	}
+}
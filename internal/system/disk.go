@@ -0,0 +1,13 @@
+package system
+
+import "golang.org/x/sys/unix"
+
+// DiskFree returns the number of bytes available to unprivileged users on the filesystem of a path.
+func DiskFree(path string) (uint64, error) {
+	var stat unix.Statfs_t
+	err := unix.Statfs(path, &stat)
+	if err != nil {
+		return 0, err
+	}
+	return stat.Bavail * uint64(stat.Bsize), nil
+}
@@ -1,17 +1,92 @@
 package system
 
+import "errors"
+
+// ErrUnsupported is returned by a service backend for an operation it cannot perform.
+var ErrUnsupported = errors.New("unsupported operating system")
+
+// ErrNotInstalled is returned when the service binary must be installed first.
+var ErrNotInstalled = errors.New("algod is not installed, install it with nodekit install first")
+
 // Interface defines methods for managing and interacting with a system service.
 type Interface interface {
+	// IsInstalled is true when the service binary is available.
 	IsInstalled() bool
+	// IsRunning is true when the service runs for the resolved data directory.
 	IsRunning(dataDir string) bool
+	// IsService is true when the service is registered with the service manager.
 	IsService() bool
+	// IsServiceEnabled is true when the service starts with the system.
+	IsServiceEnabled() bool
+	// ServiceStatus returns the health of the service as reported by the service manager.
+	ServiceStatus() (ServiceStatus, error)
 	SetNetwork(network string) error
-	Install() error
-	Update() error
-	Uninstall() error
+	// Install installs the release, the zero Release is the latest stable release.
+	Install(release Release) error
+	// Update upgrades or downgrades to the release, the zero Release is the latest stable release.
+	Update(release Release) error
+	Uninstall(force bool) error
 	Start() error
 	Stop() error
+	// Restart stops and starts the service, it is started when it was not running.
 	Restart() error
 	UpdateService(dataDirectoryPath string) error
 	EnsureService() error
 }
+
+// Release selects the algod release to install.
+type Release struct {
+	// Channel is the release channel, stable or beta, empty for stable.
+	Channel string `json:"channel"`
+
+	// Version pins the package to a version, e.g. 3.26.0, empty for the latest release of the Channel.
+	Version string `json:"version,omitempty"`
+
+	// Downgrade allows the Version to be older than the installed version.
+	Downgrade bool `json:"downgrade,omitempty"`
+}
+
+// GetChannel returns the Channel, defaulting to stable.
+func (r Release) GetChannel() string {
+	if r.Channel == "" {
+		return "stable"
+	}
+	return r.Channel
+}
+
+// ServiceStatus is the health of a system service as reported by the service manager.
+type ServiceStatus struct {
+	// Unit is the name of the service, e.g. algorand.service.
+	Unit string `json:"unit"`
+
+	// LoadState is whether the service definition was loaded, e.g. loaded or not-found.
+	LoadState string `json:"loadState"`
+
+	// ActiveState is the high-level state, e.g. active, inactive, failed or activating.
+	ActiveState string `json:"activeState"`
+
+	// SubState is the low-level state of the service, e.g. running, exited or auto-restart.
+	SubState string `json:"subState"`
+
+	// UnitFileState is whether the service starts with the system, e.g. enabled or disabled.
+	UnitFileState string `json:"unitFileState"`
+
+	// ExitCode is the exit status of the last main process of the service.
+	ExitCode int `json:"exitCode"`
+
+	// Restarts is the number of automatic restarts since the service was started.
+	Restarts int `json:"restarts"`
+
+	// Journal holds the most recent log lines of the service.
+	Journal []string `json:"journal,omitempty"`
+}
+
+// IsActive is true while the service is running.
+func (s ServiceStatus) IsActive() bool {
+	return s.ActiveState == "active"
+}
+
+// IsFailed is true when the service stopped because of an error.
+func (s ServiceStatus) IsFailed() bool {
+	return s.ActiveState == "failed"
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"github.com/algorandfoundation/nodekit/api"
 	"github.com/algorandfoundation/nodekit/internal/test/mock"
+	"io"
 	"net/http"
 )
 
@@ -221,6 +222,81 @@ func (c *Client) GenerateParticipationKeysWithResponse(ctx context.Context, addr
 	return &res, nil
 }
 
+// AddParticipationKeyWithBodyWithResponse simulates installing a participation key file and returns the ID of the first mock key.
+func (c *Client) AddParticipationKeyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...api.RequestEditorFn) (*api.AddParticipationKeyResponse, error) {
+	var res api.AddParticipationKeyResponse
+	if !c.Invalid {
+		httpResponse := http.Response{StatusCode: 200}
+		res = api.AddParticipationKeyResponse{
+			HTTPResponse: &httpResponse,
+			JSON200: &struct {
+				PartId string `json:"partId"`
+			}{PartId: mock.Keys[0].Id},
+		}
+	} else {
+		httpResponse := http.Response{StatusCode: 400}
+		res = api.AddParticipationKeyResponse{
+			HTTPResponse: &httpResponse,
+			JSON400:      &api.ErrorResponse{Message: "invalid participation key file"},
+		}
+	}
+	if c.Errors {
+		return nil, errors.New("test error")
+	}
+	return &res, nil
+}
+
+// TransactionParamsWithResponse returns suggested transaction parameters for a test network.
+func (c *Client) TransactionParamsWithResponse(ctx context.Context, reqEditors ...api.RequestEditorFn) (*api.TransactionParamsResponse, error) {
+	httpResponse := http.Response{StatusCode: 200}
+	res := api.TransactionParamsResponse{
+		HTTPResponse: &httpResponse,
+		JSON200: &struct {
+			ConsensusVersion string `json:"consensus-version"`
+			Fee              int    `json:"fee"`
+			GenesisHash      []byte `json:"genesis-hash"`
+			GenesisId        string `json:"genesis-id"`
+			LastRound        int    `json:"last-round"`
+			MinFee           int    `json:"min-fee"`
+		}{
+			ConsensusVersion: "future",
+			Fee:              0,
+			GenesisHash:      []byte("TESTGENESISHASHTESTGENESISHASH32"),
+			GenesisId:        "tuinet-v1",
+			LastRound:        1000,
+			MinFee:           1000,
+		},
+	}
+	if c.Errors {
+		return nil, errors.New("test error")
+	}
+	return &res, nil
+}
+
+// RawTransactionWithBodyWithResponse simulates submitting a signed transaction.
+func (c *Client) RawTransactionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...api.RequestEditorFn) (*api.RawTransactionResponse, error) {
+	var res api.RawTransactionResponse
+	if !c.Invalid {
+		httpResponse := http.Response{StatusCode: 200}
+		res = api.RawTransactionResponse{
+			HTTPResponse: &httpResponse,
+			JSON200: &struct {
+				TxId string `json:"txId"`
+			}{TxId: "TXID"},
+		}
+	} else {
+		httpResponse := http.Response{StatusCode: 400}
+		res = api.RawTransactionResponse{
+			HTTPResponse: &httpResponse,
+			JSON400:      &api.ErrorResponse{Message: "transaction rejected"},
+		}
+	}
+	if c.Errors {
+		return nil, errors.New("test error")
+	}
+	return &res, nil
+}
+
 func (c *Client) GetVersionWithResponse(ctx context.Context, reqEditors ...api.RequestEditorFn) (*api.GetVersionResponse, error) {
 	var res api.GetVersionResponse
 	version := api.Version{
@@ -0,0 +1,132 @@
+package mock
+
+import "github.com/algorandfoundation/nodekit/internal/system"
+
+// Service is an in-memory system.Interface, it records the calls and tracks the state they change.
+type Service struct {
+	Installed bool
+	Running   bool
+	Service   bool
+	Enabled   bool
+	Network   string
+	DataDir   string
+	Status    system.ServiceStatus
+
+	// Release is the release of the last Install or Update.
+	Release system.Release
+
+	// Err is returned by every call changing the state, the state is left untouched.
+	Err error
+
+	// Calls lists the names of the calls changing the state, in order.
+	Calls []string
+}
+
+var _ system.Interface = &Service{}
+
+// call records the call and returns Err.
+func (s *Service) call(name string) error {
+	s.Calls = append(s.Calls, name)
+	return s.Err
+}
+
+// IsInstalled returns Installed.
+func (s *Service) IsInstalled() bool { return s.Installed }
+
+// IsRunning returns Running for any data directory.
+func (s *Service) IsRunning(dataDir string) bool { return s.Running }
+
+// IsService returns Service.
+func (s *Service) IsService() bool { return s.Service }
+
+// IsServiceEnabled returns Enabled.
+func (s *Service) IsServiceEnabled() bool { return s.Enabled }
+
+// ServiceStatus returns Status, with system.ErrUnsupported when there is no service.
+func (s *Service) ServiceStatus() (system.ServiceStatus, error) {
+	if !s.Service {
+		return s.Status, system.ErrUnsupported
+	}
+	return s.Status, nil
+}
+
+// SetNetwork records the call and sets Network.
+func (s *Service) SetNetwork(network string) error {
+	err := s.call("SetNetwork")
+	if err == nil {
+		s.Network = network
+	}
+	return err
+}
+
+// Install records the call and installs an enabled service with the release.
+func (s *Service) Install(release system.Release) error {
+	err := s.call("Install")
+	if err == nil {
+		s.Installed, s.Service, s.Enabled, s.Release = true, true, true, release
+	}
+	return err
+}
+
+// Update records the call and sets Release.
+func (s *Service) Update(release system.Release) error {
+	err := s.call("Update")
+	if err == nil {
+		s.Release = release
+	}
+	return err
+}
+
+// Uninstall records the call and removes the service, force is ignored.
+func (s *Service) Uninstall(force bool) error {
+	err := s.call("Uninstall")
+	if err == nil {
+		s.Installed, s.Service, s.Enabled, s.Running = false, false, false, false
+	}
+	return err
+}
+
+// Start records the call and sets Running.
+func (s *Service) Start() error {
+	err := s.call("Start")
+	if err == nil {
+		s.Running = true
+	}
+	return err
+}
+
+// Stop records the call and clears Running.
+func (s *Service) Stop() error {
+	err := s.call("Stop")
+	if err == nil {
+		s.Running = false
+	}
+	return err
+}
+
+// Restart records the call and sets Running.
+func (s *Service) Restart() error {
+	err := s.call("Restart")
+	if err == nil {
+		s.Running = true
+	}
+	return err
+}
+
+// UpdateService records the call and sets DataDir.
+func (s *Service) UpdateService(dataDirectoryPath string) error {
+	err := s.call("UpdateService")
+	if err == nil {
+		s.DataDir = dataDirectoryPath
+	}
+	return err
+}
+
+// EnsureService records the call and enables the service.
+func (s *Service) EnsureService() error {
+	err := s.call("EnsureService")
+	if err == nil {
+		s.Service, s.Enabled = true, true
+	}
+	return err
+}
@@ -1,9 +1,11 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"github.com/algorandfoundation/nodekit/api"
 	"github.com/algorandfoundation/nodekit/cmd"
+	"github.com/algorandfoundation/nodekit/internal/doctor"
 	"github.com/charmbracelet/log"
 	"os"
 	"runtime"
@@ -39,7 +41,8 @@ func main() {
 	// TODO: more performance tuning
 	runtime.GOMAXPROCS(1)
 	err = cmd.Execute(version, needsUpgrade)
-	if err != nil {
-		return
+	// Failed doctor checks exit with an error for scripts and monitoring, the error was printed by the command
+	if errors.Is(err, doctor.ErrFailed) {
+		os.Exit(1)
 	}
 }
@@ -12,9 +12,17 @@ type FastCatchupStopped string
 
 func StartFastCatchupCmd(state *algod.StateModel) tea.Cmd {
 	return func() tea.Msg {
-		threshold := algod.CATCHPOINT_THRESHOLD
-		// Fetch catchpoint
-		catchpoint, _, err := algod.GetLatestCatchpoint(state.HttpPkg, state.Status.Network)
+		threshold := algod.GetLaggingThreshold(state.Status.Network, state.Status.GenesisHash)
+		// Fetch catchpoint, verified by the catchpoint sources when the settings require it
+		var catchpoint string
+		var err error
+		if algod.IsCatchpointVerificationEnabled() {
+			var verification algod.Verification
+			verification, err = algod.GetVerifiedCatchpoint(state.Context, state.HttpPkg, state.Status.Network, state.Status.GenesisHash)
+			catchpoint = verification.Catchpoint
+		} else {
+			catchpoint, _, err = algod.GetLatestCatchpoint(state.HttpPkg, state.Status.Network, state.Status.GenesisHash)
+		}
 		if err != nil {
 			return err
 		}
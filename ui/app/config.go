@@ -0,0 +1,65 @@
+package app
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/algod/utils"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ErrNoDataDir is returned when the configuration of a node without a local data directory is requested.
+var ErrNoDataDir = errors.New("the configuration can only be edited for a node with a local data directory")
+
+// ConfigLoaded contains the keys of the config.json file in the node's data directory.
+type ConfigLoaded struct {
+	Values map[string]json.RawMessage
+	Err    error
+}
+
+// LoadConfigCmd reads the config.json file of the node.
+func LoadConfigCmd(state *algod.StateModel) tea.Cmd {
+	return func() tea.Msg {
+		if state.DataDir == "" {
+			return ConfigLoaded{Err: ErrNoDataDir}
+		}
+		values, err := utils.GetConfigValuesFromDataDir(state.DataDir)
+		return ConfigLoaded{Values: values, Err: err}
+	}
+}
+
+// ConfigOptionSelected is the name and current value of the option to edit.
+type ConfigOptionSelected struct {
+	Name  string
+	Value string
+	Set   bool
+}
+
+// EmitConfigOptionSelected creates a command to select an option for the config modal.
+func EmitConfigOptionSelected(option ConfigOptionSelected) tea.Cmd {
+	return func() tea.Msg {
+		return option
+	}
+}
+
+// SaveConfig validates and writes a single option, an empty value removes it so algod uses the default.
+// The typed Config of the state is reloaded so the rest of the interface reflects the change.
+func SaveConfig(state *algod.StateModel, name string, value string) (bool, error) {
+	if state.DataDir == "" {
+		return false, ErrNoDataDir
+	}
+	set := map[string]string{}
+	var unset []string
+	if value == "" {
+		unset = append(unset, name)
+	} else {
+		set[name] = value
+	}
+	changed, err := algod.UpdateConfig(state.DataDir, state.Status.Version, set, unset)
+	if err != nil || !changed {
+		return changed, err
+	}
+	state.Config, err = utils.GetConfigFromDataDir(state.DataDir)
+	return changed, err
+}
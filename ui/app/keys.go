@@ -2,9 +2,11 @@ package app
 
 import (
 	"context"
+	"fmt"
 	"github.com/algorandfoundation/nodekit/internal/algod"
 	"github.com/algorandfoundation/nodekit/internal/algod/participation"
 	"github.com/charmbracelet/lipgloss"
+	"os"
 	"time"
 
 	"github.com/algorandfoundation/nodekit/api"
@@ -75,6 +77,50 @@ func GenerateCmd(account string, rangeType participation.RangeType, duration int
 
 }
 
+// ImportCmd creates a command to upload a participation key file to the node.
+// The installed key is checked against the account's registration and returned as a KeySelectedEvent.
+func ImportCmd(path string, state *algod.StateModel) tea.Cmd {
+	return func() tea.Msg {
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		key, err := participation.Import(state.Context, state.Client, "", file)
+		if err != nil {
+			return err
+		}
+
+		acct, ok := state.Accounts[key.Address]
+		active := ok && acct.Participation != nil && participation.IsActive(*key, *acct.Participation)
+
+		next := lipgloss.JoinVertical(
+			lipgloss.Left,
+			"Next step: register the participation keys with the network by signing a keyreg online transaction.",
+			"Press the R key to start this process.",
+		)
+		if active {
+			next = "The account is already registered online with this key."
+		}
+		if key.Key.VoteLastValid < int(state.Status.LastRound) {
+			next = fmt.Sprintf("***WARNING***\nThe key expired at round %d.", key.Key.VoteLastValid)
+		}
+
+		return KeySelectedEvent{
+			Key: key,
+			Prefix: lipgloss.JoinVertical(
+				lipgloss.Left,
+				"Participation keys imported.",
+				"",
+				next,
+				"",
+			),
+			Active: active,
+		}
+	}
+}
+
 // KeySelectedEvent represents an event triggered in the modal system.
 type KeySelectedEvent struct {
 
@@ -34,6 +34,15 @@ const (
 
 	// RenameModal represents a modal type used for assigning a local nickname to an account.
 	RenameModal ModalType = "rename"
+
+	// ImportModal represents a modal type used for uploading a participation key file to the node.
+	ImportModal ModalType = "import"
+
+	// ConfigModal represents a modal type used for editing a single algod configuration option.
+	ConfigModal ModalType = "config"
+
+	// UpgradeModal represents a modal type used to warn about a protocol upgrade the installed algod does not support.
+	UpgradeModal ModalType = "upgrade"
 )
 
 // EmitShowModal creates a command to emit a modal message of the specified ModalType.
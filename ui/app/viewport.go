@@ -14,6 +14,9 @@ const (
 
 	// KeysPage represents the page within the application used for managing and displaying key-related information.
 	KeysPage Page = "keys"
+
+	// ConfigPage represents the page within the application used for viewing and editing the algod configuration.
+	ConfigPage Page = "config"
 )
 
 // EmitShowPage returns a command that emits a tea.Msg containing the given Page to be displayed in the application's viewport.
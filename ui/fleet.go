@@ -0,0 +1,141 @@
+package ui
+
+import (
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/fleet"
+	"github.com/algorandfoundation/nodekit/ui/app"
+	fleetpage "github.com/algorandfoundation/nodekit/ui/pages/fleet"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// FleetViewModel shows a summary of several nodes and a ViewportViewModel for the selected node.
+type FleetViewModel struct {
+	TerminalWidth, TerminalHeight int
+
+	Nodes  []fleet.Node
+	States map[string]*algod.StateModel
+	Errors map[string]error
+
+	// Snapshots are the latest copies of the States, the summary reads them instead of the live States
+	Snapshots map[string]algod.Snapshot
+
+	summary   fleetpage.ViewModel
+	viewports map[string]ViewportViewModel
+
+	// active is the node being shown, the summary is shown when it is empty
+	active string
+}
+
+// Init hooks for components
+func (m FleetViewModel) Init() tea.Cmd {
+	return m.summary.Init()
+}
+
+// Update Handle the fleet lifecycle
+func (m FleetViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var (
+		cmd  tea.Cmd
+		cmds []tea.Cmd
+	)
+
+	switch msg := msg.(type) {
+	case fleet.Update:
+		m.Errors[msg.Name] = msg.Err
+		if msg.State != nil {
+			m.States[msg.Name] = msg.State
+			m.Snapshots[msg.Name] = msg.Snapshot
+			// Create the viewport once the node responded
+			if _, ok := m.viewports[msg.Name]; !ok {
+				vp, err := NewViewportViewModel(msg.State)
+				if err != nil {
+					return m, func() tea.Msg { return err }
+				}
+				cmds = append(cmds, vp.Init())
+				m.viewports[msg.Name] = m.updateViewport(*vp, tea.WindowSizeMsg{Width: m.TerminalWidth, Height: m.TerminalHeight}, &cmds)
+			}
+		}
+		m.summary, cmd = m.summary.HandleMessage(fleet.Summarize(m.Nodes, m.Snapshots, m.Errors))
+		cmds = append(cmds, cmd)
+
+		// Only the node being shown receives the live updates
+		if msg.Name == m.active {
+			vp := m.updateViewport(m.viewports[m.active], msg.State, &cmds)
+			if msg.Err != nil {
+				vp = m.updateViewport(vp, msg.Err, &cmds)
+			}
+			m.viewports[m.active] = vp
+		}
+		return m, tea.Batch(cmds...)
+
+	case tea.WindowSizeMsg:
+		m.TerminalWidth = msg.Width
+		m.TerminalHeight = msg.Height
+		m.summary, cmd = m.summary.HandleMessage(msg)
+		cmds = append(cmds, cmd)
+		for name, vp := range m.viewports {
+			m.viewports[name] = m.updateViewport(vp, msg, &cmds)
+		}
+		return m, tea.Batch(cmds...)
+
+	case tea.KeyMsg:
+		if m.active == "" {
+			switch msg.String() {
+			case "enter":
+				name := m.summary.SelectedNode()
+				vp, ok := m.viewports[name]
+				if !ok {
+					// Nothing to show until the node responded
+					return m, nil
+				}
+				m.active = name
+				m.viewports[name] = m.updateViewport(vp, m.States[name], &cmds)
+				return m, tea.Batch(cmds...)
+			case "q", "ctrl+c":
+				return m, tea.Quit
+			}
+			m.summary, cmd = m.summary.HandleMessage(msg)
+			return m, cmd
+		}
+
+		// Go back to the summary from the accounts page
+		vp := m.viewports[m.active]
+		if msg.String() == "esc" && vp.page == app.AccountsPage && !vp.modal.Open {
+			m.active = ""
+			return m, nil
+		}
+	}
+
+	// Handle all other events in the node being shown
+	if m.active != "" {
+		m.viewports[m.active] = m.updateViewport(m.viewports[m.active], msg, &cmds)
+	}
+	return m, tea.Batch(cmds...)
+}
+
+// updateViewport passes a message to a viewport and collects its command
+func (m FleetViewModel) updateViewport(vp ViewportViewModel, msg tea.Msg, cmds *[]tea.Cmd) ViewportViewModel {
+	model, cmd := vp.Update(msg)
+	*cmds = append(*cmds, cmd)
+	return model.(ViewportViewModel)
+}
+
+// View renders the summary or the node being shown
+func (m FleetViewModel) View() string {
+	if m.active != "" {
+		return m.viewports[m.active].View()
+	}
+	return m.summary.View()
+}
+
+// NewFleetViewModel handles the construction of the fleet TUI, nodes are added as their updates arrive
+func NewFleetViewModel(nodes []fleet.Node) *FleetViewModel {
+	m := FleetViewModel{
+		Nodes:     nodes,
+		States:    make(map[string]*algod.StateModel),
+		Snapshots: make(map[string]algod.Snapshot),
+		Errors:    make(map[string]error),
+		viewports: make(map[string]ViewportViewModel),
+	}
+	m.summary = fleetpage.New(fleet.Summarize(m.Nodes, m.Snapshots, m.Errors))
+	return &m
+}
@@ -0,0 +1,49 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/algorandfoundation/nodekit/internal/fleet"
+	"github.com/algorandfoundation/nodekit/internal/test"
+	uitest "github.com/algorandfoundation/nodekit/ui/internal/test"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func Test_FleetViewModel(t *testing.T) {
+	state := uitest.GetState(test.GetClient(false))
+	var model tea.Model = NewFleetViewModel([]fleet.Node{
+		{Name: "down", Endpoint: "http://localhost:1", Token: "t"},
+		{Name: "local", DataDir: "/var/lib/algorand"},
+	})
+	model, _ = model.Update(tea.WindowSizeMsg{Width: 160, Height: 40})
+	model, _ = model.Update(fleet.Update{Name: "down", Err: errors.New("connection refused")})
+	model, _ = model.Update(fleet.Update{Name: "local", State: state, Snapshot: state.Snapshot()})
+
+	view := ansi.Strip(model.View())
+	if !strings.Contains(view, "UNREACHABLE") || !strings.Contains(view, "local") {
+		t.Fatalf("expected the summary of both nodes, got %s", view)
+	}
+
+	// An unreachable node cannot be selected
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if model.(FleetViewModel).active != "" {
+		t.Error("expected to stay on the summary")
+	}
+
+	// Drill into the node and back out
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if model.(FleetViewModel).active != "local" {
+		t.Fatalf("expected the local node to be shown")
+	}
+	if !strings.Contains(ansi.Strip(model.View()), "Accounts") {
+		t.Error("expected the accounts page of the node")
+	}
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if model.(FleetViewModel).active != "" {
+		t.Error("expected esc to return to the summary")
+	}
+}
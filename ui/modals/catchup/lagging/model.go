@@ -1,6 +1,9 @@
 package lagging
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/algorandfoundation/nodekit/internal/algod"
 	"github.com/algorandfoundation/nodekit/ui/app"
 	"github.com/algorandfoundation/nodekit/ui/style"
@@ -13,6 +16,8 @@ type ViewModel struct {
 	Width  int
 	// State is a pointer to an algod.StateModel, representing the state of the application including its configurations.
 	State *algod.StateModel
+	// Estimate compares a fast-catchup with a normal sync, nil until it is received.
+	Estimate *algod.CatchupEstimate
 }
 
 func New(state *algod.StateModel) ViewModel {
@@ -36,6 +41,8 @@ func (m ViewModel) HandleMessage(msg tea.Msg) (ViewModel, tea.Cmd) {
 	switch msg := msg.(type) {
 	case app.FastCatchupStarted:
 		return m, app.EmitCloseOverlay()
+	case algod.CatchupEstimate:
+		m.Estimate = &msg
 	case tea.KeyMsg:
 		switch msg.String() {
 		case "y", "Y":
@@ -70,10 +77,19 @@ func (m ViewModel) Controls() string {
 
 // Body returns the formatted body content of the ViewModel, including participation key details or a default message.
 func (m ViewModel) Body() string {
+	if m.Estimate == nil {
+		return lipgloss.NewStyle().Padding(1).Render(lipgloss.JoinVertical(lipgloss.Center,
+			"Your node is significantly behind the network.\n Would you like to perform a fast-catchup?\n",
+		))
+	}
 	return lipgloss.NewStyle().Padding(1).Render(lipgloss.JoinVertical(lipgloss.Center,
-		"Your node is significantly behind the network.\n Would you like to perform a fast-catchup?\n",
+		fmt.Sprintf("Your node is %d rounds behind the network.", m.Estimate.Lag),
+		"",
+		fmt.Sprintf("Normal sync: %s", style.Red.Render(m.Estimate.SyncTime.Round(time.Minute).String())),
+		fmt.Sprintf("Fast-catchup: %s", style.Green.Render(m.Estimate.CatchupTime.Round(time.Minute).String())),
+		"",
+		"Would you like to perform a fast-catchup?\n",
 	))
-
 }
 
 // View renders the ViewModel as a styled string, incorporating title, controls, and body content with dynamic borders.
@@ -3,6 +3,7 @@ package catchup
 import (
 	"fmt"
 	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/algod/progress"
 	"github.com/algorandfoundation/nodekit/ui/app"
 	"github.com/algorandfoundation/nodekit/ui/style"
 	tea "github.com/charmbracelet/bubbletea"
@@ -14,6 +15,8 @@ type ViewModel struct {
 	State  *algod.StateModel
 	Height int
 	Width  int
+	// Progress is the rate and ETA of the catchup phases, nil until the first report is received.
+	Progress *progress.Report
 }
 
 func New(state *algod.StateModel) ViewModel {
@@ -37,6 +40,8 @@ func (m ViewModel) HandleMessage(msg tea.Msg) (ViewModel, tea.Cmd) {
 	switch msg := msg.(type) {
 	case app.FastCatchupStopped:
 		return m, app.EmitCloseOverlay()
+	case progress.Report:
+		m.Progress = &msg
 	case tea.KeyMsg:
 		switch msg.String() {
 		// TODO: Maybe abort?
@@ -68,19 +73,45 @@ func (m ViewModel) Controls() string {
 
 // Body returns the formatted body content of the ViewModel, including participation key details or a default message.
 func (m ViewModel) Body() string {
-	return style.LightBlue(lipgloss.JoinVertical(lipgloss.Top,
+	lines := []string{
 		"Please wait while your node syncs with the network.",
 		"This process can take up to an hour.",
 		"",
-		fmt.Sprintf("Accounts Processed:   %d / %d", m.State.Status.CatchpointAccountsProcessed, m.State.Status.CatchpointAccountsTotal),
+		fmt.Sprintf("Accounts Processed:   %d / %d%s", m.State.Status.CatchpointAccountsProcessed, m.State.Status.CatchpointAccountsTotal, m.phaseETA(progress.AccountsPhase)),
+		fmt.Sprintf("Key Values Processed: %d / %d%s", m.State.Status.CatchpointKeyValueProcessed, m.State.Status.CatchpointKeyValueTotal, m.phaseETA(progress.KeyValuesPhase)),
+		// Accounts and key values are verified together, the phase has a single ETA
 		fmt.Sprintf("Accounts Verified:    %d / %d", m.State.Status.CatchpointAccountsVerified, m.State.Status.CatchpointAccountsTotal),
-		fmt.Sprintf("Key Values Processed: %d / %d", m.State.Status.CatchpointKeyValueProcessed, m.State.Status.CatchpointKeyValueTotal),
 		fmt.Sprintf("Key Values Verified:  %d / %d", m.State.Status.CatchpointKeyValueVerified, m.State.Status.CatchpointKeyValueTotal),
-		fmt.Sprintf("Downloaded blocks:    %d / %d", m.State.Status.CatchpointBlocksAcquired, m.State.Status.CatchpointBlocksTotal),
+		fmt.Sprintf("Verification:         %d / %d%s",
+			m.State.Status.CatchpointAccountsVerified+m.State.Status.CatchpointKeyValueVerified,
+			m.State.Status.CatchpointAccountsTotal+m.State.Status.CatchpointKeyValueTotal,
+			m.phaseETA(progress.VerifyPhase)),
+		fmt.Sprintf("Downloaded blocks:    %d / %d%s", m.State.Status.CatchpointBlocksAcquired, m.State.Status.CatchpointBlocksTotal, m.phaseETA(progress.BlocksPhase)),
 		"",
 		fmt.Sprintf("Sync Time: %ds", m.State.Status.SyncTime/int(time.Second)),
-	))
+	}
+	if m.Progress != nil && m.Progress.Catchpoint != "" {
+		if m.Progress.ETA > 0 {
+			lines = append(lines, fmt.Sprintf("Estimated Time Remaining: %s", m.Progress.ETA.Round(time.Second)))
+		}
+		if m.Progress.Stalled {
+			lines = append(lines, "", style.Red.Render(fmt.Sprintf("No progress since %s, the catchup may have stalled.", m.Progress.LastChange.Format(time.TimeOnly))))
+		}
+	}
+	return style.LightBlue(lipgloss.JoinVertical(lipgloss.Top, lines...))
+}
 
+// phaseETA returns the rate and remaining time of a phase, empty when unknown.
+func (m ViewModel) phaseETA(phase progress.Phase) string {
+	if m.Progress == nil {
+		return ""
+	}
+	for _, p := range m.Progress.Phases {
+		if p.Phase == phase && p.Rate > 0 {
+			return fmt.Sprintf("  (%.0f/s, %s left)", p.Rate, p.ETA.Round(time.Second))
+		}
+	}
+	return ""
 }
 
 // View renders the ViewModel as a styled string, incorporating title, controls, and body content with dynamic borders.
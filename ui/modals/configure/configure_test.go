@@ -0,0 +1,57 @@
+package configure
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/algorandfoundation/nodekit/internal/algod/utils"
+	"github.com/algorandfoundation/nodekit/internal/test"
+	"github.com/algorandfoundation/nodekit/ui/app"
+	uitest "github.com/algorandfoundation/nodekit/ui/internal/test"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func Test_Configure(t *testing.T) {
+	state := uitest.GetState(test.GetClient(false))
+	state.DataDir = t.TempDir()
+	err := os.WriteFile(filepath.Join(state.DataDir, "config.json"), []byte(`{"Version": 34}`), 0o664)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := New(state)
+	m, _ = m.HandleMessage(app.ConfigOptionSelected{Name: "GossipFanout", Value: "", Set: false})
+	if m.Option.Name != "GossipFanout" || m.Input.Placeholder != "4" {
+		t.Fatalf("expected the option to be selected, got %+v", m.Option)
+	}
+	if !strings.Contains(m.View(), "Outgoing connections to relays") {
+		t.Error("expected the description to be rendered")
+	}
+
+	m.Input.SetValue("0")
+	m, cmd := m.HandleMessage(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd != nil || !strings.Contains(m.InputError, "at least 1") {
+		t.Errorf("expected a validation error, got %s", m.InputError)
+	}
+
+	m.Input.SetValue("8")
+	m, cmd = m.HandleMessage(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil || m.InputError != "" {
+		t.Fatalf("expected the value to be saved, got %s", m.InputError)
+	}
+	values, _ := utils.GetConfigValuesFromDataDir(state.DataDir)
+	if string(values["GossipFanout"]) != "8" || string(values["Version"]) != "34" {
+		t.Errorf("unexpected config %v", values)
+	}
+
+	// An empty value restores the default
+	m, _ = m.HandleMessage(app.ConfigOptionSelected{Name: "GossipFanout", Value: "8", Set: true})
+	m.Input.SetValue("")
+	_, _ = m.HandleMessage(tea.KeyMsg{Type: tea.KeyEnter})
+	values, _ = utils.GetConfigValuesFromDataDir(state.DataDir)
+	if _, ok := values["GossipFanout"]; ok {
+		t.Error("expected GossipFanout to be removed")
+	}
+}
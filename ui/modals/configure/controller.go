@@ -0,0 +1,51 @@
+package configure
+
+import (
+	"strings"
+
+	"github.com/algorandfoundation/nodekit/ui/app"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Init initializes the ViewModel, starting the text input cursor blink.
+func (m ViewModel) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+// Update processes incoming messages and returns the updated model and command.
+func (m ViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return m.HandleMessage(msg)
+}
+
+// HandleMessage processes incoming messages, updates the ViewModel state, and
+// returns an updated model and command.
+func (m ViewModel) HandleMessage(msg tea.Msg) (ViewModel, tea.Cmd) {
+	var cmd tea.Cmd
+	switch msg := msg.(type) {
+	case app.ConfigOptionSelected:
+		m.SetOption(msg.Name, msg.Value, msg.Set)
+		return m, nil
+	case tea.WindowSizeMsg:
+		m.Width = msg.Width
+		m.Height = msg.Height
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "esc":
+			return m, app.EmitCloseOverlay()
+		case "enter":
+			value := strings.TrimSpace(m.Input.Value())
+			_, err := app.SaveConfig(m.State, m.Option.Name, value)
+			if err != nil {
+				m.InputError = "Error: " + err.Error()
+				return m, nil
+			}
+			m.InputError = ""
+			// Reload the page with the new value
+			return m, tea.Batch(app.EmitCloseOverlay(), app.LoadConfigCmd(m.State))
+		}
+	}
+
+	m.Input, cmd = m.Input.Update(msg)
+	return m, cmd
+}
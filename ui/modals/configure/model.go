@@ -0,0 +1,50 @@
+package configure
+
+import (
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/algod/config"
+	"github.com/charmbracelet/bubbles/textinput"
+)
+
+// ViewModel holds the state for the modal used to edit a single algod configuration option.
+type ViewModel struct {
+	Width  int
+	Height int
+
+	// Option is the schema entry being edited.
+	Option config.Option
+	// Set indicates whether the option is present in config.json.
+	Set bool
+
+	// Input collects the new value, an empty value restores the default.
+	Input textinput.Model
+	// InputError holds a validation/persistence error to surface to the user.
+	InputError string
+
+	// State is the shared application state, its DataDir locates config.json.
+	State *algod.StateModel
+}
+
+// New creates a configure ViewModel bound to the provided application state.
+func New(state *algod.StateModel) ViewModel {
+	m := ViewModel{
+		State: state,
+		Input: textinput.New(),
+	}
+	m.Input.Cursor.Style = cursorStyle
+	m.Input.CharLimit = 256
+	m.Input.PromptStyle = focusedStyle
+	m.Input.TextStyle = focusedStyle
+	return m
+}
+
+// SetOption targets the modal at an option, prefilling the input with its current value.
+func (m *ViewModel) SetOption(name string, value string, set bool) {
+	m.Option, _ = config.Lookup(name)
+	m.Set = set
+	m.InputError = ""
+	m.Input.Placeholder = m.Option.Format(m.Option.Default)
+	m.Input.SetValue(value)
+	m.Input.CursorEnd()
+	m.Input.Focus()
+}
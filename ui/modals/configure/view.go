@@ -0,0 +1,71 @@
+package configure
+
+import (
+	"fmt"
+
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Title returns the modal title.
+func (m ViewModel) Title() string {
+	return "Edit Configuration"
+}
+
+// BorderColor returns the modal border color.
+func (m ViewModel) BorderColor() string {
+	return "5"
+}
+
+// Controls returns the available control hints for the modal.
+func (m ViewModel) Controls() string {
+	return "| " + style.Red.Render("(esc) to cancel") + " |"
+}
+
+// Navigation returns the navigation hint for the modal.
+func (m ViewModel) Navigation() string {
+	return style.Bold("( (enter) to save | empty for the default )")
+}
+
+// Body renders the modal contents.
+func (m ViewModel) Body() string {
+	details := fmt.Sprintf("Type: %s | Default: %s", m.Option.Type, m.Option.Format(m.Option.Default))
+	if m.Option.Since != "" {
+		details += " | Since: algod " + m.Option.Since
+	}
+	render := lipgloss.JoinVertical(lipgloss.Left,
+		"",
+		style.Bold(m.Option.Name),
+		m.Option.Description,
+		"",
+		m.Input.View(),
+		"",
+		lipgloss.NewStyle().Faint(true).Render(details),
+		lipgloss.NewStyle().Faint(true).Render("Restart the node to apply the change"),
+		"",
+	)
+	if m.InputError != "" {
+		render = lipgloss.JoinVertical(lipgloss.Left,
+			render,
+			style.Red.Render(m.InputError),
+		)
+	}
+	return lipgloss.NewStyle().Width(70).Render(render)
+}
+
+// View renders the ViewModel as a styled string.
+func (m ViewModel) View() string {
+	body := m.Body()
+	width := lipgloss.Width(body)
+	height := lipgloss.Height(body)
+	return style.WithControls(m.Controls(), style.WithNavigation(
+		m.Navigation(),
+		style.WithTitle(
+			m.Title(),
+			style.ApplyBorder(width+2, height-4, m.BorderColor()).
+				PaddingRight(1).
+				PaddingLeft(1).
+				Render(m.Body()),
+		),
+	))
+}
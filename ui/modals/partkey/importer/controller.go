@@ -0,0 +1,66 @@
+package importer
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/algorandfoundation/nodekit/ui/app"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Init initializes the ViewModel, starting the text input cursor blink.
+func (m ViewModel) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+// Update processes incoming messages and returns the updated model and command.
+func (m ViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return m.HandleMessage(msg)
+}
+
+// HandleMessage processes incoming messages, updates the ViewModel state, and
+// returns an updated model and command.
+func (m ViewModel) HandleMessage(msg tea.Msg) (ViewModel, tea.Cmd) {
+	var cmd tea.Cmd
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.Width = msg.Width
+		m.Height = msg.Height
+	// The upload finished or failed
+	case app.KeySelectedEvent, error:
+		m.Waiting = false
+	case tea.KeyMsg:
+		if m.Waiting {
+			return m, nil
+		}
+		switch msg.String() {
+		case "esc":
+			return m, app.EmitCloseOverlay()
+		case "enter":
+			path := strings.TrimSpace(m.Input.Value())
+			if strings.HasPrefix(path, "~/") {
+				home, err := os.UserHomeDir()
+				if err == nil {
+					path = filepath.Join(home, path[2:])
+				}
+			}
+			info, err := os.Stat(path)
+			if err != nil {
+				m.InputError = "Error: " + err.Error()
+				return m, nil
+			}
+			if info.IsDir() {
+				m.InputError = "Error: " + path + " is a directory"
+				return m, nil
+			}
+			m.InputError = ""
+			m.Waiting = true
+			return m, app.ImportCmd(path, m.State)
+		}
+	}
+
+	m.Input, cmd = m.Input.Update(msg)
+	return m, cmd
+}
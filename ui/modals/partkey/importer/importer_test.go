@@ -0,0 +1,57 @@
+package importer
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/algorandfoundation/nodekit/internal/test"
+	"github.com/algorandfoundation/nodekit/ui/app"
+	uitest "github.com/algorandfoundation/nodekit/ui/internal/test"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func Test_Import(t *testing.T) {
+	m := New(uitest.GetState(test.GetClient(false)))
+
+	m.Input.SetValue(filepath.Join(t.TempDir(), "missing.partkey"))
+	m, cmd := m.HandleMessage(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd != nil || m.Waiting || m.InputError == "" {
+		t.Error("expected an error for a missing file")
+	}
+	if !strings.Contains(m.View(), "Error:") {
+		t.Error("expected the error to be rendered")
+	}
+
+	path := filepath.Join(t.TempDir(), "key.partkey")
+	err := os.WriteFile(path, []byte("partkey"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.Input.SetValue(path)
+	m, cmd = m.HandleMessage(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil || !m.Waiting || m.InputError != "" {
+		t.Fatal("expected the upload to start")
+	}
+	if m.Controls() != "" {
+		t.Error("expected no controls while uploading")
+	}
+
+	event, ok := cmd().(app.KeySelectedEvent)
+	if !ok || event.Key == nil || event.Key.Id != "123" {
+		t.Fatalf("expected the imported key, got %+v", event)
+	}
+	if !strings.Contains(event.Prefix, "Participation keys imported.") {
+		t.Errorf("unexpected prefix %s", event.Prefix)
+	}
+
+	m, _ = m.HandleMessage(event)
+	if m.Waiting {
+		t.Error("expected the upload to finish")
+	}
+	m.Reset()
+	if m.Input.Value() != "" {
+		t.Error("expected the input to be cleared")
+	}
+}
@@ -0,0 +1,47 @@
+package importer
+
+import (
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/charmbracelet/bubbles/textinput"
+)
+
+// ViewModel holds the state for the modal used to upload a participation key file
+// that was generated on another machine, for example an air-gapped host.
+type ViewModel struct {
+	Width  int
+	Height int
+
+	// Input collects the path to the participation key file.
+	Input textinput.Model
+	// InputError holds a validation error to surface to the user.
+	InputError string
+
+	// Waiting is set while the file is uploaded to the node.
+	Waiting bool
+
+	// State is the shared application state used for the client.
+	State *algod.StateModel
+}
+
+// New creates an import ViewModel bound to the provided application state.
+func New(state *algod.StateModel) ViewModel {
+	m := ViewModel{
+		State: state,
+		Input: textinput.New(),
+	}
+	m.Input.Cursor.Style = cursorStyle
+	m.Input.CharLimit = 4096
+	m.Input.Placeholder = "/path/to/file.partkey"
+	m.Input.PromptStyle = focusedStyle
+	m.Input.TextStyle = focusedStyle
+	m.Input.Focus()
+	return m
+}
+
+// Reset clears the input and any error so the modal can be reused.
+func (m *ViewModel) Reset() {
+	m.Input.SetValue("")
+	m.Input.Focus()
+	m.InputError = ""
+	m.Waiting = false
+}
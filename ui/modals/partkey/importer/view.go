@@ -0,0 +1,73 @@
+package importer
+
+import (
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Title returns the modal title.
+func (m ViewModel) Title() string {
+	return "Import Participation Key"
+}
+
+// BorderColor returns the modal border color.
+func (m ViewModel) BorderColor() string {
+	return "2"
+}
+
+// Controls returns the available control hints for the modal.
+func (m ViewModel) Controls() string {
+	if m.Waiting {
+		return ""
+	}
+	return "| " + style.Red.Render("(esc) to cancel") + " |"
+}
+
+// Navigation returns the navigation hint for the modal.
+func (m ViewModel) Navigation() string {
+	return style.Bold("( (enter) to upload )")
+}
+
+// Body renders the modal contents.
+func (m ViewModel) Body() string {
+	if m.Waiting {
+		return lipgloss.NewStyle().Width(70).Render(lipgloss.JoinVertical(lipgloss.Left,
+			"",
+			"Uploading the participation key file to the node...",
+			"",
+		))
+	}
+	render := lipgloss.JoinVertical(lipgloss.Left,
+		"",
+		"Path to the participation key file:",
+		"",
+		m.Input.View(),
+		"",
+		lipgloss.NewStyle().Faint(true).Render("Create the file offline with: algokey part generate --keyfile <file.partkey>"),
+		"",
+	)
+	if m.InputError != "" {
+		render = lipgloss.JoinVertical(lipgloss.Left,
+			render,
+			style.Red.Render(m.InputError),
+		)
+	}
+	return lipgloss.NewStyle().Width(70).Render(render)
+}
+
+// View renders the ViewModel as a styled string.
+func (m ViewModel) View() string {
+	body := m.Body()
+	width := lipgloss.Width(body)
+	height := lipgloss.Height(body)
+	return style.WithControls(m.Controls(), style.WithNavigation(
+		m.Navigation(),
+		style.WithTitle(
+			m.Title(),
+			style.ApplyBorder(width+2, height-4, m.BorderColor()).
+				PaddingRight(1).
+				PaddingLeft(1).
+				Render(m.Body()),
+		),
+	))
+}
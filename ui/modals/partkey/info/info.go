@@ -115,6 +115,15 @@ func (m ViewModel) Body() string {
 	if m.Prefix != "" {
 		prefix = "\n" + m.Prefix
 	}
+	if m.State != nil {
+		if acct, ok := m.State.Accounts[m.Participation.Address]; ok && acct.IsRekeyed() {
+			prefix = lipgloss.JoinVertical(lipgloss.Left,
+				prefix,
+				style.Yellow.Render("***REKEYED***: transactions are authorized by "+acct.AuthAddr),
+				"",
+			)
+		}
+	}
 	return ansi.Hardwrap(lipgloss.JoinVertical(lipgloss.Left,
 		prefix,
 		account,
@@ -26,6 +26,20 @@ func Test_New(t *testing.T) {
 		t.Error("Controls are not correct")
 	}
 }
+func Test_Rekeyed(t *testing.T) {
+	m := New(test.GetState(nil))
+	m.Participation = &mock.Keys[0]
+	if bytes.Contains([]byte(m.Body()), []byte("REKEYED")) {
+		t.Error("Did not expect a rekey warning")
+	}
+	account := m.State.Accounts[mock.Keys[0].Address]
+	account.AuthAddr = "AUTH"
+	m.State.Accounts[mock.Keys[0].Address] = account
+	if !bytes.Contains([]byte(ansi.Strip(m.Body())), []byte("transactions are authorized by AUTH")) {
+		t.Error("Expected a rekey warning")
+	}
+}
+
 func Test_Snapshot(t *testing.T) {
 	// TODO: Suspended, and Corrupt Key
 	t.Run("Visible", func(t *testing.T) {
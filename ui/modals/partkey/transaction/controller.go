@@ -85,7 +85,7 @@ func (m *ViewModel) UpdateState() {
 
 	var fee *uint64
 	if m.ShouldAddIncentivesFee() {
-		feeInst := uint64(2000000)
+		feeInst := uint64(participation.IncentiveEligibleFee)
 		fee = &feeInst
 	}
 
@@ -70,6 +70,23 @@ func (m ViewModel) Body() string {
 		)
 	}
 
+	if acct := m.Account(); acct != nil && (acct.IsRekeyed() || acct.IsMultisig()) {
+		render = lipgloss.JoinVertical(
+			lipgloss.Center,
+			render,
+			"",
+			style.Yellow.Render("Authorizing address: "+acct.Signer()),
+		)
+		if acct.IsMultisig() {
+			render = lipgloss.JoinVertical(
+				lipgloss.Center,
+				render,
+				style.Yellow.Render("This is a multisig account, write an unsigned transaction instead:"),
+				fmt.Sprintf("nodekit keys keyreg %s", m.Participation.Id),
+			)
+		}
+	}
+
 	if m.ShouldAddIncentivesFee() {
 		render = lipgloss.JoinVertical(
 			lipgloss.Center,
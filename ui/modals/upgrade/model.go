@@ -0,0 +1,118 @@
+package upgrade
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/algod/upgrade"
+	"github.com/algorandfoundation/nodekit/ui/app"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type ViewModel struct {
+	Height int
+	Width  int
+	// State is a pointer to an algod.StateModel, representing the state of the application including its configurations.
+	State *algod.StateModel
+	// Report is the projection of the pending protocol upgrade, nil until it is received.
+	Report *upgrade.Report
+}
+
+func New(state *algod.StateModel) ViewModel {
+	return ViewModel{
+		State:  state,
+		Height: 0,
+		Width:  0,
+	}
+}
+
+func (m ViewModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m ViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return m.HandleMessage(msg)
+}
+
+func (m ViewModel) HandleMessage(msg tea.Msg) (ViewModel, tea.Cmd) {
+	var cmd tea.Cmd
+	switch msg := msg.(type) {
+	case upgrade.Report:
+		m.Report = &msg
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "esc", "enter":
+			return m, app.EmitCloseOverlay()
+		}
+	case tea.WindowSizeMsg:
+		borderRender := style.Border.Render("")
+		m.Width = max(0, msg.Width-lipgloss.Width(borderRender))
+		m.Height = max(0, msg.Height-lipgloss.Height(borderRender))
+	}
+
+	return m, cmd
+}
+
+// Title returns the static title string "Unsupported Protocol Upgrade" for the ViewModel.
+func (m ViewModel) Title() string {
+	return "( Unsupported Protocol Upgrade )"
+}
+
+// BorderColor returns the border color as a string, typically used for rendering styled components in the ViewModel.
+func (m ViewModel) BorderColor() string {
+	return "9"
+}
+
+// Controls returns a formatted string displaying the available control options.
+func (m ViewModel) Controls() string {
+	return "| " + style.Red.Render("(enter) to close") + " |"
+}
+
+// Body returns the formatted body content of the ViewModel, describing the upgrade and how to get ready for it.
+func (m ViewModel) Body() string {
+	if m.Report == nil || m.State == nil {
+		return lipgloss.NewStyle().Padding(1).Render("Waiting for the protocol upgrade status...")
+	}
+	r := m.Report
+	switchAt := fmt.Sprintf("round %d", r.SwitchRound)
+	if r.Switch != nil {
+		switchAt += ", around " + r.Switch.Local().Format("2006-01-02 15:04")
+	}
+	var tally string
+	if r.Phase == upgrade.ScheduledPhase {
+		tally = fmt.Sprintf("Protocol %s is scheduled at %s.", r.NextProtocol, switchAt)
+	} else {
+		tally = fmt.Sprintf("The vote is projected to pass with %d of %d required votes,", r.ProjectedYes, r.Required)
+		tally += fmt.Sprintf("\nthe switch is expected at %s.", switchAt)
+	}
+	return lipgloss.NewStyle().Padding(1).Render(lipgloss.JoinVertical(lipgloss.Center,
+		fmt.Sprintf("algod %s does not support protocol %s.", m.State.Status.Version, r.NextProtocol),
+		"",
+		tally,
+		"",
+		style.Red.Render("Your node will stall at the switch."),
+		"Upgrade it before by running:",
+		style.LightBlue(os.Args[0]+" upgrade"),
+	))
+}
+
+// View renders the ViewModel as a styled string, incorporating title, controls, and body content with dynamic borders.
+func (m ViewModel) View() string {
+	body := m.Body()
+	width := lipgloss.Width(body)
+	height := lipgloss.Height(body)
+	return style.WithNavigation(
+		m.Controls(),
+		style.WithTitle(
+			m.Title(),
+			// Apply the Borders with the Padding
+			style.ApplyBorder(width+2, height-4, m.BorderColor()).
+				PaddingRight(1).
+				PaddingLeft(1).
+				Render(m.Body()),
+		),
+	)
+}
@@ -19,6 +19,9 @@ func (m ViewModel) Init() tea.Cmd {
 		m.generateModal.Init(),
 		m.hybridModal.Init(),
 		m.renameModal.Init(),
+		m.importModal.Init(),
+		m.configModal.Init(),
+		m.upgradeModal.Init(),
 	)
 }
 
@@ -48,6 +51,9 @@ func (m ViewModel) HandleMessage(msg tea.Msg) (ViewModel, tea.Cmd) {
 		m.transactionModal.State = msg
 		m.infoModal.State = msg
 		m.renameModal.State = msg
+		m.importModal.State = msg
+		m.configModal.State = msg
+		m.upgradeModal.State = msg
 
 		// Get the existing account from the state
 		acct, ok := msg.Accounts[m.Address]
@@ -138,6 +144,7 @@ func (m ViewModel) HandleMessage(msg tea.Msg) (ViewModel, tea.Cmd) {
 			m.Open = false
 			m.SetType(app.InfoModal)
 			m.generateModal.Reset("")
+			m.importModal.Reset()
 		// Handle back navigation
 		case app.OverlayEventCancel:
 			switch m.Type {
@@ -157,10 +164,13 @@ func (m ViewModel) HandleMessage(msg tea.Msg) (ViewModel, tea.Cmd) {
 		if msg == app.RenameModal {
 			m.renameModal.SetAddress(m.Address)
 		}
+		if msg == app.ImportModal {
+			m.importModal.Reset()
+		}
 
 	// Only trigger KeyMsgs when the modal is active
 	case tea.KeyMsg:
-		if msg.String() == "q" && m.Type != app.GenerateModal && m.Type != app.RenameModal && m.Open {
+		if msg.String() == "q" && m.Type != app.GenerateModal && m.Type != app.RenameModal && m.Type != app.ImportModal && m.Type != app.ConfigModal && m.Open {
 			return m, tea.Quit
 		}
 		// Only trigger modal commands when they are active
@@ -183,6 +193,12 @@ func (m ViewModel) HandleMessage(msg tea.Msg) (ViewModel, tea.Cmd) {
 			m.hybridModal, cmd = m.hybridModal.HandleMessage(msg)
 		case app.RenameModal:
 			m.renameModal, cmd = m.renameModal.HandleMessage(msg)
+		case app.ImportModal:
+			m.importModal, cmd = m.importModal.HandleMessage(msg)
+		case app.ConfigModal:
+			m.configModal, cmd = m.configModal.HandleMessage(msg)
+		case app.UpgradeModal:
+			m.upgradeModal, cmd = m.upgradeModal.HandleMessage(msg)
 		}
 		// Exit early and don't apply twice
 		cmds = append(cmds, cmd)
@@ -208,6 +224,12 @@ func (m ViewModel) HandleMessage(msg tea.Msg) (ViewModel, tea.Cmd) {
 	cmds = append(cmds, cmd)
 	m.renameModal, cmd = m.renameModal.HandleMessage(msg)
 	cmds = append(cmds, cmd)
+	m.importModal, cmd = m.importModal.HandleMessage(msg)
+	cmds = append(cmds, cmd)
+	m.configModal, cmd = m.configModal.HandleMessage(msg)
+	cmds = append(cmds, cmd)
+	m.upgradeModal, cmd = m.upgradeModal.HandleMessage(msg)
+	cmds = append(cmds, cmd)
 
 	return m, tea.Batch(cmds...)
 }
@@ -7,13 +7,16 @@ import (
 	"github.com/algorandfoundation/nodekit/ui/app"
 	"github.com/algorandfoundation/nodekit/ui/modals/catchup"
 	"github.com/algorandfoundation/nodekit/ui/modals/catchup/lagging"
+	"github.com/algorandfoundation/nodekit/ui/modals/configure"
 	"github.com/algorandfoundation/nodekit/ui/modals/exception"
 	"github.com/algorandfoundation/nodekit/ui/modals/hybrid"
 	"github.com/algorandfoundation/nodekit/ui/modals/partkey/delete"
 	"github.com/algorandfoundation/nodekit/ui/modals/partkey/generate"
+	"github.com/algorandfoundation/nodekit/ui/modals/partkey/importer"
 	"github.com/algorandfoundation/nodekit/ui/modals/partkey/info"
 	"github.com/algorandfoundation/nodekit/ui/modals/partkey/transaction"
 	"github.com/algorandfoundation/nodekit/ui/modals/rename"
+	"github.com/algorandfoundation/nodekit/ui/modals/upgrade"
 )
 
 type ViewModel struct {
@@ -48,6 +51,9 @@ type ViewModel struct {
 	exceptionModal   exception.ViewModel
 	hybridModal      hybrid.ViewModel
 	renameModal      rename.ViewModel
+	importModal      importer.ViewModel
+	configModal      configure.ViewModel
+	upgradeModal     upgrade.ViewModel
 
 	// Current Component Data
 	title       string
@@ -102,6 +108,9 @@ func New(parent string, open bool, state *algod.StateModel) ViewModel {
 		exceptionModal:   exception.New(""),
 		hybridModal:      hybrid.New(state),
 		renameModal:      rename.New(state),
+		importModal:      importer.New(state),
+		configModal:      configure.New(state),
+		upgradeModal:     upgrade.New(state),
 
 		Type:        app.InfoModal,
 		controls:    "",
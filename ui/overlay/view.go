@@ -30,6 +30,12 @@ func (m ViewModel) View() string {
 		render = m.hybridModal.View()
 	case app.RenameModal:
 		render = m.renameModal.View()
+	case app.ImportModal:
+		render = m.importModal.View()
+	case app.ConfigModal:
+		render = m.configModal.View()
+	case app.UpgradeModal:
+		render = m.upgradeModal.View()
 	}
 
 	return style.WithOverlay(render, m.Parent)
@@ -41,7 +41,7 @@ func New(state *algod.StateModel) ViewModel {
 		Height:      0,
 		BorderColor: "6",
 		Data:        state,
-		Controls:    "( (g)enerate | (n)ickname | (enter) to select )",
+		Controls:    "( (g)enerate | (n)ickname | (c)onfig | (enter) to select )",
 		Navigation:  "| -> | " + style.Green.Render("accounts") + " | keys |",
 	}
 
@@ -0,0 +1,48 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/algorandfoundation/nodekit/ui/app"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func Test_ConfigPage(t *testing.T) {
+	m := New()
+	m, _ = m.HandleMessage(tea.WindowSizeMsg{Width: 160, Height: 120})
+	m, _ = m.HandleMessage(app.ConfigLoaded{Values: map[string]json.RawMessage{
+		"Archival": json.RawMessage(`true`),
+		"Version":  json.RawMessage(`34`),
+	}})
+
+	if m.SelectedOption() != "Archival" {
+		t.Fatalf("expected Archival to be selected, got %s", m.SelectedOption())
+	}
+	view := ansi.Strip(m.View())
+	if !strings.Contains(view, "Version") || !strings.Contains(view, "GossipFanout") {
+		t.Error("expected the schema and the unknown keys to be listed")
+	}
+
+	_, cmd := m.HandleMessage(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected the option to be selected")
+	}
+
+	_, cmd = m.HandleMessage(tea.KeyMsg{Type: tea.KeyEsc})
+	if cmd == nil || cmd() != app.AccountsPage {
+		t.Error("expected esc to go back to the accounts")
+	}
+
+	m, _ = m.HandleMessage(app.ConfigLoaded{Err: errors.New("no data directory")})
+	if !strings.Contains(ansi.Strip(m.View()), "no data directory") {
+		t.Error("expected the error to be rendered")
+	}
+	_, cmd = m.HandleMessage(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd != nil {
+		t.Error("expected no editing without a configuration")
+	}
+}
@@ -0,0 +1,76 @@
+package config
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/algorandfoundation/nodekit/internal/algod/config"
+	"github.com/algorandfoundation/nodekit/ui/app"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func (m ViewModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m ViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return m.HandleMessage(msg)
+}
+
+func (m ViewModel) HandleMessage(msg tea.Msg) (ViewModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	// When config.json was read
+	case app.ConfigLoaded:
+		m.Err = msg.Err
+		m.Values = msg.Values
+		if m.Values == nil {
+			m.Values = map[string]json.RawMessage{}
+		}
+		rows, names := m.makeRows()
+		m.names = names
+		m.table.SetRows(rows)
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "esc":
+			return m, app.EmitShowPage(app.AccountsPage)
+		// Edit the selected option
+		case "enter":
+			name := m.SelectedOption()
+			if m.Err != nil || name == "" {
+				return m, nil
+			}
+			if _, ok := config.Lookup(name); !ok {
+				// Keys outside the schema are kept as they are
+				return m, nil
+			}
+			raw, ok := m.Values[name]
+			value := string(raw)
+			if s, err := strconv.Unquote(value); err == nil {
+				value = s
+			}
+			return m, tea.Sequence(
+				app.EmitConfigOptionSelected(app.ConfigOptionSelected{Name: name, Value: value, Set: ok}),
+				app.EmitShowModal(app.ConfigModal),
+			)
+		}
+
+	// Handle Resize Events
+	case tea.WindowSizeMsg:
+		borderRender := style.Border.Render("")
+		borderWidth := lipgloss.Width(borderRender)
+		borderHeight := lipgloss.Height(borderRender)
+
+		m.Width = max(0, msg.Width-borderWidth)
+		m.Height = max(0, msg.Height-borderHeight)
+		m.table.SetWidth(m.Width)
+		m.table.SetHeight(m.Height)
+		m.table.SetColumns(m.makeColumns(m.Width))
+	}
+
+	// Handle Table Update
+	m.table, _ = m.table.Update(msg)
+
+	return m, nil
+}
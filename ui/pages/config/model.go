@@ -0,0 +1,111 @@
+package config
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/algorandfoundation/nodekit/internal/algod/config"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ViewModel lists every algod configuration option with its value in config.json and its default.
+type ViewModel struct {
+	// Values are the keys of config.json
+	Values map[string]json.RawMessage
+	// Err is set when config.json could not be read
+	Err error
+
+	Title       string
+	Navigation  string
+	Controls    string
+	BorderColor string
+	Width       int
+	Height      int
+
+	table table.Model
+
+	// names holds the option names in the same order as the table rows
+	names []string
+}
+
+func New() ViewModel {
+	m := ViewModel{
+		Title:       "Configuration",
+		Width:       0,
+		Height:      0,
+		BorderColor: "5",
+		Values:      map[string]json.RawMessage{},
+		Controls:    "( (enter) to edit | (esc) to go back )",
+		Navigation:  "| <- | accounts | keys | " + style.Green.Render("config") + " |",
+	}
+
+	rows, names := m.makeRows()
+	m.names = names
+	m.table = table.New(
+		table.WithColumns(m.makeColumns(0)),
+		table.WithRows(rows),
+		table.WithFocused(true),
+	)
+	s := table.DefaultStyles()
+	s.Header = s.Header.
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderForeground(lipgloss.Color("240")).
+		BorderBottom(true).
+		Bold(false)
+	s.Selected = s.Selected.
+		Foreground(lipgloss.Color("229")).
+		Background(lipgloss.Color(m.BorderColor)).
+		Bold(false)
+	m.table.SetStyles(s)
+	return m
+}
+
+// SelectedOption returns the name of the option on the selected row, or an empty string
+func (m ViewModel) SelectedOption() string {
+	idx := m.table.Cursor()
+	if idx >= 0 && idx < len(m.names) {
+		return m.names[idx]
+	}
+	return ""
+}
+
+func (m ViewModel) makeColumns(width int) []table.Column {
+	avgWidth := (width - lipgloss.Width(style.Border.Render("")) - 9) / 5
+	return []table.Column{
+		{Title: "Option", Width: avgWidth * 2},
+		{Title: "Value", Width: avgWidth},
+		{Title: "Default", Width: avgWidth},
+		{Title: "Type", Width: avgWidth},
+	}
+}
+
+// makeRows builds the rows of the schema followed by the keys that are not part of it,
+// returning the option names in the same order
+func (m ViewModel) makeRows() ([]table.Row, []string) {
+	rows := make([]table.Row, 0, len(config.Options))
+	names := make([]string, 0, len(config.Options))
+	for _, option := range config.Options {
+		rows = append(rows, table.Row{
+			option.Name,
+			string(m.Values[option.Name]),
+			option.Format(option.Default),
+			string(option.Type),
+		})
+		names = append(names, option.Name)
+	}
+
+	unknown := make([]string, 0)
+	for name := range m.Values {
+		if _, ok := config.Lookup(name); !ok {
+			unknown = append(unknown, name)
+		}
+	}
+	sort.Strings(unknown)
+	for _, name := range unknown {
+		rows = append(rows, table.Row{name, string(m.Values[name]), "", ""})
+		names = append(names, name)
+	}
+	return rows, names
+}
@@ -0,0 +1,25 @@
+package config
+
+import (
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func (m ViewModel) View() string {
+	body := m.table.View()
+	ctls := m.Controls
+	if m.Err != nil {
+		body = lipgloss.Place(m.Width, m.Height, lipgloss.Center, lipgloss.Center, style.Red.Render(m.Err.Error()))
+		ctls = "( (esc) to go back )"
+	}
+	return style.WithNavigation(
+		m.Navigation,
+		style.WithControls(
+			ctls,
+			style.WithTitle(
+				m.Title,
+				style.ApplyBorder(m.Width, m.Height, m.BorderColor).Render(body),
+			),
+		),
+	)
+}
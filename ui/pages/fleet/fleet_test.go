@@ -0,0 +1,41 @@
+package fleet
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/fleet"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func Test_New(t *testing.T) {
+	m := New(nil)
+	if m.SelectedNode() != "" {
+		t.Errorf("expected no node to be selected, got %s", m.SelectedNode())
+	}
+
+	expires := time.Now().Add(time.Hour)
+	m, _ = m.HandleMessage([]fleet.Summary{
+		{Name: "relay", State: fleet.UnreachableState},
+		{Name: "participation", State: algod.StableState, LastRound: 1337, Lag: 2, Peers: 4, Keys: 3, Expires: &expires},
+	})
+	m, _ = m.HandleMessage(tea.WindowSizeMsg{Width: 160, Height: 20})
+	if m.SelectedNode() != "relay" {
+		t.Errorf("expected relay to be selected, got %s", m.SelectedNode())
+	}
+
+	view := ansi.Strip(m.View())
+	for _, expected := range []string{"UNREACHABLE", "participation", "1337", "⚠"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("expected the view to contain %s", expected)
+		}
+	}
+
+	m, _ = m.HandleMessage(tea.KeyMsg{Type: tea.KeyDown})
+	if m.SelectedNode() != "participation" {
+		t.Errorf("expected participation to be selected, got %s", m.SelectedNode())
+	}
+}
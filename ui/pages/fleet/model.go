@@ -0,0 +1,117 @@
+package fleet
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/fleet"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type ViewModel struct {
+	Data []fleet.Summary
+
+	Title       string
+	Navigation  string
+	Controls    string
+	BorderColor string
+	Width       int
+	Height      int
+
+	table table.Model
+}
+
+func New(summaries []fleet.Summary) ViewModel {
+	m := ViewModel{
+		Title:       "Fleet",
+		Width:       0,
+		Height:      0,
+		BorderColor: "6",
+		Data:        summaries,
+		Controls:    "( (enter) to select | (q)uit )",
+		Navigation:  "| " + style.Green.Render("fleet") + " | accounts | keys |",
+	}
+
+	m.table = table.New(
+		table.WithColumns(m.makeColumns(0)),
+		table.WithRows(m.makeRows()),
+		table.WithFocused(true),
+	)
+	s := table.DefaultStyles()
+	s.Header = s.Header.
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderForeground(lipgloss.Color("240")).
+		BorderBottom(true).
+		Bold(false)
+	s.Selected = s.Selected.
+		Foreground(lipgloss.Color("229")).
+		Background(lipgloss.Color(m.BorderColor)).
+		Bold(false)
+	m.table.SetStyles(s)
+	return m
+}
+
+// SelectedNode returns the name of the node on the selected row, or an empty string
+func (m ViewModel) SelectedNode() string {
+	idx := m.table.Cursor()
+	if idx >= 0 && idx < len(m.Data) {
+		return m.Data[idx].Name
+	}
+	return ""
+}
+
+func (m ViewModel) makeColumns(width int) []table.Column {
+	avgWidth := (width - lipgloss.Width(style.Border.Render("")) - 15) / 7
+	return []table.Column{
+		{Title: "Node", Width: avgWidth},
+		{Title: "Status", Width: avgWidth},
+		{Title: "Round", Width: avgWidth},
+		{Title: "Lag", Width: avgWidth},
+		{Title: "Peers", Width: avgWidth},
+		{Title: "Keys", Width: avgWidth},
+		{Title: "Next Expiry", Width: avgWidth},
+	}
+}
+
+func (m ViewModel) makeRows() []table.Row {
+	rows := make([]table.Row, 0, len(m.Data))
+	for _, summary := range m.Data {
+		// Nothing is known about a node that never responded
+		if summary.State == fleet.ConnectingState || summary.State == fleet.UnreachableState {
+			rows = append(rows, table.Row{summary.Name, string(summary.State), "", "", "", "", ""})
+			continue
+		}
+
+		expires := "N/A"
+		if summary.Expires != nil {
+			if summary.Expires.Before(time.Now()) {
+				expires = "EXPIRED"
+			} else {
+				expires = summary.Expires.Format(time.RFC822)
+			}
+			// Expires within the week
+			if summary.Expires.Before(time.Now().Add(time.Hour * 24 * 7)) {
+				expires = "⚠ " + expires
+			}
+		}
+
+		lag := strconv.FormatUint(summary.Lag, 10)
+		if summary.State == algod.DownState {
+			lag = ""
+		}
+
+		rows = append(rows, table.Row{
+			summary.Name,
+			string(summary.State),
+			strconv.FormatUint(summary.LastRound, 10),
+			lag,
+			strconv.FormatUint(summary.Peers, 10),
+			strconv.Itoa(summary.Keys),
+			expires,
+		})
+	}
+	return rows
+}
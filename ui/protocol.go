@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/algod/upgrade"
 	"github.com/algorandfoundation/nodekit/ui/style"
 	"github.com/algorandfoundation/nodekit/ui/utils"
 	tea "github.com/charmbracelet/bubbletea"
@@ -17,6 +18,7 @@ import (
 type ProtocolViewModel struct {
 	Data           algod.Status
 	Metrics        algod.Metrics
+	Upgrade        *upgrade.Report
 	TerminalWidth  int
 	TerminalHeight int
 	IsVisible      bool
@@ -46,6 +48,9 @@ func (m ProtocolViewModel) HandleMessage(msg tea.Msg) (ProtocolViewModel, tea.Cm
 	case algod.Metrics:
 		m.Metrics = msg
 		return m, nil
+	case upgrade.Report:
+		m.Upgrade = &msg
+		return m, nil
 	// Update Viewport Size
 	case tea.WindowSizeMsg:
 		m.TerminalWidth = msg.Width
@@ -148,7 +153,11 @@ func (m ProtocolViewModel) View() string {
 	if !isCompact {
 		rows = append(rows, "")
 	}
-	rows = append(rows, style.Blue.Render(" Protocol Upgrade: ")+formatProtocolVote(m.Data, m.Metrics))
+	protocolUpgrade := formatProtocolVote(m.Data, m.Metrics)
+	if m.Upgrade != nil && m.Upgrade.Blocking {
+		protocolUpgrade += style.Red.Render(" [UNSUPPORTED]")
+	}
+	rows = append(rows, style.Blue.Render(" Protocol Upgrade: ")+protocolUpgrade)
 	if isCompact && m.Data.NeedsUpdate {
 		rows = append(rows, style.Blue.Render(" Upgrade Available: ")+style.Green.Render(strconv.FormatBool(m.Data.NeedsUpdate)))
 	}
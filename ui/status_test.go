@@ -131,7 +131,7 @@ func Test_StatusMessages(t *testing.T) {
 	)
 
 	// Send the state
-	tm.Send(state)
+	tm.Send(&state)
 
 	// Send hide key
 	tm.Send(tea.KeyMsg{
@@ -8,6 +8,7 @@ import (
 	"github.com/algorandfoundation/nodekit/ui/app"
 	"github.com/algorandfoundation/nodekit/ui/overlay"
 	"github.com/algorandfoundation/nodekit/ui/pages/accounts"
+	"github.com/algorandfoundation/nodekit/ui/pages/config"
 	"github.com/algorandfoundation/nodekit/ui/pages/keys"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -27,6 +28,7 @@ type ViewportViewModel struct {
 	// Pages
 	accountsPage accounts.ViewModel
 	keysPage     keys.ViewModel
+	configPage   config.ViewModel
 
 	modal overlay.ViewModel
 	page  app.Page
@@ -38,6 +40,7 @@ func (m ViewportViewModel) Init() tea.Cmd {
 		m.modal.Init(),
 		m.accountsPage.Init(),
 		m.keysPage.Init(),
+		m.configPage.Init(),
 	)
 }
 
@@ -79,6 +82,16 @@ func (m ViewportViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		switch msg.String() {
 		case "p":
 			return m, app.EmitShowModal(app.HybridModal)
+		case "i":
+			// Import a key file from the keys page only
+			if m.page == app.KeysPage {
+				return m, app.EmitShowModal(app.ImportModal)
+			}
+		case "c":
+			// Reload config.json every time the page is shown
+			if m.page != app.ConfigPage {
+				return m, tea.Sequence(app.LoadConfigCmd(m.Data), app.EmitShowPage(app.ConfigPage))
+			}
 		case "g":
 			// Only open modal when it is closed and not syncing
 			if m.Data.Status.State == algod.StableState && m.Data.Metrics.RoundTime > 0 {
@@ -97,8 +110,8 @@ func (m ViewportViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if m.page == app.AccountsPage {
 				return m, nil
 			}
-			// Navigate to the Keys Page
-			if m.page == app.KeysPage {
+			// Navigate back to the Accounts Page
+			if m.page == app.KeysPage || m.page == app.ConfigPage {
 				return m, app.EmitShowPage(app.AccountsPage)
 			}
 		case "right":
@@ -131,6 +144,10 @@ func (m ViewportViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.keysPage, cmd = m.keysPage.HandleMessage(msg)
 			cmds = append(cmds, cmd)
 		}
+		if m.page == app.ConfigPage {
+			m.configPage, cmd = m.configPage.HandleMessage(msg)
+			cmds = append(cmds, cmd)
+		}
 
 		return m, tea.Batch(cmds...)
 
@@ -158,6 +175,9 @@ func (m ViewportViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.keysPage, cmd = m.keysPage.HandleMessage(pageMsg)
 		cmds = append(cmds, cmd)
 
+		m.configPage, cmd = m.configPage.HandleMessage(pageMsg)
+		cmds = append(cmds, cmd)
+
 		// Avoid triggering commands again
 		return m, tea.Batch(cmds...)
 	}
@@ -167,6 +187,8 @@ func (m ViewportViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	cmds = append(cmds, cmd)
 	m.keysPage, cmd = m.keysPage.HandleMessage(msg)
 	cmds = append(cmds, cmd)
+	m.configPage, cmd = m.configPage.HandleMessage(msg)
+	cmds = append(cmds, cmd)
 	m.modal, cmd = m.modal.HandleMessage(msg)
 	cmds = append(cmds, cmd)
 
@@ -183,6 +205,8 @@ func (m ViewportViewModel) View() string {
 		page = m.accountsPage
 	case app.KeysPage:
 		page = m.keysPage
+	case app.ConfigPage:
+		page = m.configPage
 	}
 
 	if page == nil {
@@ -221,6 +245,7 @@ func NewViewportViewModel(state *algod.StateModel) (*ViewportViewModel, error) {
 		// Pages
 		accountsPage: accounts.New(state),
 		keysPage:     keys.New("", state.ParticipationKeys),
+		configPage:   config.New(),
 
 		// Modal
 		modal: overlay.New("", false, state),
@@ -3,11 +3,6 @@
 # Install nodekit, replacing any previous nodekit (FORCE_INSTALL) and skipping the interactive bootstrap
 wget -qO- https://nodekit.run/install.sh | NODEKIT_FORCE_INSTALL=1 NODEKIT_SKIP_BOOTSTRAP=1 bash
 
-# Install node
-./nodekit install -f
-
-# Wait a bit for connections to be established
-sleep 2m
-
-# Start a fast catchup
-./nodekit catchup start
+# Install and start the node, then start a fast catchup without asking any question.
+# A failed bootstrap continues from the failed step with: ./nodekit bootstrap --resume --yes
+./nodekit bootstrap --yes